@@ -10,6 +10,7 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/accounthist/injector"
 	"github.com/dfuse-io/dstore"
 	"github.com/dfuse-io/kvdb/store"
+	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	"github.com/dfuse-io/shutter"
 	"go.uber.org/zap"
 )
@@ -22,6 +23,7 @@ type Config struct {
 	GRPCListenAddr           string
 	BlocksStoreURL           string //FileSourceBaseURL
 	BlockstreamAddr          string // LiveSourceAddress
+	BlockmetaAddr            string // Optional, resolves block time boundaries of queries
 	ShardNum                 byte
 	MaxEntriesPerKey         uint64
 	FlushBlocksInterval      uint64
@@ -31,6 +33,8 @@ type Config struct {
 	StartBlockNum            uint64
 	StopBlockNum             uint64
 	AccounthistMode          accounthist.AccounthistMode
+	FacetCollection          byte   // Collection prefix of the custom facet, only used in 'facet' mode
+	FacetFilter              string // CEL filter selecting the custom facet's actions, only used in 'facet' mode
 }
 
 type Modules struct {
@@ -77,8 +81,25 @@ func (a *App) Run() error {
 		return fmt.Errorf("setting up archive store: %w", err)
 	}
 
+	// The live hub links the injector to the server's live streaming, both must run in this process
+	var liveHub *accounthist.LiveHub
+	if a.config.EnableInjector && a.config.EnableServer {
+		liveHub = accounthist.NewLiveHub()
+	}
+
 	if a.config.EnableServer {
-		server := grpc.New(a.config.GRPCListenAddr, a.config.MaxEntriesPerKey, kvdb)
+		var blockmetaClient *pbblockmeta.Client
+		if a.config.BlockmetaAddr != "" {
+			blockmetaClient, err = pbblockmeta.NewClient(a.config.BlockmetaAddr)
+			if err != nil {
+				return fmt.Errorf("blockmeta connection error: %w", err)
+			}
+		}
+
+		server := grpc.New(a.config.GRPCListenAddr, a.config.MaxEntriesPerKey, kvdb, blockmetaClient)
+		if liveHub != nil {
+			server.SetLiveHub(liveHub)
+		}
 
 		a.OnTerminating(server.Terminate)
 		server.OnTerminated(a.Shutdown)
@@ -88,6 +109,8 @@ func (a *App) Run() error {
 			go server.ServeAccountMode()
 		case accounthist.AccounthistModeAccountContract:
 			go server.ServeAccountContractMode()
+		case accounthist.AccounthistModeFacet:
+			go server.ServeFacetMode(a.config.FacetCollection)
 		default:
 			return fmt.Errorf("invalid accounthist mode: %q", a.config.AccounthistMode)
 		}
@@ -115,10 +138,23 @@ func (a *App) Run() error {
 			zlog.Info("setting up 'account-contract' mode")
 			injector.SetFacetFactory(&accounthist.AccountContractFactory{})
 			injector.SetupMetrics("accounthist-account-contract")
+		case accounthist.AccounthistModeFacet:
+			zlog.Info("setting up 'facet' mode", zap.Uint8("collection", a.config.FacetCollection), zap.String("filter", a.config.FacetFilter))
+			facetFactory, err := accounthist.NewCELFacetFactory(a.config.FacetCollection, a.config.FacetFilter)
+			if err != nil {
+				return fmt.Errorf("unable to create facet factory: %w", err)
+			}
+
+			injector.SetFacetFactory(facetFactory)
+			injector.SetupMetrics(fmt.Sprintf("accounthist-facet-%02x", a.config.FacetCollection))
 		default:
 			return fmt.Errorf("invalid accounthist mode: %q", a.config.AccounthistMode)
 		}
 
+		if liveHub != nil {
+			injector.SetLiveHub(liveHub)
+		}
+
 		if err = injector.SetupSource(a.config.IgnoreCheckpointOnLaunch); err != nil {
 			return fmt.Errorf("error setting up source: %w", err)
 		}
@@ -137,5 +173,11 @@ func (c *Config) validate() error {
 		return errors.New("both enable injection and enable server were disabled, this is invalid, at least one of them must be enabled, or both")
 	}
 
+	if c.AccounthistMode == accounthist.AccounthistModeFacet {
+		if err := accounthist.ValidateCustomCollection(c.FacetCollection); err != nil {
+			return fmt.Errorf("invalid facet collection: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,93 @@
+package accounthist
+
+import (
+	"fmt"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
+	"github.com/dfuse-io/dfuse-eosio/filtering"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+)
+
+// CollectionAccountFacet is an account facet stored in a custom collection, rows are
+// laid out like `AccountFacet` ones but under the collection's own prefix.
+type CollectionAccountFacet struct {
+	collection byte
+	account    uint64
+}
+
+func (a *CollectionAccountFacet) String() string {
+	return fmt.Sprintf("account (%s) collection (0x%02x)", eos.NameToString(a.account), a.collection)
+}
+
+func (a *CollectionAccountFacet) Account() uint64 {
+	return a.account
+}
+
+func (a *CollectionAccountFacet) Row(shard byte, seqData uint64) RowKey {
+	return keyer.EncodeAccountKeyWithPrefix(a.collection, a.account, shard, seqData)
+}
+
+func (a *CollectionAccountFacet) Bytes() []byte {
+	return keyer.EncodeAccountWithPrefixKey(a.collection, a.account)
+}
+
+// CELFacetFactory keeps, for each account, the history of actions matching a CEL
+// filter. The filter is evaluated with the `filtering` package environment (`receiver`,
+// `account`, `action`, `data`, `auth`, ...).
+type CELFacetFactory struct {
+	collection byte
+	filter     *filtering.CELFilter
+}
+
+func NewCELFacetFactory(collection byte, filterCode string) (*CELFacetFactory, error) {
+	if err := ValidateCustomCollection(collection); err != nil {
+		return nil, err
+	}
+
+	filter, err := filtering.NewActionTraceFilter(fmt.Sprintf("accounthist facet 0x%02x", collection), filterCode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid facet filter %q: %w", filterCode, err)
+	}
+
+	return &CELFacetFactory{
+		collection: collection,
+		filter:     filter,
+	}, nil
+}
+
+// ValidateCustomCollection ensures the collection does not overlap the built-in ones and
+// leaves room for its checkpoint prefix.
+func ValidateCustomCollection(collection byte) error {
+	if collection < keyer.PrefixCustomFacetStart {
+		return fmt.Errorf("collection 0x%02x is reserved, custom collections start at 0x%02x", collection, keyer.PrefixCustomFacetStart)
+	}
+
+	if collection%2 != 0 || collection == 0xff {
+		return fmt.Errorf("collection 0x%02x is invalid, custom collections must be even, the following prefix holds the checkpoints", collection)
+	}
+
+	return nil
+}
+
+func (f *CELFacetFactory) Collection() byte {
+	return f.collection
+}
+
+func (f *CELFacetFactory) NewFacet(blk *bstream.Block, act *pbcodec.ActionTrace, account uint64) Facet {
+	return &CollectionAccountFacet{collection: f.collection, account: account}
+}
+
+func (f *CELFacetFactory) NewCheckpointKey(shardNum byte) []byte {
+	return keyer.EncodeCheckpointKeyWithPrefix(f.collection+1, shardNum)
+}
+
+func (f *CELFacetFactory) DecodeRow(key []byte) (Facet, byte, uint64) {
+	account, shard, seqNum := keyer.DecodeAccountKeySeqNum(key)
+	return &CollectionAccountFacet{collection: f.collection, account: account}, shard, seqNum
+}
+
+func (f *CELFacetFactory) ActionFilter(act *pbcodec.ActionTrace) bool {
+	return f.filter.MatchActionTrace(act)
+}
@@ -2,18 +2,11 @@ package grpc
 
 import (
 	"context"
-	"encoding/hex"
-	"fmt"
-	"math"
-
-	"github.com/dfuse-io/dfuse-eosio/accounthist"
 
 	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
-	"github.com/dfuse-io/kvdb/store"
 	"github.com/dfuse-io/logging"
-	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,7 +22,12 @@ func (s *Server) GetAccountContractActions(req *pbaccounthist.GetTokenActionsReq
 	contract := req.Contract
 	limit := uint64(req.Limit)
 
-	err := s.StreamAccountContractActions(stream.Context(), account, contract, limit, req.Cursor, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+	rng, err := s.resolveActionsRange(stream.Context(), req.Ascending, req.LowBlockNum, req.HighBlockNum, req.LowBlockTime, req.HighBlockTime)
+	if err != nil {
+		return err
+	}
+
+	err = s.ScanAccountContractActions(stream.Context(), account, contract, limit, req.Cursor, rng, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
 		if err := stream.Send(&pbaccounthist.ActionResponse{Cursor: cursor, ActionTrace: actionTrace}); err != nil {
 			return err
 		}
@@ -45,59 +43,30 @@ func (s *Server) GetAccountContractActions(req *pbaccounthist.GetTokenActionsReq
 
 }
 
-func (s *Server) StreamAccountContractActions(
+func (s *Server) ScanAccountContractActions(
 	ctx context.Context,
 	account uint64,
 	contract uint64,
 	limit uint64,
 	cursor *pbaccounthist.Cursor,
+	rng *ActionsRange,
 	onAction func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error,
 ) error {
-	logger := logging.Logger(ctx, zlog)
-
-	queryShardNum := byte(0x00)
-	querySeqNum := uint64(math.MaxUint64)
-	if cursor != nil {
-		// TODO: extract these from the key instead
-		queryShardNum = byte(cursor.ShardNum)
-		querySeqNum = cursor.SequenceNumber - 1
-	}
-
-	startKey := keyer.EncodeAccountContractKey(account, contract, queryShardNum, querySeqNum)
-	endKey := store.Key(keyer.EncodeAccountContractPrefixKey(account, contract)).PrefixNext()
-
-	if limit == 0 || limit > s.MaxEntries {
-		limit = s.MaxEntries
-	}
-
-	logger.Debug("scanning actions",
+	logging.Logger(ctx, zlog).Debug("streaming account contract actions",
 		zap.Stringer("account", EOSName(account)),
 		zap.Stringer("contract", EOSName(contract)),
-		zap.String("start_key", hex.EncodeToString(startKey)),
-		zap.String("end_key", hex.EncodeToString(endKey)),
-		zap.Uint64("limit", limit),
 	)
 
-	ctx, cancel := context.WithTimeout(ctx, accounthist.DatabaseTimeout)
-	defer cancel()
-
-	it := s.KVStore.Scan(ctx, startKey, endKey, int(limit))
-	for it.Next() {
-		newact := &pbaccounthist.ActionRow{}
-		err := proto.Unmarshal(it.Item().Value, newact)
-		if err != nil {
-			return fmt.Errorf("unmarshal action: %w", err)
-		}
-
-		_, _, shardNo, SeqNum := keyer.DecodeAccountContractKeySeqNum(it.Item().Key)
-		if err := onAction(ActionKeyToCursor(it.Item().Key, shardNo, SeqNum), newact.ActionTrace); err != nil {
-			return fmt.Errorf("on action: %w", err)
-		}
-	}
-
-	if err := it.Err(); err != nil {
-		return fmt.Errorf("fetching actions: %w", err)
+	rows := facetRows{
+		prefix: keyer.EncodeAccountContractPrefixKey(account, contract),
+		rowKey: func(shard byte, seqNum uint64) []byte {
+			return keyer.EncodeAccountContractKey(account, contract, shard, seqNum)
+		},
+		decodeRow: func(key []byte) (byte, uint64) {
+			_, _, shard, seqNum := keyer.DecodeAccountContractKeySeqNum(key)
+			return shard, seqNum
+		},
 	}
 
-	return nil
+	return s.streamFacetActions(ctx, rows, limit, cursor, rng, onAction)
 }
@@ -2,18 +2,11 @@ package grpc
 
 import (
 	"context"
-	"encoding/hex"
-	"fmt"
-	"math"
-
-	"github.com/dfuse-io/dfuse-eosio/accounthist"
 
 	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
-	"github.com/dfuse-io/kvdb/store"
 	"github.com/dfuse-io/logging"
-	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,7 +21,12 @@ func (s *Server) GetActions(req *pbaccounthist.GetActionsRequest, stream pbaccou
 	account := req.Account
 	limit := uint64(req.Limit)
 
-	err := s.StreamAccountActions(stream.Context(), account, limit, req.Cursor, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+	rng, err := s.resolveActionsRange(stream.Context(), req.Ascending, req.LowBlockNum, req.HighBlockNum, req.LowBlockTime, req.HighBlockTime)
+	if err != nil {
+		return err
+	}
+
+	err = s.ScanCollectionAccountActions(stream.Context(), s.accountCollection, account, limit, req.Cursor, rng, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
 		if err := stream.Send(&pbaccounthist.ActionResponse{Cursor: cursor, ActionTrace: actionTrace}); err != nil {
 			return err
 		}
@@ -43,57 +41,41 @@ func (s *Server) GetActions(req *pbaccounthist.GetActionsRequest, stream pbaccou
 	return nil
 }
 
-func (s *Server) StreamAccountActions(
+func (s *Server) ScanAccountActions(
 	ctx context.Context,
 	account uint64,
 	limit uint64,
 	cursor *pbaccounthist.Cursor,
+	rng *ActionsRange,
 	onAction func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error,
 ) error {
-	logger := logging.Logger(ctx, zlog)
-
-	queryShardNum := byte(0x00)
-	querySeqNum := uint64(math.MaxUint64)
-	if cursor != nil {
-		// TODO: extract these from the key instead
-		queryShardNum = byte(cursor.ShardNum)
-		querySeqNum = cursor.SequenceNumber - 1
-	}
-
-	startKey := keyer.EncodeAccountKey(account, queryShardNum, querySeqNum)
-	endKey := store.Key(keyer.EncodeAccountPrefixKey(account)).PrefixNext()
-
-	if limit == 0 || limit > s.MaxEntries {
-		limit = s.MaxEntries
-	}
+	return s.ScanCollectionAccountActions(ctx, keyer.PrefixAccount, account, limit, cursor, rng, onAction)
+}
 
-	logger.Debug("scanning actions",
+func (s *Server) ScanCollectionAccountActions(
+	ctx context.Context,
+	collection byte,
+	account uint64,
+	limit uint64,
+	cursor *pbaccounthist.Cursor,
+	rng *ActionsRange,
+	onAction func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error,
+) error {
+	logging.Logger(ctx, zlog).Debug("streaming account actions",
+		zap.Uint8("collection", collection),
 		zap.Stringer("account", EOSName(account)),
-		zap.String("start_key", hex.EncodeToString(startKey)),
-		zap.String("end_key", hex.EncodeToString(endKey)),
-		zap.Uint64("limit", limit),
 	)
 
-	ctx, cancel := context.WithTimeout(ctx, accounthist.DatabaseTimeout)
-	defer cancel()
-
-	it := s.KVStore.Scan(ctx, startKey, endKey, int(limit))
-	for it.Next() {
-		newact := &pbaccounthist.ActionRow{}
-		err := proto.Unmarshal(it.Item().Value, newact)
-		if err != nil {
-			return fmt.Errorf("unmarshal action: %w", err)
-		}
-
-		_, shardNo, SeqNum := keyer.DecodeAccountKeySeqNum(it.Item().Key)
-		if err := onAction(ActionKeyToCursor(it.Item().Key, shardNo, SeqNum), newact.ActionTrace); err != nil {
-			return fmt.Errorf("on action: %w", err)
-		}
+	rows := facetRows{
+		prefix: keyer.EncodeAccountWithPrefixKey(collection, account),
+		rowKey: func(shard byte, seqNum uint64) []byte {
+			return keyer.EncodeAccountKeyWithPrefix(collection, account, shard, seqNum)
+		},
+		decodeRow: func(key []byte) (byte, uint64) {
+			_, shard, seqNum := keyer.DecodeAccountKeySeqNum(key)
+			return shard, seqNum
+		},
 	}
 
-	if err := it.Err(); err != nil {
-		return fmt.Errorf("fetching actions: %w", err)
-	}
-
-	return nil
+	return s.streamFacetActions(ctx, rows, limit, cursor, rng, onAction)
 }
@@ -5,10 +5,13 @@ import (
 	"net"
 	"time"
 
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
+	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
 	"github.com/dfuse-io/dgrpc"
 
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	"github.com/dfuse-io/kvdb/store"
+	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	"github.com/dfuse-io/shutter"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -21,19 +24,41 @@ type Server struct {
 	server     *grpc.Server
 	MaxEntries uint64
 	KVStore    store.KVStore
+
+	// BlockmetaClient resolves block time boundaries, those are refused when `nil`
+	BlockmetaClient *pbblockmeta.Client
+
+	// accountCollection is the collection `GetActions` reads from, the custom facet's one
+	// when serving in facet mode.
+	accountCollection byte
+
+	// liveHub feeds `StreamAccountActions`, it is only available when the injector runs in the same process
+	liveHub *accounthist.LiveHub
+
+	// streamsAccountActions is set in the modes where the live actions are keyed by account, `StreamAccountActions`
+	// is refused otherwise
+	streamsAccountActions bool
 }
 
-func New(grpcAddr string, maxEntries uint64, kvStore store.KVStore) *Server {
+func New(grpcAddr string, maxEntries uint64, kvStore store.KVStore, blockmetaClient *pbblockmeta.Client) *Server {
 	return &Server{
-		Shutter:    shutter.New(),
-		grpcAddr:   grpcAddr,
-		MaxEntries: maxEntries,
-		KVStore:    kvStore,
-		server:     dgrpc.NewServer(dgrpc.WithLogger(zlog)),
+		Shutter:         shutter.New(),
+		grpcAddr:        grpcAddr,
+		MaxEntries:      maxEntries,
+		KVStore:         kvStore,
+		BlockmetaClient: blockmetaClient,
+		server:          dgrpc.NewServer(dgrpc.WithLogger(zlog)),
+
+		accountCollection: keyer.PrefixAccount,
 	}
 }
 
+func (s *Server) SetLiveHub(hub *accounthist.LiveHub) {
+	s.liveHub = hub
+}
+
 func (s *Server) ServeAccountMode() {
+	s.streamsAccountActions = true
 	pbaccounthist.RegisterAccountHistoryServer(s.server, s)
 	s.serve()
 }
@@ -43,6 +68,14 @@ func (s *Server) ServeAccountContractMode() {
 	s.serve()
 }
 
+// ServeFacetMode serves the account history of a custom facet, see `accounthist.CELFacetFactory`.
+func (s *Server) ServeFacetMode(collection byte) {
+	s.accountCollection = collection
+	s.streamsAccountActions = true
+	pbaccounthist.RegisterAccountHistoryServer(s.server, s)
+	s.serve()
+}
+
 func (s *Server) serve() {
 	zlog.Info("listening for accounthist", zap.String("addr", s.grpcAddr))
 	lis, err := net.Listen("tcp", s.grpcAddr)
@@ -0,0 +1,194 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/kvdb/store"
+	"github.com/dfuse-io/logging"
+	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ActionsRange restricts the streamed actions to an inclusive block range and
+// controls the iteration order, a `nil` range streams all actions, most recent first.
+type ActionsRange struct {
+	Ascending    bool
+	LowBlockNum  uint64 // 0 means unbounded
+	HighBlockNum uint64 // 0 means unbounded
+}
+
+func (r *ActionsRange) isAscending() bool {
+	return r != nil && r.Ascending
+}
+
+func (r *ActionsRange) isBounded() bool {
+	return r != nil && (r.LowBlockNum != 0 || r.HighBlockNum != 0)
+}
+
+func (r *ActionsRange) aboveHigh(blockNum uint64) bool {
+	return r != nil && r.HighBlockNum != 0 && blockNum > r.HighBlockNum
+}
+
+func (r *ActionsRange) belowLow(blockNum uint64) bool {
+	return r != nil && r.LowBlockNum != 0 && blockNum < r.LowBlockNum
+}
+
+// resolveActionsRange turns the request's boundaries into an `ActionsRange`, time
+// boundaries are resolved to block nums through blockmeta.
+func (s *Server) resolveActionsRange(ctx context.Context, ascending bool, lowBlockNum, highBlockNum uint64, lowBlockTime, highBlockTime *timestamp.Timestamp) (*ActionsRange, error) {
+	out := &ActionsRange{
+		Ascending:    ascending,
+		LowBlockNum:  lowBlockNum,
+		HighBlockNum: highBlockNum,
+	}
+
+	if (lowBlockNum != 0 && lowBlockTime != nil) || (highBlockNum != 0 && highBlockTime != nil) {
+		return nil, status.Error(codes.InvalidArgument, "a boundary cannot be specified both as a block num and a block time")
+	}
+
+	if lowBlockTime != nil || highBlockTime != nil {
+		if s.BlockmetaClient == nil {
+			return nil, status.Error(codes.FailedPrecondition, "block time boundaries are not supported, no blockmeta configured")
+		}
+
+		if lowBlockTime != nil {
+			resp, err := s.BlockmetaClient.BlockAfter(ctx, pbblockmeta.Timestamp(lowBlockTime), true)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "unable to resolve low block time: %s", err)
+			}
+			out.LowBlockNum = uint64(eos.BlockNum(resp.Id))
+		}
+
+		if highBlockTime != nil {
+			resp, err := s.BlockmetaClient.BlockBefore(ctx, pbblockmeta.Timestamp(highBlockTime), true)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "unable to resolve high block time: %s", err)
+			}
+			out.HighBlockNum = uint64(eos.BlockNum(resp.Id))
+		}
+	}
+
+	if out.HighBlockNum != 0 && out.LowBlockNum > out.HighBlockNum {
+		return nil, status.Errorf(codes.InvalidArgument, "low block num %d is higher than high block num %d", out.LowBlockNum, out.HighBlockNum)
+	}
+
+	return out, nil
+}
+
+type facetRows struct {
+	prefix    []byte
+	rowKey    func(shard byte, seqNum uint64) []byte
+	decodeRow func(key []byte) (shard byte, seqNum uint64)
+}
+
+type actionRow struct {
+	key         []byte
+	shard       byte
+	seqNum      uint64
+	actionTrace *pbcodec.ActionTrace
+}
+
+// streamFacetActions walks the rows of a facet, stored most recent first, in the order
+// requested by `rng`. Walking in ascending order buffers the rows before the cursor,
+// which is bounded by the maximum number of entries kept per facet.
+func (s *Server) streamFacetActions(
+	ctx context.Context,
+	rows facetRows,
+	limit uint64,
+	cursor *pbaccounthist.Cursor,
+	rng *ActionsRange,
+	onAction func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error,
+) error {
+	logger := logging.Logger(ctx, zlog)
+
+	startKey := rows.rowKey(0x00, math.MaxUint64)
+	endKey := store.Key(rows.prefix).PrefixNext()
+	if cursor != nil {
+		// TODO: extract these from the key instead
+		if rng.isAscending() {
+			endKey = rows.rowKey(byte(cursor.ShardNum), cursor.SequenceNumber)
+		} else {
+			startKey = rows.rowKey(byte(cursor.ShardNum), cursor.SequenceNumber-1)
+		}
+	}
+
+	if limit == 0 || limit > s.MaxEntries {
+		limit = s.MaxEntries
+	}
+
+	scanLimit := int(limit)
+	if rng.isAscending() || rng.isBounded() {
+		scanLimit = 0
+	}
+
+	logger.Debug("scanning actions",
+		zap.String("start_key", hex.EncodeToString(startKey)),
+		zap.String("end_key", hex.EncodeToString(endKey)),
+		zap.Uint64("limit", limit),
+		zap.Reflect("range", rng),
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, accounthist.DatabaseTimeout)
+	defer cancel()
+
+	var buffered []*actionRow
+	count := uint64(0)
+
+	it := s.KVStore.Scan(ctx, startKey, endKey, scanLimit)
+	for it.Next() {
+		newact := &pbaccounthist.ActionRow{}
+		err := proto.Unmarshal(it.Item().Value, newact)
+		if err != nil {
+			return fmt.Errorf("unmarshal action: %w", err)
+		}
+
+		blockNum := newact.ActionTrace.BlockNum
+		if rng.aboveHigh(blockNum) {
+			continue
+		}
+
+		if rng.belowLow(blockNum) {
+			break
+		}
+
+		shardNo, seqNum := rows.decodeRow(it.Item().Key)
+		if rng.isAscending() {
+			buffered = append(buffered, &actionRow{it.Item().Key, shardNo, seqNum, newact.ActionTrace})
+			continue
+		}
+
+		if err := onAction(ActionKeyToCursor(it.Item().Key, shardNo, seqNum), newact.ActionTrace); err != nil {
+			return fmt.Errorf("on action: %w", err)
+		}
+
+		count++
+		if count >= limit {
+			break
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("fetching actions: %w", err)
+	}
+
+	for i := len(buffered) - 1; i >= 0 && count < limit; i-- {
+		row := buffered[i]
+		if err := onAction(ActionKeyToCursor(row.key, row.shard, row.seqNum), row.actionTrace); err != nil {
+			return fmt.Errorf("on action: %w", err)
+		}
+		count++
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
+	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamAccountActions replays the stored history of the account after the request's
+// cursor, then streams the live actions processed by the injector, undo and redo
+// included. The live hub subscription is taken before the replay, actions already
+// replayed are skipped when they come back live, leaving no gap in between.
+func (s *Server) StreamAccountActions(req *pbaccounthist.StreamAccountActionsRequest, stream pbaccounthist.AccountHistory_StreamAccountActionsServer) error {
+	if !s.streamsAccountActions {
+		return status.Error(codes.Unimplemented, "streaming account actions is only available when accounthist runs in 'account' or 'facet' mode")
+	}
+
+	if s.liveHub == nil {
+		return status.Error(codes.Unavailable, "live streaming of actions is not available, the injector is not running alongside this server")
+	}
+
+	ctx := stream.Context()
+	logger := logging.Logger(ctx, zlog)
+	logger.Info("streaming account actions", zap.Stringer("account", EOSName(req.Account)))
+
+	reversible, sub := s.liveHub.Subscribe(keyer.EncodeAccountWithPrefixKey(s.accountCollection, req.Account))
+	defer s.liveHub.Unsubscribe(sub)
+
+	lastReplayedGlobalSeq := uint64(0)
+	cursor := req.Cursor
+	for {
+		count := uint64(0)
+		err := s.ScanCollectionAccountActions(ctx, s.accountCollection, req.Account, s.MaxEntries, cursor, &ActionsRange{Ascending: true}, func(actionCursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+			count++
+			cursor = actionCursor
+			lastReplayedGlobalSeq = actionTrace.Receipt.GlobalSequence
+
+			return stream.Send(&pbaccounthist.StreamActionResponse{
+				Step:        pbaccounthist.ActionStep_ACTION_STEP_IRREVERSIBLE,
+				Cursor:      actionCursor,
+				ActionTrace: actionTrace,
+			})
+		})
+		if err != nil {
+			return status.Errorf(codes.Unknown, "unable to replay actions: %s", err)
+		}
+
+		if count < s.MaxEntries {
+			break
+		}
+	}
+
+	send := func(action *accounthist.LiveAction) error {
+		if action.ActionTrace.Receipt.GlobalSequence <= lastReplayedGlobalSeq {
+			return nil
+		}
+
+		resp := &pbaccounthist.StreamActionResponse{Step: action.Step, ActionTrace: action.ActionTrace}
+		if action.RowKey != nil {
+			resp.Cursor = ActionKeyToCursor(action.RowKey, action.ShardNum, action.SeqNum)
+		}
+
+		return stream.Send(resp)
+	}
+
+	for _, action := range reversible {
+		if err := send(action); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case action, ok := <-sub.Actions():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return status.Errorf(codes.Unavailable, "live stream interrupted: %s", err)
+				}
+				return nil
+			}
+
+			if err := send(action); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -3,7 +3,10 @@ package injector
 import (
 	"testing"
 
+	"github.com/dfuse-io/dfuse-eosio/accounthist/grpc"
 	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -186,3 +189,51 @@ func Test_Account_Sharding(t *testing.T) {
 //	_, err := service.shardNewestSequenceData(context.Background(), eos.MustStringToName("a"), 1, service.processSequenceDataKeyValue)
 //	assert.Error(t, err, store.ErrNotFound)
 //}
+
+func Test_AccountLiveShard_Range(t *testing.T) {
+	kvStore, cleanup := getKVTestFactory(t)
+	defer cleanup()
+
+	s := setupAccountInjector(NewRWCache(kvStore), 0, 10)
+
+	autoGlobalSequence := ct.AutoGlobalSequence()
+
+	var blocks []*pbcodec.Block
+	for _, blockID := range []string{"00000001aa", "00000002aa", "00000003aa", "00000004aa"} {
+		block := ct.Block(t, blockID, autoGlobalSequence,
+			ct.TrxTrace(t, ct.ActionTrace(t, "some1:some:thing1")),
+			ct.TrxTrace(t, ct.ActionTrace(t, "some1:some:thing2")),
+		)
+
+		// Action traces hold their block num when coming from the chain
+		for _, trxTrace := range block.UnfilteredTransactionTraces {
+			for _, actTrace := range trxTrace.ActionTraces {
+				actTrace.BlockNum = block.Num()
+			}
+		}
+		blocks = append(blocks, block)
+	}
+
+	streamBlocks(t, s, blocks...)
+
+	globalSeqs := func(responses []*pbaccounthist.ActionResponse) (out []uint64) {
+		for _, response := range responses {
+			out = append(out, response.ActionTrace.Receipt.GlobalSequence)
+		}
+		return
+	}
+
+	assert.Equal(t, []uint64{6, 5, 4, 3}, globalSeqs(listAccountActionsInRange(t, s, "some1", 0, nil, &grpc.ActionsRange{LowBlockNum: 2, HighBlockNum: 3})))
+	assert.Equal(t, []uint64{3, 4, 5, 6}, globalSeqs(listAccountActionsInRange(t, s, "some1", 0, nil, &grpc.ActionsRange{Ascending: true, LowBlockNum: 2, HighBlockNum: 3})))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8}, globalSeqs(listAccountActionsInRange(t, s, "some1", 0, nil, &grpc.ActionsRange{Ascending: true})))
+	assert.Equal(t, []uint64{8, 7}, globalSeqs(listAccountActionsInRange(t, s, "some1", 2, nil, nil)))
+
+	firstPage := listAccountActionsInRange(t, s, "some1", 3, nil, &grpc.ActionsRange{Ascending: true, LowBlockNum: 2})
+	assert.Equal(t, []uint64{3, 4, 5}, globalSeqs(firstPage))
+
+	secondPage := listAccountActionsInRange(t, s, "some1", 3, firstPage[2].Cursor, &grpc.ActionsRange{Ascending: true, LowBlockNum: 2})
+	assert.Equal(t, []uint64{6, 7, 8}, globalSeqs(secondPage))
+
+	backward := listAccountActionsInRange(t, s, "some1", 0, secondPage[0].Cursor, &grpc.ActionsRange{LowBlockNum: 2})
+	assert.Equal(t, []uint64{5, 4, 3}, globalSeqs(backward))
+}
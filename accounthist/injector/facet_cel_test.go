@@ -0,0 +1,39 @@
+package injector
+
+import (
+	"testing"
+
+	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FacetLiveShard_Filter(t *testing.T) {
+	kvStore, cleanup := getKVTestFactory(t)
+	defer cleanup()
+
+	votes := setupFacetInjector(t, NewRWCache(kvStore), 0, 2, 0x10, `account == "eosio" && action == "voteproducer"`)
+	transfers := setupFacetInjector(t, NewRWCache(kvStore), 0, 2, 0x12, `action == "transfer" && data.to == "some2"`)
+
+	autoGlobalSequence := ct.AutoGlobalSequence()
+	trxTraces := []interface{}{
+		ct.TrxTrace(t, ct.ActionTrace(t, "eosio:voteproducer", ct.Authorization("some1"))),
+		ct.TrxTrace(t, ct.ActionTrace(t, "eosio:delegatebw", ct.Authorization("some1"))),
+		ct.TrxTrace(t, ct.ActionTrace(t, "eosio.token:transfer", ct.Authorization("some1"), ct.ActionData(`{"to":"some2"}`))),
+		ct.TrxTrace(t, ct.ActionTrace(t, "eosio.token:transfer", ct.Authorization("some1"), ct.ActionData(`{"to":"some3"}`))),
+	}
+
+	block := ct.Block(t, "00000001aa", append([]interface{}{autoGlobalSequence}, trxTraces...)...)
+	streamBlocks(t, votes, block)
+	streamBlocks(t, transfers, block)
+
+	assert.Equal(t, []*actionResult{
+		{cursor: "10c524a0800000000000fffffffffffffffe:00:1", actionTrace: ct.ActionTrace(t, "eosio:voteproducer", ct.Authorization("some1"), ct.GlobalSequence(1))},
+	}, listFacetActions(t, votes, 0x10, "some1"))
+
+	assert.Equal(t, []*actionResult{
+		{cursor: "12c524a0800000000000fffffffffffffffe:00:1", actionTrace: ct.ActionTrace(t, "eosio.token:transfer", ct.Authorization("some1"), ct.ActionData(`{"to":"some2"}`), ct.GlobalSequence(3))},
+	}, listFacetActions(t, transfers, 0x12, "some1"))
+
+	assert.Equal(t, []*actionResult(nil), listFacetActions(t, votes, 0x10, "eosio.token"))
+	assert.Equal(t, []*actionResult(nil), listAccountActions(t, votes, "some1", nil))
+}
@@ -10,7 +10,9 @@ import (
 	"go.uber.org/zap"
 )
 
-func (i *Injector) flush(ctx context.Context, blk *pbcodec.Block, lastInStreak bool) error {
+// flush writes the pending puts at a flush interval boundary or when near real time, returning
+// whether it did
+func (i *Injector) flush(ctx context.Context, blk *pbcodec.Block, lastInStreak bool) (flushed bool, err error) {
 	ctx, cancel := context.WithTimeout(ctx, accounthist.DatabaseTimeout)
 	defer cancel()
 
@@ -35,11 +37,30 @@ func (i *Injector) flush(ctx context.Context, blk *pbcodec.Block, lastInStreak b
 			writtenAt: time.Now(),
 		}
 		zlog.Debug("starting force flush", zap.Uint64("block_num", blk.Num()))
-		return i.ForceFlush(ctx)
+		return true, i.ForceFlush(ctx)
 	}
-	return nil
+	return false, nil
 }
 
 func (i *Injector) ForceFlush(ctx context.Context) error {
 	return i.KvStore.FlushPuts(ctx)
 }
+
+type unflushedBlock struct {
+	id      string
+	written []*accounthist.LiveAction
+}
+
+// notifyFlushedBlocks tells the live hub that the blocks processed since the last flush are
+// irreversible, it must only be called once their actions are flushed so a stream resuming
+// from one of their cursors finds them in the store
+func (i *Injector) notifyFlushedBlocks() {
+	if i.liveHub == nil {
+		return
+	}
+
+	for _, block := range i.unflushedBlocks {
+		i.liveHub.IrreversibleBlock(block.id, block.written)
+	}
+	i.unflushedBlocks = nil
+}
@@ -96,6 +96,25 @@ func setupAccountContractInjector(kvStore store.KVStore, shardNum byte, maxEntri
 	return i
 }
 
+func setupFacetInjector(t *testing.T, kvStore store.KVStore, shardNum byte, maxEntries uint64, collection byte, filter string) *Injector {
+	facetFactory, err := accounthist.NewCELFacetFactory(collection, filter)
+	require.NoError(t, err)
+
+	i := NewInjector(
+		NewRWCache(kvStore),
+		nil,
+		nil,
+		shardNum,
+		maxEntries,
+		1,
+		0,
+		0,
+		nil)
+	i.lastCheckpoint = &pbaccounthist.ShardCheckpoint{}
+	i.SetFacetFactory(facetFactory)
+	return i
+}
+
 func streamBlocks(t *testing.T, s *Injector, blocks ...*pbcodec.Block) {
 	preprocessor := PreprocessingFunc(s.BlockFilter)
 
@@ -118,7 +137,21 @@ func listAccountActions(t *testing.T, s *Injector, act string, cursor *pbaccount
 	// TODO: this is kinda ugly maybe should cast the interace
 
 	server := grpc.Server{KVStore: s.KvStore, MaxEntries: s.MaxEntries}
-	err := server.StreamAccountActions(ctx, eos.MustStringToName(act), 1000, nil, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+	err := server.ScanAccountActions(ctx, eos.MustStringToName(act), 1000, nil, nil, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+		cursorStr := fmt.Sprintf("%x:%02x:%d", cursor.Key, byte(cursor.ShardNum), cursor.SequenceNumber)
+		out = append(out, &actionResult{cursor: cursorStr, actionTrace: actionTrace})
+		return nil
+	})
+	require.NoError(t, err)
+
+	return out
+}
+
+func listFacetActions(t *testing.T, s *Injector, collection byte, act string) (out []*actionResult) {
+	ctx := context.Background()
+
+	server := grpc.Server{KVStore: s.KvStore, MaxEntries: s.MaxEntries}
+	err := server.ScanCollectionAccountActions(ctx, collection, eos.MustStringToName(act), 1000, nil, nil, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
 		cursorStr := fmt.Sprintf("%x:%02x:%d", cursor.Key, byte(cursor.ShardNum), cursor.SequenceNumber)
 		out = append(out, &actionResult{cursor: cursorStr, actionTrace: actionTrace})
 		return nil
@@ -128,12 +161,25 @@ func listAccountActions(t *testing.T, s *Injector, act string, cursor *pbaccount
 	return out
 }
 
+func listAccountActionsInRange(t *testing.T, s *Injector, act string, limit uint64, cursor *pbaccounthist.Cursor, rng *grpc.ActionsRange) (out []*pbaccounthist.ActionResponse) {
+	ctx := context.Background()
+
+	server := grpc.Server{KVStore: s.KvStore, MaxEntries: s.MaxEntries}
+	err := server.ScanAccountActions(ctx, eos.MustStringToName(act), limit, cursor, rng, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+		out = append(out, &pbaccounthist.ActionResponse{Cursor: cursor, ActionTrace: actionTrace})
+		return nil
+	})
+	require.NoError(t, err)
+
+	return out
+}
+
 func listAccountContractActions(t *testing.T, s *Injector, act, ctr string, cursor *pbaccounthist.Cursor) (out []*actionResult) {
 	ctx := context.Background()
 	// TODO: this is kinda ugly maybe should cast the interace
 
 	server := grpc.Server{KVStore: s.KvStore, MaxEntries: s.MaxEntries}
-	err := server.StreamAccountContractActions(ctx, eos.MustStringToName(act), eos.MustStringToName(ctr), 1000, nil, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
+	err := server.ScanAccountContractActions(ctx, eos.MustStringToName(act), eos.MustStringToName(ctr), 1000, nil, nil, func(cursor *pbaccounthist.Cursor, actionTrace *pbcodec.ActionTrace) error {
 		cursorStr := fmt.Sprintf("%x:%02x:%d", cursor.Key, byte(cursor.ShardNum), cursor.SequenceNumber)
 		out = append(out, &actionResult{cursor: cursorStr, actionTrace: actionTrace})
 		return nil
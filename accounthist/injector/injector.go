@@ -44,6 +44,12 @@ type Injector struct {
 
 	facetFactory accounthist.FacetFactory
 
+	liveHub        *accounthist.LiveHub
+	writtenActions []*accounthist.LiveAction
+	// unflushedBlocks are the irreversible blocks whose actions are not flushed to the store yet, the
+	// live hub is only told they are irreversible once they are
+	unflushedBlocks []*unflushedBlock
+
 	lastWrittenBlock    *lastWrittenBlock
 	currentBatchMetrics blockBatchMetrics
 	headBlockTimeDrift  *dmetrics.HeadTimeDrift
@@ -83,6 +89,13 @@ func (i *Injector) SetFacetFactory(facetFactory accounthist.FacetFactory) {
 	i.facetFactory = facetFactory
 
 }
+
+// SetLiveHub makes the injector dispatch the actions it processes to the hub's
+// subscriptions, reversible ones included.
+func (i *Injector) SetLiveHub(hub *accounthist.LiveHub) {
+	i.liveHub = hub
+}
+
 func (i *Injector) SetupMetrics(serviceName string) {
 	i.headBlockTimeDrift = metrics.NewHeadBlockTimeDrift(serviceName)
 	i.headBlockNumber = metrics.NewHeadBlockNumber(serviceName)
@@ -0,0 +1,113 @@
+package injector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
+	"github.com/dfuse-io/dfuse-eosio/accounthist/keyer"
+	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LiveHub(t *testing.T) {
+	kvStore, cleanup := getKVTestFactory(t)
+	defer cleanup()
+
+	s := setupAccountInjector(NewRWCache(kvStore), 0, 10)
+	hub := accounthist.NewLiveHub()
+	s.SetLiveHub(hub)
+
+	block2a := ct.Block(t, "00000002aa", ct.TrxTrace(t, ct.ActionTrace(t, "alice:eosio.token:transfer", ct.GlobalSequence(1))))
+	block3a := ct.Block(t, "00000003aa", ct.TrxTrace(t, ct.ActionTrace(t, "alice:eosio.token:transfer", ct.GlobalSequence(2))))
+	block3b := ct.Block(t, "00000003bb", ct.TrxTrace(t, ct.ActionTrace(t, "bob:eosio.token:transfer", ct.GlobalSequence(2))))
+
+	streamBlockSteps(t, s, forkable.StepNew, block2a)
+
+	reversible, sub := hub.Subscribe(keyer.EncodeAccountWithPrefixKey(keyer.PrefixAccount, eos.MustStringToName("alice")))
+	defer hub.Unsubscribe(sub)
+	require.Len(t, reversible, 1)
+	assert.Equal(t, uint64(1), reversible[0].ActionTrace.Receipt.GlobalSequence)
+
+	streamBlockSteps(t, s, forkable.StepNew, block3a)
+	streamBlockSteps(t, s, forkable.StepUndo, block3a)
+	streamBlockSteps(t, s, forkable.StepNew, block3b)
+	streamBlockSteps(t, s, forkable.StepIrreversible, block2a)
+
+	type liveResult struct {
+		step      pbaccounthist.ActionStep
+		globalSeq uint64
+		cursor    string
+	}
+
+	var results []liveResult
+	for len(sub.Actions()) > 0 {
+		action := <-sub.Actions()
+
+		result := liveResult{step: action.Step, globalSeq: action.ActionTrace.Receipt.GlobalSequence}
+		if action.RowKey != nil {
+			result.cursor = action.RowKey.String()
+		}
+		results = append(results, result)
+	}
+
+	assert.Equal(t, []liveResult{
+		{step: pbaccounthist.ActionStep_ACTION_STEP_NEW, globalSeq: 2},
+		{step: pbaccounthist.ActionStep_ACTION_STEP_UNDO, globalSeq: 2},
+		{step: pbaccounthist.ActionStep_ACTION_STEP_IRREVERSIBLE, globalSeq: 1, cursor: accounthist.RowKey(keyer.EncodeAccountKey(eos.MustStringToName("alice"), 0, 1)).String()},
+	}, results)
+
+	reversible, other := hub.Subscribe(keyer.EncodeAccountWithPrefixKey(keyer.PrefixAccount, eos.MustStringToName("bob")))
+	defer hub.Unsubscribe(other)
+	require.Len(t, reversible, 1)
+	assert.Equal(t, uint64(2), reversible[0].ActionTrace.Receipt.GlobalSequence)
+}
+
+func streamBlockSteps(t *testing.T, s *Injector, step forkable.StepType, blocks ...*pbcodec.Block) {
+	preprocessor := PreprocessingFunc(s.BlockFilter)
+
+	for _, block := range blocks {
+		blk := ct.ToBstreamBlock(t, block)
+		obj, err := preprocessor(blk)
+		require.NoError(t, err)
+
+		require.NoError(t, s.ProcessBlock(blk, &forkable.ForkableObject{Obj: obj, Step: step}))
+	}
+}
+
+func Test_LiveHub_IrreversibleOnceFlushed(t *testing.T) {
+	kvStore, cleanup := getKVTestFactory(t)
+	defer cleanup()
+
+	s := setupAccountInjector(NewRWCache(kvStore), 0, 10)
+	s.flushBlocksInterval = 3
+	hub := accounthist.NewLiveHub()
+	s.SetLiveHub(hub)
+
+	block2a := ct.Block(t, "00000002aa", ct.TrxTrace(t, ct.ActionTrace(t, "alice:eosio.token:transfer", ct.GlobalSequence(1))))
+	block3a := ct.Block(t, "00000003aa", ct.TrxTrace(t, ct.ActionTrace(t, "alice:eosio.token:transfer", ct.GlobalSequence(2))))
+
+	_, sub := hub.Subscribe(keyer.EncodeAccountWithPrefixKey(keyer.PrefixAccount, eos.MustStringToName("alice")))
+	defer hub.Unsubscribe(sub)
+
+	streamBlockSteps(t, s, forkable.StepIrreversible, block2a)
+	assert.Len(t, sub.Actions(), 0, "block 2 is not flushed yet, it must not be notified")
+
+	streamBlockSteps(t, s, forkable.StepIrreversible, block3a)
+	require.Len(t, sub.Actions(), 2)
+
+	for _, expectedGlobalSeq := range []uint64{1, 2} {
+		action := <-sub.Actions()
+		assert.Equal(t, pbaccounthist.ActionStep_ACTION_STEP_IRREVERSIBLE, action.Step)
+		assert.Equal(t, expectedGlobalSeq, action.ActionTrace.Receipt.GlobalSequence)
+
+		// the cursor of a notified action resumes from the store
+		_, err := kvStore.Get(context.Background(), action.RowKey)
+		require.NoError(t, err)
+	}
+}
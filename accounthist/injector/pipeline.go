@@ -39,10 +39,10 @@ func (i *Injector) setupPipeline(startProcessingBlockNum, fileSourceStartBlockNu
 		zap.String("gate_type", gateType.String()),
 	)
 
-	// WARN: this is IRREVERSIBLE ONLY
+	// WARN: only irreversible blocks are written, reversible steps feed the live hub
 	options := []forkable.Option{
 		forkable.WithLogger(zlog),
-		forkable.WithFilters(forkable.StepNew | forkable.StepIrreversible),
+		forkable.WithFilters(forkable.StepNew | forkable.StepUndo | forkable.StepRedo | forkable.StepIrreversible),
 	}
 
 	if fileSourceStartBlockId != "" {
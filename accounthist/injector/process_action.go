@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
@@ -16,14 +17,7 @@ func (i *Injector) processAction(ctx context.Context, blk *bstream.Block, act *p
 		return nil
 	}
 
-	accts := map[string]bool{
-		act.Receiver: true,
-	}
-	for _, v := range act.Action.Authorization {
-		accts[v.Actor] = true
-	}
-
-	for acct := range accts {
+	for acct := range actionAccounts(act) {
 		acctUint := eos.MustStringToName(acct)
 
 		facet := i.facetFactory.NewFacet(blk, act, acctUint)
@@ -65,6 +59,29 @@ func (i *Injector) processAction(ctx context.Context, blk *bstream.Block, act *p
 		acctSeqData.LastGlobalSeq = act.Receipt.GlobalSequence
 
 		i.UpdateSeqData(facet, acctSeqData)
+
+		if i.liveHub != nil {
+			i.writtenActions = append(i.writtenActions, &accounthist.LiveAction{
+				FacetKey:    facet.Bytes(),
+				RowKey:      facet.Row(i.ShardNum, acctSeqData.CurrentOrdinal),
+				ShardNum:    i.ShardNum,
+				SeqNum:      acctSeqData.CurrentOrdinal,
+				ActionTrace: act,
+			})
+		}
 	}
 	return nil
 }
+
+// actionAccounts returns the accounts an action is part of the history of, its
+// receiver and its authorizers.
+func actionAccounts(act *pbcodec.ActionTrace) map[string]bool {
+	accts := map[string]bool{
+		act.Receiver: true,
+	}
+	for _, v := range act.Action.Authorization {
+		accts[v.Actor] = true
+	}
+
+	return accts
+}
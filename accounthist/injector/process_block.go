@@ -7,7 +7,10 @@ import (
 
 	"github.com/dfuse-io/bstream"
 	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/accounthist"
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 )
 
@@ -28,6 +31,20 @@ func (i *Injector) ProcessBlock(blk *bstream.Block, obj interface{}) error {
 			i.headBlockTimeDrift.SetBlockTime(blkTime)
 		}
 
+		if i.liveHub != nil {
+			i.liveHub.PushBlock(pbaccounthist.ActionStep_ACTION_STEP_NEW, block.Id, i.liveActions(blk, block))
+		}
+
+	case forkable.StepRedo:
+		if i.liveHub != nil {
+			i.liveHub.PushBlock(pbaccounthist.ActionStep_ACTION_STEP_REDO, block.Id, i.liveActions(blk, block))
+		}
+
+	case forkable.StepUndo:
+		if i.liveHub != nil {
+			i.liveHub.UndoBlock(block.Id)
+		}
+
 	case forkable.StepIrreversible:
 		rawTraceMap := fObj.Obj.(map[uint64][]byte)
 		isLastInStreak := fObj.StepIndex+1 == fObj.StepCount
@@ -41,6 +58,7 @@ func (i *Injector) ProcessBlock(blk *bstream.Block, obj interface{}) error {
 				i.Shutdown(err)
 				return fmt.Errorf("flushing when stopping: %w", err)
 			}
+			i.notifyFlushedBlocks()
 
 			i.Shutdown(nil)
 			return nil
@@ -69,10 +87,20 @@ func (i *Injector) ProcessBlock(blk *bstream.Block, obj interface{}) error {
 			return fmt.Errorf("error while saving block checkpoint")
 		}
 
-		if err := i.flush(ctx, block, isLastInStreak); err != nil {
+		if i.liveHub != nil {
+			i.unflushedBlocks = append(i.unflushedBlocks, &unflushedBlock{id: block.Id, written: i.writtenActions})
+			i.writtenActions = nil
+		}
+
+		flushed, err := i.flush(ctx, block, isLastInStreak)
+		if err != nil {
 			return fmt.Errorf("error while flushing: %w", err)
 		}
 
+		if flushed {
+			i.notifyFlushedBlocks()
+		}
+
 		i.currentBatchMetrics.blockCount++
 		if (blk.Number % 1000) == 0 {
 			opts := i.currentBatchMetrics.dump()
@@ -92,3 +120,27 @@ func (i *Injector) ProcessBlock(blk *bstream.Block, obj interface{}) error {
 
 	return nil
 }
+
+// liveActions lists, for each facet, the actions of the block that are written once
+// the block becomes irreversible.
+func (i *Injector) liveActions(blk *bstream.Block, block *pbcodec.Block) (out []*accounthist.LiveAction) {
+	for _, trxTrace := range block.TransactionTraces() {
+		if trxTrace.HasBeenReverted() {
+			continue
+		}
+
+		actionMatcher := block.FilteringActionMatcher(trxTrace)
+		for _, act := range trxTrace.ActionTraces {
+			if !actionMatcher.Matched(act.ExecutionIndex) || act.Receipt == nil || !i.facetFactory.ActionFilter(act) {
+				continue
+			}
+
+			for acct := range actionAccounts(act) {
+				facet := i.facetFactory.NewFacet(blk, act, eos.MustStringToName(acct))
+				out = append(out, &accounthist.LiveAction{FacetKey: facet.Bytes(), ActionTrace: act})
+			}
+		}
+	}
+
+	return out
+}
@@ -10,6 +10,10 @@ const (
 	PrefixAccountContract           = byte(0x04)
 	PrefixAccountContractCheckpoint = byte(0x05)
 
+	// Facets defined through configuration each get their own collection, starting at this
+	// prefix. A collection uses two prefixes, its own for rows and the next one for checkpoints.
+	PrefixCustomFacetStart = byte(0x10)
+
 	TokenPrefixLen      = 17
 	AccountPrefixKeyLen = 9
 	AccountKeyLen       = 18
@@ -66,9 +70,13 @@ func EncodeAccountPrefixKey(account uint64) []byte {
 }
 
 func EncodeAccountKey(account uint64, shardNum byte, ordinalNumber uint64) []byte {
+	return EncodeAccountKeyWithPrefix(PrefixAccount, account, shardNum, ordinalNumber)
+}
+
+func EncodeAccountKeyWithPrefix(prefix byte, account uint64, shardNum byte, ordinalNumber uint64) []byte {
 	key := make([]byte, AccountKeyLen)
 
-	key[0] = PrefixAccount
+	key[0] = prefix
 
 	binary.BigEndian.PutUint64(key[1:], account)
 
@@ -101,6 +109,13 @@ func EncodeAccountContractCheckpointKey(shardNum byte) []byte {
 	return key
 }
 
+func EncodeCheckpointKeyWithPrefix(prefix byte, shardNum byte) []byte {
+	key := make([]byte, CheckpointLen)
+	key[0] = prefix
+	key[1] = shardNum
+	return key
+}
+
 func DecodeCheckpointKey(key []byte) byte {
 	_ = key[CheckpointLen-1] //bounds check
 	return key[1]
@@ -0,0 +1,179 @@
+package accounthist
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"go.uber.org/zap"
+)
+
+var ErrSubscriptionTooSlow = errors.New("subscription too slow to consume live actions")
+
+const liveSubscriptionBufferSize = 1000
+
+// LiveAction is an action seen by the injector for a given facet. Irreversible
+// live actions are the ones that were written, they carry their row key.
+type LiveAction struct {
+	Step        pbaccounthist.ActionStep
+	FacetKey    []byte
+	RowKey      RowKey
+	ShardNum    byte
+	SeqNum      uint64
+	ActionTrace *pbcodec.ActionTrace
+}
+
+type liveBlock struct {
+	id      string
+	actions []*LiveAction
+}
+
+// LiveHub dispatches the actions processed by the injector to the subscriptions
+// of the matching facet. It keeps the actions of reversible blocks so that new
+// subscriptions start with a complete view of the chain segment above the last
+// irreversible block.
+type LiveHub struct {
+	lock sync.Mutex
+
+	reversibleBlocks []*liveBlock
+	subscriptions    map[*LiveSubscription]bool
+}
+
+func NewLiveHub() *LiveHub {
+	return &LiveHub{
+		subscriptions: make(map[*LiveSubscription]bool),
+	}
+}
+
+type LiveSubscription struct {
+	facetKey []byte
+	actions  chan *LiveAction
+
+	closeOnce sync.Once
+	err       error
+}
+
+// Actions returns the channel receiving the live actions of the subscription, it
+// is closed when the subscription is removed, see `Err` for the reason.
+func (s *LiveSubscription) Actions() <-chan *LiveAction {
+	return s.actions
+}
+
+func (s *LiveSubscription) Err() error {
+	return s.err
+}
+
+func (s *LiveSubscription) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.actions)
+	})
+}
+
+// Subscribe registers a subscription to the facet's live actions and returns the
+// actions of the reversible blocks currently known, in chain order.
+func (h *LiveHub) Subscribe(facetKey []byte) (reversible []*LiveAction, sub *LiveSubscription) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for _, block := range h.reversibleBlocks {
+		for _, action := range block.actions {
+			if bytes.Equal(action.FacetKey, facetKey) {
+				reversible = append(reversible, action)
+			}
+		}
+	}
+
+	sub = &LiveSubscription{
+		facetKey: facetKey,
+		actions:  make(chan *LiveAction, liveSubscriptionBufferSize),
+	}
+	h.subscriptions[sub] = true
+
+	return reversible, sub
+}
+
+func (h *LiveHub) Unsubscribe(sub *LiveSubscription) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	delete(h.subscriptions, sub)
+	sub.close(nil)
+}
+
+// PushBlock records the actions of a new (or redone) reversible block and
+// dispatches them.
+func (h *LiveHub) PushBlock(step pbaccounthist.ActionStep, blockID string, actions []*LiveAction) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.reversibleBlocks = append(h.reversibleBlocks, &liveBlock{id: blockID, actions: actions})
+	h.dispatch(step, actions)
+}
+
+// UndoBlock forgets the actions of a block that was forked out and dispatches
+// their undo, in reverse order.
+func (h *LiveHub) UndoBlock(blockID string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	block := h.removeBlock(blockID)
+	if block == nil {
+		return
+	}
+
+	undone := make([]*LiveAction, len(block.actions))
+	for i, action := range block.actions {
+		undone[len(undone)-1-i] = action
+	}
+
+	h.dispatch(pbaccounthist.ActionStep_ACTION_STEP_UNDO, undone)
+}
+
+// IrreversibleBlock forgets the block's reversible actions and dispatches the
+// actions written for it.
+func (h *LiveHub) IrreversibleBlock(blockID string, written []*LiveAction) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.removeBlock(blockID)
+	h.dispatch(pbaccounthist.ActionStep_ACTION_STEP_IRREVERSIBLE, written)
+}
+
+func (h *LiveHub) removeBlock(blockID string) *liveBlock {
+	for i, block := range h.reversibleBlocks {
+		if block.id == blockID {
+			h.reversibleBlocks = append(h.reversibleBlocks[:i], h.reversibleBlocks[i+1:]...)
+			return block
+		}
+	}
+
+	return nil
+}
+
+func (h *LiveHub) dispatch(step pbaccounthist.ActionStep, actions []*LiveAction) {
+	if len(h.subscriptions) == 0 {
+		return
+	}
+
+	for _, action := range actions {
+		stepped := *action
+		stepped.Step = step
+
+		for sub := range h.subscriptions {
+			if !bytes.Equal(sub.facetKey, action.FacetKey) {
+				continue
+			}
+
+			select {
+			case sub.actions <- &stepped:
+			default:
+				zlog.Info("live subscription too slow, closing it", zap.String("facet_key", RowKey(sub.facetKey).String()))
+				delete(h.subscriptions, sub)
+				sub.close(ErrSubscriptionTooSlow)
+			}
+		}
+	}
+}
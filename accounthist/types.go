@@ -20,6 +20,7 @@ type AccounthistMode string
 const (
 	AccounthistModeAccount         AccounthistMode = "account"
 	AccounthistModeAccountContract AccounthistMode = "account-contract"
+	AccounthistModeFacet           AccounthistMode = "facet"
 )
 
 type RowKeyDecoderFunc func(key []byte) (Facet, byte, uint64)
@@ -23,7 +23,9 @@ func init() {
 		RegisterFlags: func(cmd *cobra.Command) error {
 			cmd.Flags().String("accounthist-grpc-listen-addr", AccountHistGRPCServingAddr, "Address to listen for incoming gRPC requests")
 			cmd.Flags().String("accounthist-dsn", AccountHistDSN, "kvdb connection string to the accoun thistory database.")
-			cmd.Flags().String("accounthist-mode", "account", "Accounthist mode configuration. One of: 'account' or 'account-contract'")
+			cmd.Flags().String("accounthist-mode", "account", "Accounthist mode configuration. One of: 'account', 'account-contract' or 'facet'")
+			cmd.Flags().Int("accounthist-facet-collection", 0x10, "[FACET] Collection prefix byte of the custom facet, must be even and at least 0x10, each facet sharing the database needs its own")
+			cmd.Flags().String("accounthist-facet-filter", "", "[FACET] CEL filter selecting the actions kept in the custom facet history, e.g. `account == 'eosio' && action == 'voteproducer'`, empty keeps all actions")
 			cmd.Flags().Int("accounthist-shard-num", 0, "[BATCH] Shard number, between 0 and 255 inclusive. Keep default for live process")
 			cmd.Flags().Int("accounthist-max-entries-per-key", 1000, "Number of actions to keep in history for each key")
 			cmd.Flags().Int("accounthist-flush-blocks-interval", 1000, "Flush to storage each X blocks.  Use 1 when live. Use a high number in batch, serves as checkpointing between restarts.")
@@ -42,6 +44,11 @@ func init() {
 				return nil, fmt.Errorf("--accounthist-shard-num must be between 0 and 255 inclusively")
 			}
 
+			facetCollection := viper.GetInt("accounthist-facet-collection")
+			if facetCollection < 0 || facetCollection > 255 {
+				return nil, fmt.Errorf("--accounthist-facet-collection must be between 0 and 255 inclusively")
+			}
+
 			flushBlocksInterval := viper.GetUint64("accounthist-flush-blocks-interval")
 			if flushBlocksInterval == 0 {
 				return nil, fmt.Errorf("--accounthist-flush-blocks-interval must be above zero")
@@ -52,6 +59,7 @@ func init() {
 				GRPCListenAddr:      viper.GetString("accounthist-grpc-listen-addr"),
 				BlocksStoreURL:      mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url")),
 				BlockstreamAddr:     blockstreamAddr,
+				BlockmetaAddr:       viper.GetString("common-blockmeta-addr"),
 				ShardNum:            byte(shardNum),
 				MaxEntriesPerKey:    viper.GetUint64("accounthist-max-entries-per-key"),
 				FlushBlocksInterval: flushBlocksInterval,
@@ -60,6 +68,8 @@ func init() {
 				StartBlockNum:       viper.GetUint64("accounthist-start-block-num"),
 				StopBlockNum:        viper.GetUint64("accounthist-stop-block-num"),
 				AccounthistMode:     accounthist.AccounthistMode(viper.GetString("accounthist-mode")),
+				FacetCollection:     byte(facetCollection),
+				FacetFilter:         viper.GetString("accounthist-facet-filter"),
 			}, &accounthistApp.Modules{
 				BlockFilter: runtime.BlockFilter.TransformInPlace,
 				Tracker:     runtime.Tracker,
@@ -38,6 +38,7 @@ const (
 	DgraphqlHTTPServingAddr     string = ":13023"
 	DgraphqlGRPCServingAddr     string = ":13024"
 	EoswsHTTPServingAddr        string = ":13026"
+	EoswsGRPCServingAddr        string = ":13035"
 	ForkResolverServingAddr     string = ":13027"
 	ForkResolverHTTPServingAddr string = ":13028"
 	StateDBHTTPServingAddr      string = ":13029"
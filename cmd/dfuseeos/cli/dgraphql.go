@@ -28,6 +28,7 @@ func init() {
 			cmd.Flags().String("dgraphql-tokenmeta-addr", TokenmetaGRPCServingAddr, "Tokenmeta client endpoint url")
 			cmd.Flags().String("dgraphql-accounthist-account-addr", AccountHistGRPCServingAddr, "Account history account indexed server client endpoint url, empty string disables the operation")
 			cmd.Flags().String("dgraphql-accounthist-account-contract-addr", "", "Account history account-contract indexed server client endpoint url, empty string disables the operation")
+			cmd.Flags().String("dgraphql-push-transaction-addr", EoswsGRPCServingAddr, "Push transaction (eosws) gRPC endpoint url, empty string disables the pushTransaction mutation")
 
 			return nil
 		},
@@ -42,6 +43,7 @@ func init() {
 				TokenmetaAddr:                  viper.GetString("dgraphql-tokenmeta-addr"),
 				AccountHistAccountAddr:         viper.GetString("dgraphql-accounthist-account-addr"),
 				AccountHistAccountContractAddr: viper.GetString("dgraphql-accounthist-account-contract-addr"),
+				PushTransactionAddr:            viper.GetString("dgraphql-push-transaction-addr"),
 				KVDBDSN:                        mustReplaceDataDir(dfuseDataDir, viper.GetString("common-trxdb-dsn")),
 				RatelimiterPlugin:              viper.GetString("common-ratelimiter-plugin"),
 				Config: dgraphqlApp.Config{
@@ -19,6 +19,7 @@ func init() {
 		Logger:      launcher.NewLoggingDef("github.com/dfuse-io/dfuse-eosio/eosws.*", nil),
 		RegisterFlags: func(cmd *cobra.Command) error {
 			cmd.Flags().String("eosws-http-listen-addr", EoswsHTTPServingAddr, "Address to listen for incoming http requests")
+			cmd.Flags().String("eosws-grpc-listen-addr", EoswsGRPCServingAddr, "Address to listen for incoming gRPC requests (push transaction service), leave empty to disable")
 			cmd.Flags().String("eosws-nodeos-rpc-addr", NodeosAPIAddr, "RPC endpoint of the nodeos instance")
 			cmd.Flags().StringSlice("eosws-nodeos-rpc-push-extra-addresses", nil, "List of API addresses available when retrying push-transaction that does not seem to appear")
 			cmd.Flags().Duration("eosws-realtime-tolerance", 15*time.Second, "longest delay to consider this service as real-time(ready) on initialization")
@@ -54,6 +55,7 @@ func init() {
 
 			return eoswsApp.New(&eoswsApp.Config{
 				HTTPListenAddr:              viper.GetString("eosws-http-listen-addr"),
+				GRPCListenAddr:              viper.GetString("eosws-grpc-listen-addr"),
 				NodeosRPCEndpoint:           viper.GetString("eosws-nodeos-rpc-addr"),
 				NodeosRPCPushExtraEndpoints: viper.GetStringSlice("eosws-nodeos-rpc-push-extra-addresses"),
 				BlockmetaAddr:               viper.GetString("common-blockmeta-addr"),
@@ -48,6 +48,7 @@ func init() {
 				IncludeFilterExpr:              strings.Split(viper.GetString("common-include-filter-expr"), ";;;"),
 				ExcludeFilterExpr:              strings.Split(viper.GetString("common-exclude-filter-expr"), ";;;"),
 				SystemActionsIncludeFilterExpr: strings.Split(viper.GetString("common-system-actions-include-filter-expr"), ";;;"),
+				ChainID:                        viper.GetString("common-chain-id"),
 				BlockstreamAddr:                viper.GetString("common-blockstream-addr"),
 			}), nil
 		},
@@ -15,9 +15,12 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dfuse-io/dfuse-eosio/codec"
 	"github.com/dfuse-io/dfuse-eosio/filtering"
@@ -79,15 +82,16 @@ func Start(dataDir string, args []string) (err error) {
 		return fmt.Errorf("unable to create dmesh client: %w", err)
 	}
 
-	blockFilter, err := filtering.NewBlockFilter(
-		strings.Split(viper.GetString("common-include-filter-expr"), ";;;"),
-		strings.Split(viper.GetString("common-exclude-filter-expr"), ";;;"),
-		strings.Split(viper.GetString("common-system-actions-include-filter-expr"), ";;;"),
-	)
+	blockFilter, filterSetWatcher, err := newBlockFilter(dataDirAbs)
 	if err != nil {
 		return fmt.Errorf("unable to create block filter: %w", err)
 	}
 
+	blockFilter.ChainID, err = hex.DecodeString(viper.GetString("common-chain-id"))
+	if err != nil {
+		return fmt.Errorf("unable to decode chain ID: %w", err)
+	}
+
 	zlog.Info("configured block filter", zap.Stringer("block_filter", blockFilter))
 
 	// Block meta & chain tracker
@@ -165,9 +169,53 @@ func Start(dataDir string, args []string) (err error) {
 
 	launch.WaitForTermination()
 
+	if filterSetWatcher != nil {
+		filterSetWatcher.Shutdown(nil)
+	}
+
 	return
 }
 
+// newBlockFilter creates the block filter shared by all apps, either out of the filter set file
+// (watched for changes) or out of the `--common-*-filter-expr` flags.
+func newBlockFilter(dataDirAbs string) (*filtering.BlockFilter, *filtering.FilterSetWatcher, error) {
+	filterSetURL := viper.GetString("common-filter-set-url")
+	if filterSetURL == "" {
+		blockFilter, err := filtering.NewBlockFilter(
+			strings.Split(viper.GetString("common-include-filter-expr"), ";;;"),
+			strings.Split(viper.GetString("common-exclude-filter-expr"), ";;;"),
+			strings.Split(viper.GetString("common-system-actions-include-filter-expr"), ";;;"),
+		)
+		return blockFilter, nil, err
+	}
+
+	filterSetURL = mustReplaceDataDir(dataDirAbs, filterSetURL)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	filterSet, err := filtering.LoadFilterSet(ctx, filterSetURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockFilter, err := filtering.NewBlockFilterFromSet(filterSet)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filter set version %q: %w", filterSet.Version, err)
+	}
+
+	userLog.Printf("Loaded filter set version %q from %s", filterSet.Version, filterSetURL)
+
+	reloadInterval := viper.GetDuration("common-filter-set-reload-interval")
+	if reloadInterval <= 0 {
+		return blockFilter, nil, nil
+	}
+
+	watcher := filtering.NewFilterSetWatcher(filterSetURL, reloadInterval, blockFilter, filterSet.Version)
+	go watcher.Launch()
+
+	return blockFilter, watcher, nil
+}
+
 func printWelcomeMessage(apps []string) {
 	hasDashboard := containsApp(apps, "dashboard")
 	hasAPIProxy := containsApp(apps, "apiproxy")
@@ -26,23 +26,29 @@ func init() {
 			cmd.Flags().Uint32("tokenmeta-save-every-n-block", 900, "Save the cache after N blocks processed")
 			cmd.Flags().Uint64("tokenmeta-bootstrap-block-offset", 20, "Block offset to ensure that we are not bootstrapping from statedb on a reversible fork")
 			cmd.Flags().Duration("tokenmeta-readiness-max-latency", 5*time.Minute, "Healthcheck will return NotServing until last processed block time (HEAD) is within that duration to now (0 to disable)")
+			cmd.Flags().String("tokenmeta-history-dsn", "", "kvdb connection string to the database recording token balances at each block, enables the 'at_block_num' requests (empty disables)")
+			cmd.Flags().Int("tokenmeta-balance-changes-buffer-blocks", 1800, "Number of recent blocks with balance changes kept in memory, balance changes streams can be resumed from a cursor within those blocks")
+			cmd.Flags().Bool("tokenmeta-head-mode", false, "Apply reversible blocks as they come in (undoing them on forks) to serve balances at the head block, requests can still ask for the last irreversible values. Cannot be combined with --tokenmeta-history-dsn")
 			return nil
 		},
 		FactoryFunc: func(runtime *launcher.Runtime) (app launcher.App, e error) {
 			dfuseDataDir := runtime.AbsDataDir
 
 			return tokenmetaApp.New(&tokenmetaApp.Config{
-				GRPCListenAddr:       viper.GetString("tokenmeta-grpc-listen-addr"),
-				StateDBGRPCAddr:      viper.GetString("tokenmeta-statedb-grpc-addr"),
-				BlockStreamAddr:      viper.GetString("common-blockstream-addr"),
-				ABICodecAddr:         viper.GetString("tokenmeta-abi-codec-addr"),
-				ABICacheBaseURL:      mustReplaceDataDir(dfuseDataDir, viper.GetString("tokenmeta-abis-base-url")),
-				ABICacheFileName:     viper.GetString("tokenmeta-abis-file-name"),
-				CacheFile:            mustReplaceDataDir(dfuseDataDir, viper.GetString("tokenmeta-cache-file")),
-				SaveEveryNBlock:      viper.GetUint32("tokenmeta-save-every-n-block"),
-				BlocksStoreURL:       mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url")),
-				BootstrapBlockOffset: viper.GetUint64("tokenmeta-bootstrap-block-offset"),
-				ReadinessMaxLatency:  viper.GetDuration("tokenmeta-readiness-max-latency"),
+				GRPCListenAddr:             viper.GetString("tokenmeta-grpc-listen-addr"),
+				StateDBGRPCAddr:            viper.GetString("tokenmeta-statedb-grpc-addr"),
+				BlockStreamAddr:            viper.GetString("common-blockstream-addr"),
+				ABICodecAddr:               viper.GetString("tokenmeta-abi-codec-addr"),
+				ABICacheBaseURL:            mustReplaceDataDir(dfuseDataDir, viper.GetString("tokenmeta-abis-base-url")),
+				ABICacheFileName:           viper.GetString("tokenmeta-abis-file-name"),
+				CacheFile:                  mustReplaceDataDir(dfuseDataDir, viper.GetString("tokenmeta-cache-file")),
+				SaveEveryNBlock:            viper.GetUint32("tokenmeta-save-every-n-block"),
+				BlocksStoreURL:             mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url")),
+				BootstrapBlockOffset:       viper.GetUint64("tokenmeta-bootstrap-block-offset"),
+				ReadinessMaxLatency:        viper.GetDuration("tokenmeta-readiness-max-latency"),
+				HistoryDSN:                 mustReplaceDataDir(dfuseDataDir, viper.GetString("tokenmeta-history-dsn")),
+				BalanceChangesBufferBlocks: viper.GetInt("tokenmeta-balance-changes-buffer-blocks"),
+				HeadMode:                   viper.GetBool("tokenmeta-head-mode"),
 			}, &tokenmetaApp.Modules{
 				BlockFilter: runtime.BlockFilter.TransformInPlace,
 			}), nil
@@ -106,6 +106,8 @@ func Block(t testing.T, blkID string, components ...interface{}) *pbcodec.Block
 			pbblock.UnfilteredTransactionTraces = append(pbblock.UnfilteredTransactionTraces, v)
 		case *pbcodec.TrxOp:
 			pbblock.UnfilteredImplicitTransactionOps = append(pbblock.UnfilteredImplicitTransactionOps, v)
+		case *pbcodec.RlimitOp:
+			pbblock.RlimitOps = append(pbblock.RlimitOps, v)
 		case *autoGlobalSequence:
 		case FilteredBlock:
 			// Performed at the very end
@@ -205,6 +207,12 @@ func TrxTrace(t testing.T, components ...interface{}) *pbcodec.TransactionTrace
 			trace.DbOps = append(trace.DbOps, v)
 		case *pbcodec.DTrxOp:
 			trace.DtrxOps = append(trace.DtrxOps, v)
+		case *pbcodec.PermOp:
+			trace.PermOps = append(trace.PermOps, v)
+		case *pbcodec.RAMOp:
+			trace.RamOps = append(trace.RamOps, v)
+		case *pbcodec.RlimitOp:
+			trace.RlimitOps = append(trace.RlimitOps, v)
 		case *pbcodec.TableOp:
 			trace.TableOps = append(trace.TableOps, v)
 		case pbcodec.TransactionStatus:
@@ -333,6 +341,30 @@ func ActionTraceSetABI(t testing.T, account string, abi *eos.ABI, components ...
 	return transformActionTrace(t, actTrace, components)
 }
 
+func ActionTraceSetCode(t testing.T, account string, code []byte, components ...interface{}) *pbcodec.ActionTrace {
+	setCode := &system.SetCode{Account: eos.AccountName(account), Code: eos.HexBytes(code)}
+	rawData, err := eos.MarshalBinary(setCode)
+	require.NoError(t, err)
+
+	jsonData, err := json.Marshal(setCode)
+	require.NoError(t, err)
+
+	actTrace := &pbcodec.ActionTrace{
+		Receiver: "eosio",
+		Receipt: &pbcodec.ActionReceipt{
+			Receiver: "eosio",
+		},
+		Action: &pbcodec.Action{
+			Account:  "eosio",
+			Name:     "setcode",
+			JsonData: string(jsonData),
+			RawData:  rawData,
+		},
+	}
+
+	return transformActionTrace(t, actTrace, components)
+}
+
 func transformActionTrace(t testing.T, actTrace *pbcodec.ActionTrace, components []interface{}) *pbcodec.ActionTrace {
 	ignoreIfActionComponent := ignoreComponent(func(component interface{}) bool {
 		switch component.(type) {
@@ -615,7 +647,7 @@ func PermOp(t testing.T, op string, components ...interface{}) *pbcodec.PermOp {
 		case OldPerm:
 			permOp.OldPerm = v
 		case NewPerm:
-			permOp.OldPerm = v
+			permOp.NewPerm = v
 		case ActionIndex:
 			permOp.ActionIndex = uint32(v)
 		default:
@@ -628,6 +660,12 @@ func PermOp(t testing.T, op string, components ...interface{}) *pbcodec.PermOp {
 
 type PublicKey string
 
+// PermissionIDs sets the chain ids of the permission object and of its parent
+type PermissionIDs struct {
+	ID       uint64
+	ParentID uint64
+}
+
 func Permission(t testing.T, accountPermission string, components ...interface{}) *pbcodec.PermissionObject {
 	paths := strings.Split(accountPermission, "@")
 
@@ -645,6 +683,9 @@ func Permission(t testing.T, accountPermission string, components ...interface{}
 			}
 
 			permission.Authority.Keys = append(permission.Authority.Keys, keyWeight)
+		case PermissionIDs:
+			permission.Id = v.ID
+			permission.ParentId = v.ParentID
 		default:
 			failInvalidComponent(t, "permission object", component)
 		}
@@ -653,6 +694,37 @@ func Permission(t testing.T, accountPermission string, components ...interface{}
 	return permission
 }
 
+// RAMOp creates a RAM op for `payer` which now uses `usage` bytes after applying `delta`
+func RAMOp(t testing.T, payer string, delta int64, usage uint64) *pbcodec.RAMOp {
+	return &pbcodec.RAMOp{
+		Operation: pbcodec.RAMOp_OPERATION_PRIMARY_INDEX_ADD,
+		Payer:     payer,
+		Delta:     delta,
+		Usage:     usage,
+	}
+}
+
+// RlimitOp creates a resource limits op of type `UPDATE`, the kind is inferred from
+// the type of the received `pbcodec.Rlimit*` message
+func RlimitOp(t testing.T, kind interface{}) *pbcodec.RlimitOp {
+	rlimitOp := &pbcodec.RlimitOp{Operation: pbcodec.RlimitOp_OPERATION_UPDATE}
+
+	switch v := kind.(type) {
+	case *pbcodec.RlimitAccountLimits:
+		rlimitOp.Kind = &pbcodec.RlimitOp_AccountLimits{AccountLimits: v}
+	case *pbcodec.RlimitAccountUsage:
+		rlimitOp.Kind = &pbcodec.RlimitOp_AccountUsage{AccountUsage: v}
+	case *pbcodec.RlimitConfig:
+		rlimitOp.Kind = &pbcodec.RlimitOp_Config{Config: v}
+	case *pbcodec.RlimitState:
+		rlimitOp.Kind = &pbcodec.RlimitOp_State{State: v}
+	default:
+		failInvalidComponent(t, "rlimit op", kind)
+	}
+
+	return rlimitOp
+}
+
 func TableOp(t testing.T, op string, path string, payer string) *pbcodec.TableOp {
 	paths := strings.Split(path, "/")
 
@@ -22,6 +22,7 @@ import (
 	eosResolver "github.com/dfuse-io/dfuse-eosio/dgraphql/resolvers"
 	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/dgraphql"
@@ -42,6 +43,7 @@ type Config struct {
 	TokenmetaAddr                  string
 	AccountHistAccountAddr         string
 	AccountHistAccountContractAddr string
+	PushTransactionAddr            string
 	KVDBDSN                        string
 }
 
@@ -123,8 +125,18 @@ func (f *SchemaFactory) Schemas() (*dgraphql.Schemas, error) {
 		accounthistClient.AccountContract = pbaccounthist.NewAccountContractHistoryClient(accountHistAccCtrConn)
 	}
 
+	var pushTransactionClient pbpushtrx.TransactionPusherClient
+	if f.config.PushTransactionAddr != "" {
+		zlog.Info("creating push transaction grpc client", zap.String("push_transaction_addr", f.config.PushTransactionAddr))
+		pushTransactionConn, err := dgrpc.NewInternalClient(f.config.PushTransactionAddr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create push transaction client connection: %w", err)
+		}
+		pushTransactionClient = pbpushtrx.NewTransactionPusherClient(pushTransactionConn)
+	}
+
 	zlog.Info("configuring resolver and parsing schemas")
-	resolver, err := eosResolver.NewRoot(searchRouterClient, dbReader, blockMetaClient, abiClient, rateLimiter, tokenmetaClient, accounthistClient)
+	resolver, err := eosResolver.NewRoot(searchRouterClient, dbReader, blockMetaClient, abiClient, rateLimiter, tokenmetaClient, accounthistClient, pushTransactionClient)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create root resolver: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/dfuse-io/derr"
@@ -11,19 +12,64 @@ import (
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	"github.com/dfuse-io/dgraphql"
 	"github.com/dfuse-io/dgraphql/analytics"
+	"github.com/dfuse-io/dgraphql/metrics"
 	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/logging"
 	"github.com/dfuse-io/opaque"
+	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	"github.com/eoscanada/eos-go"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	graphql "github.com/graph-gophers/graphql-go"
 	"go.uber.org/zap"
 )
 
 type GetAccountHistoryActionsArgs struct {
-	Account  string
-	Contract *string
-	Limit    types.Int64
-	Cursor   *string
+	Account       string
+	Contract      *string
+	Limit         types.Int64
+	Cursor        *string
+	Ascending     bool
+	LowBlockNum   *types.Int64
+	HighBlockNum  *types.Int64
+	LowBlockTime  *graphql.Time
+	HighBlockTime *graphql.Time
+}
+
+type accountHistoryRange struct {
+	ascending     bool
+	lowBlockNum   uint64
+	highBlockNum  uint64
+	lowBlockTime  *timestamp.Timestamp
+	highBlockTime *timestamp.Timestamp
+}
+
+func newAccountHistoryRange(args GetAccountHistoryActionsArgs) (*accountHistoryRange, error) {
+	out := &accountHistoryRange{ascending: args.Ascending}
+
+	if args.LowBlockNum != nil {
+		if *args.LowBlockNum < 0 {
+			return nil, fmt.Errorf("negative low block num %d is not valid", *args.LowBlockNum)
+		}
+		out.lowBlockNum = uint64(*args.LowBlockNum)
+	}
+
+	if args.HighBlockNum != nil {
+		if *args.HighBlockNum < 0 {
+			return nil, fmt.Errorf("negative high block num %d is not valid", *args.HighBlockNum)
+		}
+		out.highBlockNum = uint64(*args.HighBlockNum)
+	}
+
+	if args.LowBlockTime != nil {
+		out.lowBlockTime = pbblockmeta.TimestampProto(args.LowBlockTime.Time)
+	}
+
+	if args.HighBlockTime != nil {
+		out.highBlockTime = pbblockmeta.TimestampProto(args.HighBlockTime.Time)
+	}
+
+	return out, nil
 }
 
 type AccountHistoryActionsConnection struct {
@@ -54,21 +100,14 @@ func (r *Root) QueryGetAccountHistoryActions(ctx context.Context, args GetAccoun
 		return nil, err
 	}
 
-	var cursor *pbaccounthist.Cursor
-	if args.Cursor != nil {
-		cursor = &pbaccounthist.Cursor{}
-		rawCursor, err := opaque.FromOpaque(*args.Cursor)
-		if err != nil {
-			return nil, fmt.Errorf("unpacking cursor: %w", err)
-		}
-		err = proto.Unmarshal([]byte(rawCursor), cursor)
-		if err != nil {
-			return nil, fmt.Errorf("invalid or malformed cursor: %w", err)
-		}
+	cursor, err := decodeAccountHistoryCursor(args.Cursor)
+	if err != nil {
+		return nil, err
+	}
 
-		if cursor.Magic != 4374 {
-			return nil, fmt.Errorf("invalid magic number in cursor, is this a cursor obtained through this same GraphQL Query?")
-		}
+	rng, err := newAccountHistoryRange(args)
+	if err != nil {
+		return nil, dgraphql.Errorf(ctx, "invalid range: %s", err)
 	}
 
 	timeout := 30 * time.Second
@@ -81,9 +120,9 @@ func (r *Root) QueryGetAccountHistoryActions(ctx context.Context, args GetAccoun
 		if err != nil {
 			return nil, err
 		}
-		res, err = r.getAccountHistContract(queryCtx, accountUint, contractUint, int64(args.Limit), cursor)
+		res, err = r.getAccountHistContract(queryCtx, accountUint, contractUint, int64(args.Limit), cursor, rng)
 	} else {
-		res, err = r.getAccountHist(queryCtx, accountUint, int64(args.Limit), cursor)
+		res, err = r.getAccountHist(queryCtx, accountUint, int64(args.Limit), cursor, rng)
 	}
 
 	if err != nil {
@@ -116,15 +155,51 @@ func (r *Root) QueryGetAccountHistoryActions(ctx context.Context, args GetAccoun
 	return res, nil
 }
 
+func decodeAccountHistoryCursor(in *string) (*pbaccounthist.Cursor, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	cursor := &pbaccounthist.Cursor{}
+	rawCursor, err := opaque.FromOpaque(*in)
+	if err != nil {
+		return nil, fmt.Errorf("unpacking cursor: %w", err)
+	}
+	err = proto.Unmarshal([]byte(rawCursor), cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid or malformed cursor: %w", err)
+	}
+
+	if cursor.Magic != 4374 {
+		return nil, fmt.Errorf("invalid magic number in cursor, is this a cursor obtained through this same GraphQL Query?")
+	}
+
+	return cursor, nil
+}
+
+func encodeAccountHistoryCursor(cursor *pbaccounthist.Cursor) (string, error) {
+	rawCursor, err := proto.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return opaque.ToOpaque(string(rawCursor))
+}
+
 type ActionReceiver interface {
 	Recv() (*pbaccounthist.ActionResponse, error)
 }
 
-func (r *Root) getAccountHist(ctx context.Context, account uint64, limit int64, cursor *pbaccounthist.Cursor) (*AccountHistoryActionsConnection, error) {
+func (r *Root) getAccountHist(ctx context.Context, account uint64, limit int64, cursor *pbaccounthist.Cursor, rng *accountHistoryRange) (*AccountHistoryActionsConnection, error) {
 	stream, err := r.accounthistClients.Account.GetActions(ctx, &pbaccounthist.GetActionsRequest{
-		Account: account,
-		Limit:   uint32(limit + 1),
-		Cursor:  cursor,
+		Account:       account,
+		Limit:         uint32(limit + 1),
+		Cursor:        cursor,
+		Ascending:     rng.ascending,
+		LowBlockNum:   rng.lowBlockNum,
+		HighBlockNum:  rng.highBlockNum,
+		LowBlockTime:  rng.lowBlockTime,
+		HighBlockTime: rng.highBlockTime,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("accounthist stream: %w", err)
@@ -133,12 +208,17 @@ func (r *Root) getAccountHist(ctx context.Context, account uint64, limit int64,
 	return r.handleActionStream(ctx, stream, limit, cursor)
 }
 
-func (r *Root) getAccountHistContract(ctx context.Context, account, contract uint64, limit int64, cursor *pbaccounthist.Cursor) (*AccountHistoryActionsConnection, error) {
+func (r *Root) getAccountHistContract(ctx context.Context, account, contract uint64, limit int64, cursor *pbaccounthist.Cursor, rng *accountHistoryRange) (*AccountHistoryActionsConnection, error) {
 	stream, err := r.accounthistClients.AccountContract.GetAccountContractActions(ctx, &pbaccounthist.GetTokenActionsRequest{
-		Account:  account,
-		Contract: contract,
-		Limit:    uint32(limit + 1),
-		Cursor:   cursor,
+		Account:       account,
+		Contract:      contract,
+		Limit:         uint32(limit + 1),
+		Cursor:        cursor,
+		Ascending:     rng.ascending,
+		LowBlockNum:   rng.lowBlockNum,
+		HighBlockNum:  rng.highBlockNum,
+		LowBlockTime:  rng.lowBlockTime,
+		HighBlockTime: rng.highBlockTime,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("accounthist by contract stream: %w", err)
@@ -200,11 +280,10 @@ func (r *Root) handleActionStream(ctx context.Context, stream ActionReceiver, li
 			return nil, dgraphql.UnwrapError(ctx, err)
 		}
 
-		rawCursor, err := proto.Marshal(match.Cursor)
+		stringCursor, err := encodeAccountHistoryCursor(match.Cursor)
 		if err != nil {
 			return nil, err
 		}
-		stringCursor, _ := opaque.ToOpaque(string(rawCursor))
 
 		if out.PageInfo.StartCursor == "" {
 			out.PageInfo.StartCursor = stringCursor
@@ -221,3 +300,130 @@ func (r *Root) handleActionStream(ctx context.Context, stream ActionReceiver, li
 
 	return out, nil
 }
+
+type StreamAccountHistoryActionsArgs struct {
+	Account string
+	Cursor  *string
+}
+
+type AccountHistoryActionResponse struct {
+	step   pbaccounthist.ActionStep
+	cursor *string
+	trace  *ActionTrace
+	err    error
+}
+
+func (r *AccountHistoryActionResponse) SubscriptionError() error {
+	return r.err
+}
+
+func (r *AccountHistoryActionResponse) Step() string {
+	return strings.TrimPrefix(r.step.String(), "ACTION_STEP_")
+}
+
+func (r *AccountHistoryActionResponse) Cursor() *string     { return r.cursor }
+func (r *AccountHistoryActionResponse) Trace() *ActionTrace { return r.trace }
+
+func (r *Root) SubscriptionStreamAccountHistoryActions(ctx context.Context, args StreamAccountHistoryActionsArgs) (<-chan *AccountHistoryActionResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Info("stream account history actions", zap.Reflect("request", args))
+
+	if err := r.RateLimit(ctx, "accounthist"); err != nil {
+		return nil, err
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "SubscriptionStreamAccountHistoryActions", "Args", args)
+	/////////////////////////////////////////////////////////////////////////
+
+	if r.accounthistClients.Account == nil {
+		return nil, fmt.Errorf("account history not available")
+	}
+
+	account, err := eos.StringToName(args.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodeAccountHistoryCursor(args.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := r.accounthistClients.Account.StreamAccountActions(ctx, &pbaccounthist.StreamAccountActionsRequest{
+		Account: account,
+		Cursor:  cursor,
+	})
+	if err != nil {
+		zlogger.Error("failed StreamAccountActions request", zap.Error(err))
+		return nil, dgraphql.Errorf(ctx, "internal server error: connection to account history failed")
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Subscriptions
+	// WARNING : Here we only track inbound subscription init
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:        "dgraphql",
+		Kind:          "GraphQL Subscription",
+		Method:        "StreamAccountHistoryActions",
+		RequestsCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	c := make(chan *AccountHistoryActionResponse)
+	metrics.InflightSubscriptionCount.Inc()
+
+	go func() {
+		defer metrics.InflightSubscriptionCount.Dec()
+		defer close(c)
+
+		for {
+			action, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			resp := &AccountHistoryActionResponse{}
+			if err != nil {
+				zlogger.Info("error receiving message from account history stream", zap.Error(err))
+				resp.err = dgraphql.UnwrapError(ctx, err)
+			} else {
+				resp.step = action.Step
+				resp.trace = newActionTrace(action.ActionTrace, nil, r.abiCodecClient)
+				if action.Cursor != nil {
+					stringCursor, err := encodeAccountHistoryCursor(action.Cursor)
+					if err != nil {
+						resp.err = dgraphql.Errorf(ctx, "internal server error")
+					} else {
+						resp.cursor = &stringCursor
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case c <- resp:
+				if resp.err != nil {
+					return
+				}
+
+				//////////////////////////////////////////////////////////////////////
+				// Billable event on GraphQL Subscriptions
+				// WARNING : Here we only track outbound documents
+				//////////////////////////////////////////////////////////////////////
+				dmetering.EmitWithContext(dmetering.Event{
+					Source:         "dgraphql",
+					Kind:           "GraphQL Subscription",
+					Method:         "StreamAccountHistoryActions",
+					ResponsesCount: 1,
+				}, ctx)
+				//////////////////////////////////////////////////////////////////////
+			}
+		}
+	}()
+
+	return c, nil
+}
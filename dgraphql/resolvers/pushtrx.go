@@ -0,0 +1,267 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
+	"github.com/dfuse-io/dgraphql"
+	"github.com/dfuse-io/dgraphql/metrics"
+	commonTypes "github.com/dfuse-io/dgraphql/types"
+	"github.com/dfuse-io/dmetering"
+	"github.com/dfuse-io/logging"
+	"go.uber.org/zap"
+)
+
+type PushGuarantee string
+
+const (
+	PushGuaranteeInBlock      PushGuarantee = "IN_BLOCK"
+	PushGuaranteeHandoffs1    PushGuarantee = "HANDOFFS_1"
+	PushGuaranteeHandoffs2    PushGuarantee = "HANDOFFS_2"
+	PushGuaranteeHandoffs3    PushGuarantee = "HANDOFFS_3"
+	PushGuaranteeIrreversible PushGuarantee = "IRREVERSIBLE"
+)
+
+var pushGuaranteeToProto = map[PushGuarantee]pbpushtrx.Guarantee{
+	PushGuaranteeInBlock:      pbpushtrx.Guarantee_GUARANTEE_IN_BLOCK,
+	PushGuaranteeHandoffs1:    pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_1,
+	PushGuaranteeHandoffs2:    pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_2,
+	PushGuaranteeHandoffs3:    pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_3,
+	PushGuaranteeIrreversible: pbpushtrx.Guarantee_GUARANTEE_IRREVERSIBLE,
+}
+
+type PackedTransactionInput struct {
+	Signatures            []string
+	Compression           string
+	PackedContextFreeData string
+	PackedTrx             string
+}
+
+type PushTransactionArgs struct {
+	Transaction   PackedTransactionInput
+	Guarantee     PushGuarantee
+	UseLegacyPush bool
+}
+
+func (r *Root) MutationPushTransaction(ctx context.Context, args PushTransactionArgs) (*PushTransactionResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("push transaction", zap.String("guarantee", string(args.Guarantee)), zap.Bool("use_legacy_push", args.UseLegacyPush))
+
+	if err := r.RateLimit(ctx, "push_transaction"); err != nil {
+		return nil, err
+	}
+
+	if r.pushTransactionClient == nil {
+		return nil, dgraphql.Errorf(ctx, "push transaction is not available on this endpoint")
+	}
+
+	request, err := newPushTransactionRequest(args)
+	if err != nil {
+		return nil, dgraphql.Errorf(ctx, "invalid push transaction request: %s", err)
+	}
+
+	resp, err := r.pushTransactionClient.PushTransaction(ctx, request)
+	if err != nil {
+		zlogger.Info("push transaction failed", zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, err)
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Mutation - One Request, One Outbound Document
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Mutation",
+		Method:         "PushTransaction",
+		RequestsCount:  1,
+		ResponsesCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	return &PushTransactionResponse{resp: resp, abiCodecClient: r.abiCodecClient}, nil
+}
+
+func newPushTransactionRequest(args PushTransactionArgs) (*pbpushtrx.PushTransactionRequest, error) {
+	guarantee, found := pushGuaranteeToProto[args.Guarantee]
+	if !found {
+		return nil, fmt.Errorf("unknown guarantee %q", args.Guarantee)
+	}
+
+	packedTrx, err := hex.DecodeString(args.Transaction.PackedTrx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex for packed transaction: %s", err)
+	}
+
+	packedContextFreeData, err := hex.DecodeString(args.Transaction.PackedContextFreeData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex for packed context free data: %s", err)
+	}
+
+	return &pbpushtrx.PushTransactionRequest{
+		Signatures:            args.Transaction.Signatures,
+		Compression:           args.Transaction.Compression,
+		PackedContextFreeData: packedContextFreeData,
+		PackedTrx:             packedTrx,
+		Guarantee:             guarantee,
+		UseLegacyPush:         args.UseLegacyPush,
+	}, nil
+}
+
+type PushTransactionResponse struct {
+	resp           *pbpushtrx.PushTransactionResponse
+	abiCodecClient pbabicodec.DecoderClient
+}
+
+func (r *PushTransactionResponse) TransactionID() string { return r.resp.TransactionId }
+func (r *PushTransactionResponse) BlockID() string       { return r.resp.BlockId }
+func (r *PushTransactionResponse) BlockNum() commonTypes.Uint32 {
+	return commonTypes.Uint32(r.resp.BlockNum)
+}
+
+// Trace only knows the block's timestamp, the pusher does not hand back the full block header
+func (r *PushTransactionResponse) Trace() *TransactionTrace {
+	header := &pbcodec.BlockHeader{Timestamp: r.resp.Trace.BlockTime}
+	return newTransactionTrace(r.resp.Trace, header, nil, r.abiCodecClient)
+}
+
+type PushTransactionStatusArgs struct {
+	Transaction   PackedTransactionInput
+	UseLegacyPush bool
+}
+
+func (r *Root) SubscriptionPushTransactionStatus(ctx context.Context, args PushTransactionStatusArgs) (<-chan *PushTransactionStatusResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("push transaction status", zap.Bool("use_legacy_push", args.UseLegacyPush))
+
+	if err := r.RateLimit(ctx, "push_transaction"); err != nil {
+		return nil, err
+	}
+
+	if r.pushTransactionClient == nil {
+		return nil, dgraphql.Errorf(ctx, "push transaction is not available on this endpoint")
+	}
+
+	pushRequest, err := newPushTransactionRequest(PushTransactionArgs{Transaction: args.Transaction, Guarantee: PushGuaranteeInBlock})
+	if err != nil {
+		return nil, dgraphql.Errorf(ctx, "invalid push transaction request: %s", err)
+	}
+
+	stream, err := r.pushTransactionClient.PushTransactionStatus(ctx, &pbpushtrx.PushTransactionStatusRequest{
+		Signatures:            pushRequest.Signatures,
+		Compression:           pushRequest.Compression,
+		PackedContextFreeData: pushRequest.PackedContextFreeData,
+		PackedTrx:             pushRequest.PackedTrx,
+		UseLegacyPush:         args.UseLegacyPush,
+	})
+	if err != nil {
+		zlogger.Error("failed PushTransactionStatus request", zap.Error(err))
+		return nil, dgraphql.Errorf(ctx, "internal server error: connection to transaction pusher failed")
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Subscriptions
+	// WARNING : Here we only track inbound subscription init
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:        "dgraphql",
+		Kind:          "GraphQL Subscription",
+		Method:        "PushTransactionStatus",
+		RequestsCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	c := make(chan *PushTransactionStatusResponse)
+	metrics.InflightSubscriptionCount.Inc()
+
+	go func() {
+		defer metrics.InflightSubscriptionCount.Dec()
+		defer close(c)
+
+		for {
+			status, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			resp := &PushTransactionStatusResponse{resp: status, abiCodecClient: r.abiCodecClient}
+			if err != nil {
+				zlogger.Info("error receiving message from push transaction status stream", zap.Error(err))
+				resp.err = dgraphql.UnwrapError(ctx, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case c <- resp:
+				if resp.err != nil {
+					return
+				}
+
+				//////////////////////////////////////////////////////////////////////
+				// Billable event on GraphQL Subscriptions
+				// WARNING : Here we only track outbound documents
+				//////////////////////////////////////////////////////////////////////
+				dmetering.EmitWithContext(dmetering.Event{
+					Source:         "dgraphql",
+					Kind:           "GraphQL Subscription",
+					Method:         "PushTransactionStatus",
+					ResponsesCount: 1,
+				}, ctx)
+				//////////////////////////////////////////////////////////////////////
+			}
+		}
+	}()
+
+	return c, nil
+}
+
+type PushTransactionStatusResponse struct {
+	resp           *pbpushtrx.PushTransactionStatusResponse
+	abiCodecClient pbabicodec.DecoderClient
+	err            error
+}
+
+func (r *PushTransactionStatusResponse) SubscriptionError() error {
+	return r.err
+}
+
+func (r *PushTransactionStatusResponse) Stage() string {
+	return strings.TrimPrefix(r.resp.Stage.String(), "PUSH_STAGE_")
+}
+
+func (r *PushTransactionStatusResponse) TransactionID() string { return r.resp.TransactionId }
+func (r *PushTransactionStatusResponse) BlockID() *string {
+	if r.resp.BlockId == "" {
+		return nil
+	}
+	return &r.resp.BlockId
+}
+
+func (r *PushTransactionStatusResponse) BlockNum() *commonTypes.Uint32 {
+	if r.resp.BlockNum == 0 {
+		return nil
+	}
+	blockNum := commonTypes.Uint32(r.resp.BlockNum)
+	return &blockNum
+}
+
+func (r *PushTransactionStatusResponse) Handoffs() commonTypes.Uint32 {
+	return commonTypes.Uint32(r.resp.Handoffs)
+}
+
+// Trace only knows the block's timestamp, same as `PushTransactionResponse.Trace`
+func (r *PushTransactionStatusResponse) Trace() *TransactionTrace {
+	if r.resp.Trace == nil {
+		return nil
+	}
+
+	header := &pbcodec.BlockHeader{Timestamp: r.resp.Trace.BlockTime}
+	return newTransactionTrace(r.resp.Trace, header, nil, r.abiCodecClient)
+}
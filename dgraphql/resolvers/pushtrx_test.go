@@ -0,0 +1,48 @@
+package resolvers
+
+import (
+	"testing"
+
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPushTransactionRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          PushTransactionArgs
+		expected      *pbpushtrx.PushTransactionRequest
+		expectedError string
+	}{
+		{
+			"valid",
+			PushTransactionArgs{
+				Transaction: PackedTransactionInput{Signatures: []string{"SIG_K1_a"}, Compression: "none", PackedContextFreeData: "", PackedTrx: "aabb"},
+				Guarantee:   PushGuaranteeHandoffs2,
+			},
+			&pbpushtrx.PushTransactionRequest{
+				Signatures:            []string{"SIG_K1_a"},
+				Compression:           "none",
+				PackedContextFreeData: []byte{},
+				PackedTrx:             []byte{0xaa, 0xbb},
+				Guarantee:             pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_2,
+			},
+			"",
+		},
+		{"unknown guarantee", PushTransactionArgs{Guarantee: "OTHER"}, nil, `unknown guarantee "OTHER"`},
+		{"invalid packed trx", PushTransactionArgs{Transaction: PackedTransactionInput{PackedTrx: "zz"}, Guarantee: PushGuaranteeInBlock}, nil, "invalid hex for packed transaction: encoding/hex: invalid byte: U+007A 'z'"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, err := newPushTransactionRequest(test.args)
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, request)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
 	pbaccounthist "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/accounthist/v1"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
 	pbsearcheos "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/search/v1"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
@@ -66,6 +67,7 @@ type Root struct {
 	abiCodecClient                pbabicodec.DecoderClient
 	tokenmetaClient               pbtokenmeta.TokenMetaClient
 	accounthistClients            *AccounthistClient
+	pushTransactionClient         pbpushtrx.TransactionPusherClient
 	requestRateLimiter            rateLimiter.RateLimiter
 	requestRateLimiterLastLogTime time.Time
 }
@@ -78,17 +80,19 @@ func NewRoot(
 	requestRateLimiter rateLimiter.RateLimiter,
 	tokenmetaClient pbtokenmeta.TokenMetaClient,
 	accounthistClients *AccounthistClient,
+	pushTransactionClient pbpushtrx.TransactionPusherClient,
 ) (interface{}, error) {
 	return &Root{
-		searchClient:       searchClient,
-		trxsReader:         dbReader,
-		blocksReader:       dbReader,
-		accountsReader:     dbReader,
-		tokenmetaClient:    tokenmetaClient,
-		blockmetaClient:    blockMetaClient,
-		abiCodecClient:     abiCodecClient,
-		requestRateLimiter: requestRateLimiter,
-		accounthistClients: accounthistClients,
+		searchClient:          searchClient,
+		trxsReader:            dbReader,
+		blocksReader:          dbReader,
+		accountsReader:        dbReader,
+		tokenmetaClient:       tokenmetaClient,
+		blockmetaClient:       blockMetaClient,
+		abiCodecClient:        abiCodecClient,
+		requestRateLimiter:    requestRateLimiter,
+		accounthistClients:    accounthistClients,
+		pushTransactionClient: pushTransactionClient,
 	}, nil
 }
 
@@ -3,16 +3,21 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/dfuse-io/bstream"
 	"github.com/dfuse-io/dfuse-eosio/dgraphql/types"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
 	"github.com/dfuse-io/dgraphql"
+	"github.com/dfuse-io/dgraphql/metrics"
 	commonTypes "github.com/dfuse-io/dgraphql/types"
 	"github.com/dfuse-io/dmetering"
+	"github.com/dfuse-io/logging"
 	"github.com/eoscanada/eos-go"
 	"github.com/golang/protobuf/proto"
+	graphql "github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
 )
 
 type SortOrder string
@@ -31,12 +36,13 @@ const (
 )
 
 type TokensRequest struct {
-	TokenSymbols   *[]string
-	TokenContracts *[]string
-	Cursor         *string
-	Limit          *commonTypes.Uint32
-	SortField      TokensRequestSortField
-	SortOrder      SortOrder
+	TokenSymbols     *[]string
+	TokenContracts   *[]string
+	Cursor           *string
+	Limit            *commonTypes.Uint32
+	SortField        TokensRequestSortField
+	SortOrder        SortOrder
+	IrreversibleOnly bool
 }
 
 func (r *Root) QueryTokens(ctx context.Context, args *TokensRequest) (*TokenConnection, error) {
@@ -44,8 +50,9 @@ func (r *Root) QueryTokens(ctx context.Context, args *TokensRequest) (*TokenConn
 		return nil, err
 	}
 	request := &pbtokenmeta.GetTokensRequest{
-		SortOrder: pbtokenmeta.SortOrder(pbtokenmeta.SortOrder_value[string(args.SortOrder)]),
-		SortField: pbtokenmeta.GetTokensRequest_SortField(pbtokenmeta.GetTokensRequest_SortField_value[string(args.SortField)]),
+		SortOrder:        pbtokenmeta.SortOrder(pbtokenmeta.SortOrder_value[string(args.SortOrder)]),
+		SortField:        pbtokenmeta.GetTokensRequest_SortField(pbtokenmeta.GetTokensRequest_SortField_value[string(args.SortField)]),
+		IrreversibleOnly: args.IrreversibleOnly,
 	}
 
 	if args.TokenContracts != nil {
@@ -106,7 +113,7 @@ func (r *Root) QueryTokens(ctx context.Context, args *TokensRequest) (*TokenConn
 
 	edges := []*TokenEdge{}
 	for _, item := range eosTokens {
-		edges = append(edges, newTokenEdge(newToken(item), dgraphql.MustProtoToOpaqueCursor(&pbtokenmeta.TokenCursor{
+		edges = append(edges, newTokenEdge(newToken(item, r), dgraphql.MustProtoToOpaqueCursor(&pbtokenmeta.TokenCursor{
 			Ver:      1,
 			Contract: item.Contract,
 			Symbol:   item.Symbol,
@@ -150,14 +157,16 @@ const (
 )
 
 type AccountBalancesRequest struct {
-	Account        string
-	TokenSymbols   *[]string
-	TokenContracts *[]string
-	Cursor         *string
-	Limit          *commonTypes.Uint32
-	Options        *[]AccountBalanceOption
-	SortField      AccountBalancesRequestSortField
-	SortOrder      SortOrder
+	Account          string
+	TokenSymbols     *[]string
+	TokenContracts   *[]string
+	Cursor           *string
+	Limit            *commonTypes.Uint32
+	Options          *[]AccountBalanceOption
+	SortField        AccountBalancesRequestSortField
+	SortOrder        SortOrder
+	AtBlockNum       *commonTypes.Uint32
+	IrreversibleOnly bool
 }
 
 func (r *Root) QueryAccountBalances(ctx context.Context, args *AccountBalancesRequest) (*AccountBalanceConnection, error) {
@@ -165,10 +174,11 @@ func (r *Root) QueryAccountBalances(ctx context.Context, args *AccountBalancesRe
 		return nil, err
 	}
 	request := &pbtokenmeta.GetAccountBalancesRequest{
-		Account:   args.Account,
-		Options:   []pbtokenmeta.GetAccountBalancesRequest_Option{},
-		SortOrder: pbtokenmeta.SortOrder(pbtokenmeta.SortOrder_value[string(args.SortOrder)]),
-		SortField: pbtokenmeta.GetAccountBalancesRequest_SortField(pbtokenmeta.GetAccountBalancesRequest_SortField_value[string(args.SortField)]),
+		Account:          args.Account,
+		Options:          []pbtokenmeta.GetAccountBalancesRequest_Option{},
+		SortOrder:        pbtokenmeta.SortOrder(pbtokenmeta.SortOrder_value[string(args.SortOrder)]),
+		SortField:        pbtokenmeta.GetAccountBalancesRequest_SortField(pbtokenmeta.GetAccountBalancesRequest_SortField_value[string(args.SortField)]),
+		IrreversibleOnly: args.IrreversibleOnly,
 	}
 
 	if args.TokenContracts != nil {
@@ -179,6 +189,10 @@ func (r *Root) QueryAccountBalances(ctx context.Context, args *AccountBalancesRe
 		request.FilterTokenSymbols = *args.TokenSymbols
 	}
 
+	if args.AtBlockNum != nil {
+		request.AtBlockNum = uint64(*args.AtBlockNum)
+	}
+
 	if args.Options != nil {
 		for _, option := range *args.Options {
 			if o, ok := pbtokenmeta.GetAccountBalancesRequest_Option_value[string(option)]; ok {
@@ -277,14 +291,16 @@ const (
 )
 
 type TokenBalancesRequest struct {
-	Contract     string
-	Symbol       string
-	TokenHolders *[]string
-	Cursor       *string
-	Limit        *commonTypes.Uint32
-	Options      *[]AccountBalanceOption
-	SortField    TokenBalancesRequestSortField
-	SortOrder    SortOrder
+	Contract         string
+	Symbol           string
+	TokenHolders     *[]string
+	Cursor           *string
+	Limit            *commonTypes.Uint32
+	Options          *[]AccountBalanceOption
+	SortField        TokenBalancesRequestSortField
+	SortOrder        SortOrder
+	AtBlockNum       *commonTypes.Uint32
+	IrreversibleOnly bool
 }
 
 func (r *Root) QueryTokenBalances(ctx context.Context, args *TokenBalancesRequest) (*AccountBalanceConnection, error) {
@@ -297,12 +313,17 @@ func (r *Root) QueryTokenBalances(ctx context.Context, args *TokenBalancesReques
 		SortOrder:          pbtokenmeta.SortOrder(pbtokenmeta.SortOrder_value[string(args.SortOrder)]),
 		SortField:          pbtokenmeta.GetTokenBalancesRequest_SortField(pbtokenmeta.GetTokenBalancesRequest_SortField_value[string(args.SortField)]),
 		FilterTokenSymbols: []string{args.Symbol},
+		IrreversibleOnly:   args.IrreversibleOnly,
 	}
 
 	if args.TokenHolders != nil {
 		request.FilterHolderAccounts = *args.TokenHolders
 	}
 
+	if args.AtBlockNum != nil {
+		request.AtBlockNum = uint64(*args.AtBlockNum)
+	}
+
 	if args.Options != nil {
 		for _, option := range *args.Options {
 			if o, ok := pbtokenmeta.GetTokenBalancesRequest_Option_value[string(option)]; ok {
@@ -464,12 +485,14 @@ func (b *BlockRef) Id() string {
 // Token
 //----------------------------
 type Token struct {
-	t *pbtokenmeta.Token
+	t    *pbtokenmeta.Token
+	root *Root
 }
 
-func newToken(t *pbtokenmeta.Token) *Token {
+func newToken(t *pbtokenmeta.Token, root *Root) *Token {
 	return &Token{
-		t: t,
+		t:    t,
+		root: root,
 	}
 }
 
@@ -478,6 +501,7 @@ func (t *Token) Symbol() string                { return t.t.Symbol }
 func (t *Token) Precision() commonTypes.Uint32 { return commonTypes.Uint32(t.t.Precision) }
 func (t *Token) Issuer() string                { return t.t.Issuer }
 func (t *Token) Holders() types.Uint64         { return types.Uint64(t.t.Holders) }
+func (t *Token) Standard() string              { return t.t.Standard }
 func (t *Token) MaximumSupply(args *AssetArgs) string {
 	return assetToString(t.t.MaximumSupply, t.t.Precision, t.t.Symbol, args)
 }
@@ -485,6 +509,103 @@ func (t *Token) TotalSupply(args *AssetArgs) string {
 	return assetToString(t.t.MaximumSupply, t.t.Precision, t.t.Symbol, args)
 }
 
+type TokenStatsArgs struct {
+	TopHoldersLimit commonTypes.Uint32
+}
+
+func (t *Token) Stats(ctx context.Context, args *TokenStatsArgs) (*TokenStats, error) {
+	if err := t.root.RateLimit(ctx, "token"); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.root.tokenmetaClient.GetTokenStats(ctx, &pbtokenmeta.GetTokenStatsRequest{
+		TokenContract:   t.t.Contract,
+		Symbol:          t.t.Symbol,
+		TopHoldersLimit: uint32(args.TopHoldersLimit),
+	})
+	if err != nil {
+		return nil, dgraphql.UnwrapError(ctx, err)
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, Many Outbound Documents ???
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         "TokenStats",
+		RequestsCount:  1,
+		ResponsesCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	return &TokenStats{s: resp}, nil
+}
+
+//----------------------------
+// Token Stats
+//----------------------------
+type TokenStats struct {
+	s *pbtokenmeta.TokenStats
+}
+
+func (s *TokenStats) Transfers24h() *TokenTransferStats {
+	return &TokenTransferStats{s: s.s.Transfers_24H, token: s.s.Token}
+}
+func (s *TokenStats) Transfers7d() *TokenTransferStats {
+	return &TokenTransferStats{s: s.s.Transfers_7D, token: s.s.Token}
+}
+func (s *TokenStats) TopHolders() (out []*TokenHolder) {
+	out = []*TokenHolder{}
+	for _, holder := range s.s.TopHolders {
+		out = append(out, &TokenHolder{h: holder})
+	}
+	return
+}
+func (s *TokenStats) SupplyChanges() (out []*TokenSupplyChange) {
+	out = []*TokenSupplyChange{}
+	for _, change := range s.s.SupplyChanges {
+		out = append(out, &TokenSupplyChange{c: change, token: s.s.Token})
+	}
+	return
+}
+func (s *TokenStats) SinceBlockNum() types.Uint64 { return types.Uint64(s.s.SinceBlockNum) }
+func (s *TokenStats) BlockRef() *BlockRef         { return newBlockRef(s.s.AtBlockId, s.s.AtBlockNum) }
+
+type TokenTransferStats struct {
+	s     *pbtokenmeta.TransferStats
+	token *pbtokenmeta.Token
+}
+
+func (s *TokenTransferStats) Count() types.Uint64           { return types.Uint64(s.s.Count) }
+func (s *TokenTransferStats) UniqueSenders() types.Uint64   { return types.Uint64(s.s.UniqueSenders) }
+func (s *TokenTransferStats) UniqueReceivers() types.Uint64 { return types.Uint64(s.s.UniqueReceivers) }
+func (s *TokenTransferStats) Complete() bool                { return s.s.Complete }
+func (s *TokenTransferStats) Volume(args *AssetArgs) string {
+	return assetToString(s.s.Volume, s.token.Precision, s.token.Symbol, args)
+}
+
+type TokenHolder struct {
+	h *pbtokenmeta.TokenHolder
+}
+
+func (h *TokenHolder) Balance() *AccountBalance { return newAccountBalance(h.h.Balance) }
+func (h *TokenHolder) SupplyPercent() float64   { return h.h.SupplyPercent }
+
+type TokenSupplyChange struct {
+	c     *pbtokenmeta.SupplyChange
+	token *pbtokenmeta.Token
+}
+
+func (c *TokenSupplyChange) Type() string            { return c.c.Type.String() }
+func (c *TokenSupplyChange) BlockRef() *BlockRef     { return newBlockRef(c.c.BlockId, c.c.BlockNum) }
+func (c *TokenSupplyChange) BlockTime() graphql.Time { return toTime(c.c.BlockTime) }
+func (c *TokenSupplyChange) TransactionID() string   { return c.c.TransactionId }
+func (c *TokenSupplyChange) Amount(args *AssetArgs) string {
+	return assetToString(c.c.Amount, c.token.Precision, c.token.Symbol, args)
+}
+
 //---------------------------
 // Account Balance Connection
 //----------------------------
@@ -546,6 +667,7 @@ func (a *AccountBalance) Account() string               { return a.a.Account }
 func (a *AccountBalance) Amount() types.Uint64          { return types.Uint64(a.a.Amount) }
 func (a *AccountBalance) Symbol() string                { return a.a.Symbol }
 func (a *AccountBalance) Precision() commonTypes.Uint32 { return commonTypes.Uint32(a.a.Precision) }
+func (a *AccountBalance) Reversible() bool              { return a.a.Reversible }
 func (a *AccountBalance) Balance(args *AssetArgs) string {
 	return assetToString(a.a.Amount, a.a.Precision, a.a.Symbol, args)
 }
@@ -639,3 +761,134 @@ func assetToString(amount uint64, precision uint32, symbol string, args *AssetAr
 	}
 	return fmt.Sprintf("%s", result)
 }
+
+type StreamBalanceChangesArgs struct {
+	Accounts       *[]string
+	TokenContracts *[]string
+	TokenSymbols   *[]string
+	Cursor         *string
+}
+
+func (r *Root) SubscriptionStreamBalanceChanges(ctx context.Context, args *StreamBalanceChangesArgs) (<-chan *BalanceChangeResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Info("stream balance changes", zap.Reflect("request", args))
+
+	if err := r.RateLimit(ctx, "token"); err != nil {
+		return nil, err
+	}
+
+	request := &pbtokenmeta.StreamBalanceChangesRequest{}
+	if args.Accounts != nil {
+		request.FilterAccounts = *args.Accounts
+	}
+	if args.TokenContracts != nil {
+		request.FilterTokenContracts = *args.TokenContracts
+	}
+	if args.TokenSymbols != nil {
+		request.FilterTokenSymbols = *args.TokenSymbols
+	}
+	if args.Cursor != nil {
+		request.Cursor = &pbtokenmeta.BalanceChangeCursor{}
+		if err := dgraphql.UnmarshalCursorProto(*args.Cursor, request.Cursor); err != nil {
+			return nil, dgraphql.Errorf(ctx, "%s", err)
+		}
+	}
+
+	stream, err := r.tokenmetaClient.StreamBalanceChanges(ctx, request)
+	if err != nil {
+		zlogger.Error("failed StreamBalanceChanges request", zap.Error(err))
+		return nil, dgraphql.Errorf(ctx, "internal server error: connection to tokenmeta failed")
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Subscriptions
+	// WARNING : Here we only track inbound subscription init
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:        "dgraphql",
+		Kind:          "GraphQL Subscription",
+		Method:        "StreamBalanceChanges",
+		RequestsCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	c := make(chan *BalanceChangeResponse)
+	metrics.InflightSubscriptionCount.Inc()
+
+	go func() {
+		defer metrics.InflightSubscriptionCount.Dec()
+		defer close(c)
+
+		for {
+			change, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			resp := &BalanceChangeResponse{change: change, root: r}
+			if err != nil {
+				zlogger.Info("error receiving message from tokenmeta stream", zap.Error(err))
+				resp.err = dgraphql.UnwrapError(ctx, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case c <- resp:
+				if resp.err != nil {
+					return
+				}
+
+				//////////////////////////////////////////////////////////////////////
+				// Billable event on GraphQL Subscriptions
+				// WARNING : Here we only track outbound documents
+				//////////////////////////////////////////////////////////////////////
+				dmetering.EmitWithContext(dmetering.Event{
+					Source:         "dgraphql",
+					Kind:           "GraphQL Subscription",
+					Method:         "StreamBalanceChanges",
+					ResponsesCount: 1,
+				}, ctx)
+				//////////////////////////////////////////////////////////////////////
+			}
+		}
+	}()
+
+	return c, nil
+}
+
+type BalanceChangeResponse struct {
+	change *pbtokenmeta.BalanceChange
+	root   *Root
+	err    error
+}
+
+func (r *BalanceChangeResponse) SubscriptionError() error {
+	return r.err
+}
+
+func (r *BalanceChangeResponse) Type() string {
+	return r.change.Type.String()
+}
+
+func (r *BalanceChangeResponse) Balance() *AccountBalance {
+	if r.change.Balance == nil {
+		return nil
+	}
+	return newAccountBalance(r.change.Balance)
+}
+
+func (r *BalanceChangeResponse) Token() *Token {
+	if r.change.Token == nil {
+		return nil
+	}
+	return newToken(r.change.Token, r.root)
+}
+
+func (r *BalanceChangeResponse) BlockRef() *BlockRef {
+	return newBlockRef(r.change.BlockId, r.change.BlockNum)
+}
+
+func (r *BalanceChangeResponse) Cursor() string {
+	return dgraphql.MustProtoToOpaqueCursor(r.change.Cursor, "balance_change")
+}
@@ -3,6 +3,7 @@
 // accounthist.graphql
 // block.graphql
 // blockmeta.graphql
+// mutation.graphql
 // query.graphql
 // query_alpha.graphql
 // schema.graphql
@@ -77,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _accounthistGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4f\x73\xdb\xc6\x0f\xbd\xf3\x53\xc0\xf2\x21\x76\x46\xd6\xe1\xf7\xeb\xf4\xa0\x5b\x52\xa7\x89\x3a\xa9\x9d\xc4\x6e\x33\xd3\x4c\xc7\x5c\x2d\x41\x72\x13\x12\xcb\x2c\x96\x96\xd4\x4e\xbe\x7b\x07\xbb\x4b\x8a\xb2\xe5\xa6\x07\x5b\xe4\xfe\x79\x78\x00\x1e\x00\x9e\x66\xa7\x00\x1f\x90\x3b\x4b\x8c\x0c\xa5\x75\xf0\xbe\x47\xb7\xcb\x4e\xb3\xcc\xef\x3a\x84\x17\x5a\xdb\x9e\xfc\x1b\xc3\xde\xba\xdd\x0b\xed\x8d\x25\xfe\xc9\x12\x61\x78\x84\xbf\x33\x00\x2c\x2a\xe4\x25\x7c\xba\x31\x6d\xd7\x60\x3c\x73\xeb\x94\xc6\x57\x45\x85\x27\x7f\x9e\x64\x00\x9d\xaa\x70\x45\xa5\x5d\xc2\xbb\xf4\x74\x92\x9d\xc2\x15\x6e\x3d\xb0\xc7\x0e\x0c\xc1\x6b\xa7\xba\xfa\xfd\xdb\x8b\x46\x51\xb1\x14\x5a\x64\x8b\xe3\xb0\x02\xf9\x2d\xf1\x3b\x6a\x33\xb0\xd2\xbd\x63\xeb\x96\x70\xe3\x9d\xa1\x4a\x48\x08\xe0\xf2\xf1\x8d\x00\x36\x9b\xcd\xb2\x47\x3b\x50\xdb\xa6\x60\xf0\x35\x82\x97\x77\x06\x5b\x02\x6e\x51\xf7\xc1\x77\x5b\x82\x02\x36\x54\x35\x08\x2a\x86\x63\x63\x7c\x6d\x08\x94\x9c\x27\x8e\x8b\x73\x58\xf7\x1e\xb4\x25\xaf\x0c\x31\x34\xc8\x1c\xde\x70\xeb\x7b\xd5\x80\xa1\xd2\xba\x56\x85\xeb\xa5\xb3\xed\x60\x6e\xb8\x0e\xbe\x56\x04\x8a\x60\x42\x6c\x91\x65\xb7\xb5\x61\xb0\xeb\xcf\xa8\x3d\x18\x86\x9e\xb1\x08\xd6\xc3\xf5\xbc\x42\x9f\x72\x95\xc7\x84\x2e\x82\x87\xc7\x43\x16\xc2\x75\x2a\x7f\xf0\xb2\xb1\xfa\xcb\xc0\x2e\xac\x66\x00\xb3\xb8\x4a\x7d\xbb\x46\x27\xa9\xda\xd4\x46\x8b\x21\xc3\xa3\xdf\x8a\xa1\x73\x56\x23\x33\x16\xb3\x0c\x60\x2d\x57\xae\xfa\x76\x09\xbf\x19\xf2\x3f\xfe\x70\x32\x01\x5a\x5d\xfe\x07\x90\xc5\x88\xb2\xba\xdc\x27\x51\x40\x6e\x4d\x8b\xa0\xfc\x08\x80\xf1\xd8\x70\xbd\xe8\x35\x16\x73\x50\x5a\x5b\x57\x18\xaa\xc0\xdb\xc9\xa1\x74\xc0\x8d\xe8\x82\xb6\x04\xf9\x1f\xd0\x63\x18\x6e\x27\xe1\x7f\x18\x8c\xe9\xde\xea\x12\xac\x33\x95\x21\xd5\x34\xbb\xa0\x96\x60\x70\xef\x93\x98\xf1\x6e\xfb\xc0\x85\x68\x24\x66\x00\x0a\xe5\xd5\x08\x3e\x9b\xbd\x6e\xec\x5a\x35\xc0\xf8\xb5\x47\xd2\x28\x26\xa4\x2a\xa7\x71\x32\x14\x5f\x75\xad\x0c\xc1\x19\xd7\xd6\x89\x46\x8a\x70\xd0\xa1\x46\xd3\xf9\x45\x15\x70\xee\x06\x9c\xf3\x85\xe4\x1f\x04\xf7\x30\x25\xb3\xd9\x1f\xe8\xec\xc5\x5a\x89\x80\x0c\x15\xb8\x15\x5d\x1f\xa4\x25\x6a\xfa\x81\x2c\xe7\x42\xe3\x6e\x2c\x86\x3b\xb0\xae\x40\x97\xac\x8c\xcb\x2b\x01\x8c\x06\xff\xff\xbf\xc1\x60\x72\x3c\x31\x85\xb3\x98\xc8\xc2\x94\x25\x3a\xde\xd7\xc0\x34\xd0\xe9\xec\xf9\x42\x20\x6e\x6b\x1c\x2f\x6f\x4c\xd3\xc0\x1a\x81\xfa\xa6\x81\x4d\x8d\x04\xa5\x32\x4d\xef\xa4\x56\xb5\xee\xdd\x02\x7e\x47\x67\xca\x5d\x2c\x0b\xf6\xca\xf7\x9c\x67\x00\xa5\xc1\xa6\x00\x4b\x0f\x2d\xc5\x82\x88\x75\xb5\x48\x7c\x33\x18\xcc\x2d\x53\xd2\x3e\xc4\xd7\xfd\x7e\x6a\x93\x49\x93\xda\x16\x18\xe4\x18\xe3\x80\x85\x68\x30\x29\x2f\x86\x76\xdf\x44\x7c\xaa\x67\x80\x9b\xa3\x79\x0c\xbf\xf7\xe8\x0e\xa9\xdc\xa3\x3b\x2c\x8a\xd9\xec\x56\xb9\x0a\x3d\xb4\xe8\x6b\x5b\x3c\xe3\x50\x00\x42\x89\x54\x8b\x0b\x09\x9a\x61\x28\x2c\x32\x3c\x27\xeb\x9f\x83\xb6\xce\x85\xbe\x5f\x0c\x05\x22\x42\x77\x4a\xfb\x48\x7f\x8d\x22\xe5\xc1\x81\xb9\x74\xa1\xd0\x68\x36\xf2\x9b\x0f\x24\x72\x68\x51\x11\x0f\xf8\xf7\xaa\xe9\x31\x58\xe4\x2e\xf4\xcb\x9d\xed\x5d\xd2\x11\x1f\x71\x52\x69\xbf\x18\x78\x1a\x82\x5c\x3a\xb4\xe5\x3c\x75\xdb\xe8\x6f\xda\xff\xbe\xbb\x21\x9c\xd1\xdb\x3d\x99\x39\x68\xdb\xae\x0d\x1d\x74\xc7\x04\x99\xcf\xa1\x40\x8f\xae\x35\x84\x3c\x4d\x5d\xa7\x7c\x3d\x2a\x6b\xcc\x61\xaa\x81\x89\xef\x07\x11\x7b\xc2\x3d\x21\x74\xc4\xb7\x54\x28\xb2\xfb\xd0\xb3\x1b\x53\x91\xf2\xd6\x19\x64\x70\xf8\xb5\x37\x2e\x0a\x28\x11\x99\xd6\xe6\x11\x9b\x79\x88\x69\xef\x6b\xeb\xcc\x5f\x61\xaa\xe4\x4f\x9b\x3f\x38\xb7\x84\x4f\xef\x24\x18\xcc\xc6\xd2\x5b\xbc\xc7\x46\x06\x6d\xe4\x74\xa9\xbc\x82\x4e\xed\x1a\xab\x8a\x05\xfc\x6a\xaa\xda\x4b\x6c\x14\x70\xa0\x0e\xe2\x2c\xfc\x72\x73\x7d\x95\x4a\x47\x22\xdb\x21\x85\x76\x28\x2d\xa4\x46\x5f\xcb\xec\xf0\xb1\x4b\x5b\x66\xb3\x6e\x50\xbc\xea\xa9\x53\xfa\x8b\xec\xf4\x32\x4a\xc3\xa0\x7b\xb9\x7a\xca\x31\x69\x96\xff\xe2\x8f\x6c\x2f\x03\x8f\xc4\x3b\x50\xba\x8e\x53\xd2\x61\xe7\x90\x91\x7c\xec\xd1\xe3\xcc\xee\x94\x53\xad\xe8\x80\x85\xb5\xa4\xb2\x00\x5f\x3b\xdb\x57\x51\x2e\x42\x07\x3e\x26\x35\xe4\xd2\x68\x72\x30\x65\xf2\x85\x9e\xf9\x03\x77\x22\x00\x18\x7f\x5c\x0e\x92\xb3\xcf\x6c\x29\xd2\x95\xa7\x03\xba\x6f\x70\x7b\x81\x14\x29\xa4\xc8\x3e\x62\xed\xd4\x26\xf8\x99\xc6\x02\x7e\x47\x0a\x35\x6e\xef\xbe\x13\xb5\x1a\xb7\x97\x21\x70\x87\x3a\xbc\xee\x7d\xd7\xfb\x38\x0d\x06\x2b\xcf\x18\xf2\xce\x19\xf2\x67\xe7\x39\x48\x37\xc5\x16\xc9\xa7\xa6\x3d\x19\x14\xdc\x2a\xe7\xc7\xfa\x48\x76\xb4\x25\xb6\xcd\x23\xbd\x7f\x4c\xe2\x38\x18\x71\x0c\x6a\x98\xbc\x17\xa5\xc3\xd1\xcb\x11\x49\x76\x7e\x76\x88\x4b\x78\x69\x6d\x83\x8a\x22\xdc\x93\x60\x64\xbd\x29\x8d\x56\x71\x76\x49\xd7\x8a\x01\x95\xae\x16\x55\xe9\x9d\xa9\x2a\x94\x62\x53\x1c\x3e\xec\x0a\x04\x2c\xcb\x20\x1d\xdb\x82\x22\x1b\x90\x07\xa7\xe6\x20\x71\xd6\xb6\x33\x41\x2f\xd2\x28\xa4\x15\xb0\xd4\x7b\xaa\x94\xfd\x2c\x1b\xbe\x12\xa6\x13\x74\x68\x9a\xb5\xea\x3a\x24\x8e\xd3\x6b\x67\x7b\xd0\xaa\x69\x86\x56\x13\xca\xff\x2e\x90\xdf\xe5\xa9\xdb\xed\x61\x5f\x5d\xdf\xac\xae\xc1\x90\xc4\x93\x8d\x66\x38\x9b\x26\x42\xc5\x34\x5c\x0c\x8c\xcf\xc3\x37\x95\xe1\xab\x80\x36\x09\xdc\xb7\xec\x9f\x00\x00\x00\xff\xff\x19\xa3\xc5\x8a\x08\x0c\x00\x00")
+var _accounthistGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x57\x4d\x93\xda\x46\x10\xbd\xf3\x2b\x7a\xd9\x83\x77\x5d\x2c\x87\x24\x95\x03\xb7\xfd\x20\x36\x29\x07\xec\x05\x7b\x2b\x49\xa5\x60\x90\x1a\x34\xb6\x34\xa3\xcc\x8c\x0c\x24\xe5\xff\x9e\xee\x99\x91\x10\x0b\x78\x73\xe0\x43\x9a\x51\xf7\xeb\xd7\xdd\xaf\x47\x97\x9d\x4b\x80\x47\xb4\xa5\x56\x16\x2d\xac\xb4\x81\x0f\x15\x9a\x5d\xe7\xb2\xd3\x71\xbb\x12\xe1\x36\x49\x74\xa5\xdc\x5b\x69\x9d\x36\xbb\xdb\xc4\x49\xda\x79\xaf\x95\x42\xff\x17\xfe\xed\x00\x60\xba\x46\x3b\x80\x3f\xa7\xb2\x28\x73\x0c\x7b\x66\x46\x24\x38\xa4\x85\x8b\xbf\x2e\x68\x4b\x29\xd6\x38\x52\x2b\x3d\x80\xf7\xf1\xdf\x05\x79\x1e\xe3\xd6\x81\x75\x58\x82\x54\xf0\xc6\x88\x32\xfb\xf0\xee\x26\x17\x2a\x1d\x30\x2c\xa5\xd3\xd3\x66\xd9\xe4\xb7\x4e\xa7\xdb\xed\x76\x4e\xc1\xab\xc3\x01\x69\x41\x80\x95\x6a\x9d\x63\xf0\xa2\x57\xe0\x32\x84\x85\x75\x06\x45\x71\x32\xb4\x05\xd8\x6a\x69\x13\x23\x4b\xbe\xec\x7b\x27\x67\x89\x68\x3c\x31\x0b\xdd\xa7\x4c\x38\xc8\x44\x59\xa2\xc2\x14\x9c\xf6\xbe\x84\xdf\xd8\x83\xc5\xe8\xf1\x71\xf8\x69\xf8\x38\x1d\xdd\xbd\x1b\x2e\xe2\x6d\xc2\x67\x10\x56\x52\x89\xbc\xe7\x77\x6b\xfa\x32\x1e\x2b\xe7\x22\xcf\xf5\xc6\xdf\x36\xf8\x15\x8d\x95\x4b\x8e\x03\xd7\x05\x2a\x57\x87\x92\x64\x42\xaa\x7e\x97\xdc\xf3\x43\x03\xb8\xbd\xbf\x9f\x7c\x1c\xcf\xe6\x6f\x47\xd3\xd9\xe4\xf1\xf7\xf9\xed\xfd\x6c\x34\x19\xcf\xa7\xb3\xe1\xfb\x8b\x0e\x83\xbc\xaf\x8c\xa5\x24\x13\x3a\x83\xb6\x2a\xd0\x5b\x09\x7c\xc0\xca\xe8\xa2\x07\x5a\xe5\x3b\x72\x43\x2e\xd4\x19\xd4\xde\x5f\xe2\x0d\x0d\x60\xea\x0c\x31\xcc\xb6\x1d\xe7\x86\x6e\x1c\xa5\x8b\x93\x85\xaa\x2a\xbe\x87\x2e\x50\xb8\x67\x0c\x36\xc2\x12\x0a\x54\x5c\x1a\x02\x14\x6e\xda\x2c\x2c\x73\x9d\x7c\x61\x14\xe3\xe1\x53\xe7\xc4\x83\x06\x0b\xfd\x95\x92\xc0\x11\xed\x79\x82\xe5\x8e\x4c\xf9\x67\x79\x8b\x36\x6b\xa1\xe4\x3f\x82\x9f\x62\x5b\x1f\xc7\x0f\x93\x53\xc6\x28\xa3\xb9\x24\x63\x62\xfd\xb2\x8d\xc7\xe1\xb1\x8d\x52\x58\xcb\x05\x41\x77\xa4\xa9\x83\x90\xb9\x74\x3b\x58\x52\x49\xa5\xc2\xec\xf8\xd1\x36\xd5\xcc\x98\xaf\xba\x93\x2d\xe5\xb9\x3a\xe4\x9f\x7b\x8c\xfb\xe5\x1c\xfd\x5c\xc6\x47\x2b\x90\xe9\x3c\xb5\x1e\x98\xcf\x9d\xe5\xaa\xc2\x2d\x26\x95\xc7\x4d\x17\x4d\xfb\xd4\x74\x48\x97\xf9\x7c\xd0\x7e\x65\xeb\xda\x5e\x56\x0e\x12\xad\x1c\xd1\x63\x21\x47\x6b\xfd\x15\xf5\x76\x25\x72\x4a\x1f\x89\x4a\xe1\x09\xda\xa7\xa3\xf5\x38\x5d\x0b\xb2\xa8\xa0\x05\xac\xdf\xe9\xcc\x32\xea\x5e\xbd\xfc\x4c\x32\xc3\x7d\x5c\x31\x83\xec\x3d\x34\xf0\x1a\x5d\xd3\xaf\x5e\xaf\x5a\x8d\x7a\x1c\x26\xd3\x75\xc9\x1f\xb8\xe3\xbc\xd5\xe8\xfc\x5d\xce\x55\xb8\x4b\x05\xba\xa4\xe6\xa3\xf0\x36\x99\x4c\xd8\x11\xeb\xc7\xbe\x0c\x4a\xa3\x89\x22\xc2\xc1\xc9\xf2\x05\x30\xae\x8a\x01\x7c\x94\xca\xfd\xfc\xd3\x45\xcb\xd0\xe8\xe1\x7f\x18\xe9\x37\x56\x46\x0f\xfb\x24\xb2\x91\x99\xa4\xae\x24\x25\xa9\x0d\xc4\x6a\xaf\x1f\x4f\xab\x04\xd3\x1e\xd9\x4c\xb4\x49\xe9\xa1\x5a\x68\xc2\xa6\xb8\xc1\x34\xd6\xd9\xda\x00\xf8\xdb\x5b\x0f\x34\xcc\x5a\xf4\x3f\x27\xa3\xbd\x46\x91\x68\x23\xd7\xac\x4f\xa4\x09\x5c\x2d\xde\xe1\x3e\xa6\xae\x6f\xfb\xed\xb3\x10\x82\x93\x90\x01\x48\x85\x13\x8d\xf1\x6e\xf7\x4d\xae\x97\x54\x15\x16\xff\xae\x50\x51\x6e\xc8\x05\x0f\x9d\x36\x4f\x52\x85\xcb\xd0\xb3\x57\x36\xd3\x86\x6b\x24\xf5\x1b\x0d\x26\x48\xda\xdc\x5f\x7b\x3b\xf3\xda\xce\x75\x9f\xf3\x0f\x6c\xf7\x30\x25\xdd\xee\x1f\x68\xf4\xcd\x52\x70\x01\x49\x95\xe2\x36\x48\x67\x2b\x2d\xa1\xa6\x9f\x95\x65\x8f\x61\xcc\x9b\x66\x98\x13\x11\x29\x9a\xe8\xa5\xb9\x3d\x62\x83\xc1\xe1\x8f\x3f\xd4\x0e\x63\xe0\x11\x29\x5c\x85\x44\xa6\x72\xb5\xa2\xce\xdf\xf7\x40\x9b\xe8\xb8\xf7\xba\xcf\x26\x66\x5e\xf1\xc3\xc3\x1b\x99\xe7\xb0\x44\x2a\x4e\xfa\xdd\x64\x24\x87\x2b\x21\xf3\xca\x70\xaf\x26\x24\x01\x7d\xf8\x84\x46\xae\x76\xf5\x5c\x13\xae\xb2\x0b\xb2\xb1\x92\x98\xa7\xa0\xd5\x73\x4f\xa1\x21\x42\x5f\xf5\x23\x5e\xfa\x8e\xee\x06\x50\xcf\x35\x7f\xb9\x5f\x8f\xc3\x2f\xd6\x64\x42\x42\xe3\xcb\x31\xf0\x10\x86\x5d\xac\xbc\x40\xed\x5e\x44\x5c\xec\x67\x80\xe9\xc9\x3c\xfa\x5f\x92\xc4\x43\x28\x74\xe3\xb0\x29\xba\xdd\x99\x30\xd4\xf5\x50\xa0\xcb\x74\xfa\xca\xfa\x06\x60\x48\x4a\x14\xd8\x67\xd2\xc8\x6d\xaa\x89\x97\xd7\x4a\xbb\xd7\x84\x91\xa4\x96\xa7\x73\x33\x89\xb9\xd0\x09\x8c\x0b\xf0\x97\xc8\xa5\x5c\x07\xc0\xd3\x57\x78\xa1\xd9\xf0\xef\xa2\x06\xb1\x20\x7f\xc4\x5d\x6d\xff\xab\xc8\x2b\xf4\x1e\x6d\xe9\xf5\x72\xa7\x2b\xd3\x4c\xc5\xe3\x20\x69\xa5\x5f\xe3\xa4\x72\x5a\xb0\x42\x6b\x92\xac\xa0\xb6\x21\xde\xb8\xfe\x72\xb8\x9e\xce\x10\xed\x1e\x4c\x8f\xa2\x29\x96\x52\x1d\xa8\x63\x34\xb9\xe8\x41\x8a\x0e\x4d\x41\xcb\xb6\x9d\xba\x52\xd0\xce\xba\xb2\x9a\x1c\xc6\x1e\x68\xc5\x7e\xc0\xd8\x99\xf0\x18\xd0\x89\xd8\x62\xa3\xf0\xea\xf3\xc8\xa6\x72\xad\x04\x9d\xa1\x24\xf2\x00\xfd\xbb\x92\x26\x14\x50\x04\xd2\xee\xcd\x13\x3e\x17\x9e\xd3\x8a\x58\x31\x71\xec\x2e\xce\xbb\x3f\xd8\x47\x27\xc9\xf7\x4c\x86\xb5\x74\xf1\x8e\xc6\x70\xce\xe7\xc8\x80\xe9\x81\x44\x8a\x68\xd9\xe5\x5a\xa4\x7d\xf8\x4d\xae\x33\xc7\xdc\x08\x3e\x19\x71\x9d\x70\xb0\xf0\xeb\x74\x32\x8e\xad\xc3\xcc\xd2\x31\xcf\xcb\x21\x4b\x48\x86\xfe\xe0\x26\x5d\x50\x69\x6d\xc3\x31\x85\xa2\xaa\x14\x55\xca\x17\x5e\xa9\x78\x94\xfa\x41\x77\x37\x3a\x17\x18\x8b\xe5\x77\xe2\xe1\xe5\x81\xc7\x11\x71\x7b\x48\x93\x30\x25\x0d\x96\x54\xf1\x74\x36\x0c\x1a\xdd\x3a\x7e\x18\xca\x02\xd5\x81\x65\xd4\x9c\x4a\x3e\x8b\x18\x5d\xad\x43\xb9\x30\x1c\x78\x8a\xd5\xb0\x60\xa1\x21\x04\xab\x18\x8b\x7a\xe5\x0e\xc2\x09\x06\x68\xf1\x74\x39\x70\xce\x3e\x5b\x9a\x0b\x1e\x2e\xff\x3b\x80\xfb\x16\xb7\x37\xa4\xd6\x1e\x42\x64\xf6\x08\xb5\x11\x1b\x1f\x67\x1c\x0b\xf8\x42\x29\x64\xb8\x9d\xbf\xc0\x1a\x6d\x79\xf0\xc4\x1d\xd6\xe1\xa4\x72\x65\xd5\x1c\xa4\x83\x17\x6a\xb4\x45\x49\x9b\xdc\xd5\x35\xbd\x08\x90\x9a\x22\x1f\xb6\xa3\x68\xb7\x06\x85\x2d\x84\x71\x4d\x7f\x44\x3f\x74\x69\x75\x7e\x54\xef\x4f\xb1\x38\x0e\x46\x1c\xbf\x99\xc4\xc9\x7b\xb3\x32\xd8\x44\xd9\x58\xe2\x95\x5f\x68\x61\x00\x77\x9a\x8c\x0a\x15\xcc\x9d\x35\x46\xa2\x27\x57\x32\x11\x61\x76\xb1\x6a\x05\x42\x59\xd5\x42\x55\x12\xa8\xf5\x1a\xb9\xd9\x44\x78\x2f\xa2\x3c\x22\xcd\x24\x2e\x1d\x8a\x4e\xa8\xf0\xf2\x51\x07\x45\x47\x0c\xe2\x39\xd1\xa5\x8c\x67\x57\xdc\xb2\x14\x58\xee\xf7\xd8\x29\xfb\x59\x56\x9f\x12\xda\x13\xb4\x16\xcd\xf0\x46\x64\xc3\xf4\x22\xc1\x84\x84\x4e\x13\xb5\xd4\xf8\xf6\x9f\x7b\xf0\xbb\x45\x54\xbb\xbd\xd9\xe1\x64\x3a\x9a\x50\x62\x99\x4f\x2b\x13\x0b\x57\xed\x44\x88\x90\x86\x9b\x1a\xf1\xb5\x3f\x53\x49\x3b\xf6\xd6\x5a\xc4\x7d\xeb\xfc\x07\xae\xa2\x09\x5a\xe7\x0e\x00\x00")
 
 func accounthistGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,7 +93,7 @@ func accounthistGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "accounthist.graphql", size: 3080, mode: os.FileMode(420), modTime: time.Unix(1599276610, 0)}
+	info := bindataFileInfo{name: "accounthist.graphql", size: 3815, mode: os.FileMode(420), modTime: time.Unix(1792292553, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -137,7 +138,27 @@ func blockmetaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x41\x6f\xdb\xc6\x12\xbe\xeb\x57\x4c\xfc\x2e\x76\x20\x0b\xb2\x5f\x92\x83\x80\x1c\x24\x45\x2f\x16\x9e\x2d\xb5\xb2\xda\x00\xb9\x58\x23\x72\x48\x2e\xb2\xdc\x65\x76\x97\x52\x98\xa2\xff\xbd\x98\xdd\xa5\x44\xbb\x36\x1a\xb4\x29\x92\x43\x72\x89\x24\xce\xee\x7c\xf3\xcd\x37\xdf\x98\xae\xa9\x08\x7e\xae\xc9\x34\xf0\x5b\x0f\xe0\xe4\xe4\xa4\x07\xf0\x6e\xbc\x5a\xcc\x17\x6f\x47\xb0\x2e\x84\x05\x61\x01\x61\x32\x5b\x8f\x43\xdc\x00\xe6\x6b\xb8\x99\xbf\xbd\x5a\xc3\xed\x7a\x7e\x7d\x0d\xd3\xab\xf1\xe2\xed\x6c\xd0\xeb\x01\xac\xc8\x19\x41\x3b\x02\x57\x10\x48\xb4\x0e\x30\x71\x42\x2b\xdb\x07\x57\xa0\x03\x34\x04\xc2\x18\xda\x91\xb1\x62\x2b\xa9\x0f\xa8\x52\xff\x68\xd4\x03\xb8\x38\x03\xa5\x9d\xc8\x04\xa5\x80\x0a\x30\x49\x74\xad\x5c\x1f\xb4\xe9\x01\x5c\x9e\xc1\x1e\x2d\x60\xed\x0a\x6d\xc4\x67\x4a\x61\xdb\x74\xa2\x62\x7a\x5b\x4b\x67\x7d\x9a\xbb\x98\xf9\xae\x0f\x86\x5c\x6d\x14\xa5\x20\x14\x84\xdc\x04\xda\xa4\x64\xe0\x34\x33\xba\x04\x43\x09\x29\x07\x4e\x83\x96\xfc\x6b\x3c\x79\xe6\xef\x5c\x2c\xd7\xb3\x11\xd4\xb6\x46\x29\x9b\xbe\x2f\x6c\x8b\xc9\x07\xa1\x72\xb0\x64\x76\x22\x21\xd0\x19\x38\x26\xaa\x24\x57\xe8\x14\x0a\x2d\x53\xa6\xec\xce\x99\x5a\x25\xe8\x28\xbd\x83\xbd\x50\xa9\xde\x73\x64\x21\xac\xd3\xa6\xe9\x43\xe5\x33\x75\xc1\x63\xea\xaf\xdf\x24\xb5\xb1\xda\x6c\x18\x2e\x7f\x37\x64\x2b\xad\x6c\x24\xab\x42\x6b\x41\x38\x0f\x82\x21\x1f\xa2\x9d\x86\x44\x2b\x27\x54\x4d\x50\x61\x2e\x14\x3a\xa1\xf2\x41\xa7\x87\xb6\xd0\xc6\x9d\x4b\xb1\xa3\x14\xc2\xa9\x3e\x90\xad\x28\x11\x5c\x1b\xa0\xf5\xe9\x22\x6a\xa1\x55\x8b\x3a\xd7\x64\x61\xdb\x0c\x0e\xfa\xc8\xc9\x8d\x03\xf2\xab\x50\xcd\x38\x30\x76\xda\x03\x00\x38\x89\xcf\x40\x61\x49\x0c\xeb\xa3\x97\x57\xa6\x0f\xcc\x9e\xf8\xb8\x58\xfc\x08\x6e\x9d\x11\x2a\x7f\xd6\x0b\xa7\xa7\x5a\x39\x83\xc9\x5f\x1e\x4f\x62\x5c\x7b\x3e\x1e\xbf\xc1\x4f\xa2\xac\x4b\x50\x75\xb9\x25\xc3\x8c\xc7\x53\xf7\x64\xe0\xfb\x55\x61\x4e\x03\x80\x1b\xfc\x04\x52\x94\xc2\x01\x4a\xa9\xf7\x94\xfa\x5c\x3e\x22\x41\x29\x99\xbb\x8b\xe1\x70\x18\xb2\xfa\xc0\x11\xcc\x95\x7b\xf5\x02\x5e\xf3\x83\x98\x77\x59\x71\x16\x94\x91\xd9\x7b\xed\xd8\x17\x64\x08\x1a\x5d\x83\xa4\xcc\x81\xce\xb2\x3e\x38\xfc\x40\x0a\xa2\xfe\x82\x6c\x19\x2b\x54\x86\x76\x42\xd7\x31\xb7\xd3\x01\xc8\xc6\x53\xee\xeb\xd8\x04\x42\x06\x91\x05\x9f\xed\xc0\x01\xc0\xd9\x08\x1e\xed\xcd\x54\x2b\x45\xfe\xe3\xb3\x5e\x84\x7c\x12\xae\xb8\x25\x34\x49\x11\x94\x2d\x75\xf2\x21\x29\x50\x28\xe6\x60\x8f\x26\x72\x61\x50\xd9\x40\x23\x3c\xa7\x4f\x94\xd4\xfe\x23\xd3\x4f\xf6\x39\x6c\xd1\x52\x0a\x5a\xc1\xc6\x23\xdb\x0c\xc2\xfd\xef\x0a\x6a\x05\x1c\x89\x3f\x2a\xdb\x02\x95\x95\x6b\xfa\xac\xe4\x92\x50\x59\xcf\x4e\x81\x3b\x8e\xc6\xa4\xa0\x30\x0a\xa4\xd2\x30\x5d\x04\x5e\xa7\xde\x1a\x3c\x48\x30\xa8\x72\x6a\x33\x8d\x57\x8b\x11\xa0\xdc\x63\x63\x99\x75\x2b\x78\x8c\xfd\x2c\xd5\x2a\xd5\x1b\xc8\x04\x49\xdf\xf7\xb6\x2a\xeb\x6b\x26\xdb\x87\x7d\x21\x92\x02\xac\xc8\xb9\x77\xde\xa4\xf8\x5c\x89\x2e\x29\x78\xc6\x49\x52\xc9\xe6\xc0\xde\xc3\xe7\x59\x98\xab\xd9\xcd\xf2\xd7\xd9\x9b\xd0\x3c\x8e\x0e\x8c\x6d\x29\xc1\xda\x7a\x3b\xf0\x10\x59\x71\xda\xe4\xa8\xc4\x67\x3f\x4e\x11\xec\x2d\x11\xa0\xb4\x3a\x54\xe5\x0c\x61\xc9\x89\xbc\x25\x6a\x05\xb5\x62\xec\x9b\xdb\x7a\x6b\x13\x23\xbc\xa8\x36\xf7\xda\x15\xa0\xaf\x8f\x2d\xb1\xff\x0b\x45\x85\xe9\xf3\xa1\x69\xc6\x40\x62\x63\x83\xbb\x5f\xa3\xca\x6b\xcc\x7d\x4a\xa1\xf2\x93\x43\xb0\xef\xd9\x83\x21\xf4\x97\x5c\xeb\x3d\x99\xc8\xb6\xaa\x4b\xd8\xea\x5a\xa5\xc8\xd6\x25\x54\x22\x6b\x2b\x76\x24\x9b\x01\x8c\x41\x51\x8e\x4e\xec\x08\x76\x28\x6b\x8a\xfd\xc4\xb6\x4f\x24\xc3\x43\x17\x2a\x2e\xd8\xe6\xb4\x09\xdb\xa1\xbb\x0b\x62\xfc\x69\x4a\x15\xa9\x94\x29\x61\x45\x75\x23\x96\x4a\x36\x9b\xb3\xc1\x11\xba\xd4\xfb\x09\x1f\x5a\xd4\x65\x1c\xc9\x0e\xfc\x2b\x91\x17\x5f\x86\xff\x33\x19\xcd\x90\xbe\x59\x1d\x85\xc8\x8b\xa7\x0b\x59\x56\xf8\xb1\x26\x48\xd1\x21\x54\x82\x12\x0a\x32\xe5\x81\x49\x50\x85\x85\xd0\x6e\x83\x83\xe5\x34\xba\x36\x51\x2a\x20\x32\x1e\x33\x4e\x0f\xa9\xb0\x49\x30\x02\x4a\x07\xc7\x75\x2d\xdc\x51\xcc\x87\x21\x3d\x0c\x4d\x77\x09\xd9\xc3\xb6\x63\x7f\x1a\xc0\xdc\xf1\x30\x5b\xcc\x3c\x31\xac\x3a\x2f\x6b\xb6\xee\x68\x84\x42\xc1\x64\xb9\xbe\x82\x54\x18\x8a\x4e\x7c\xda\x8e\x21\x2f\x34\x86\xce\x5f\xba\x84\x3c\x70\xb5\x8e\x26\xbd\x4f\x73\x8a\xa3\xbf\xb7\xf6\xc9\x0b\x95\x2d\xbd\xfb\xdb\x69\x4a\x19\xfa\x4f\x4e\xb3\x59\xdf\x53\xcf\x13\x56\xee\x13\x05\xf3\x32\x35\xf5\x41\x2b\xd9\xc4\x41\x0d\x3c\x1f\xfc\x5a\xf9\x5e\x50\x13\x7a\xc0\xa8\x8e\x6d\x16\x52\xb8\xe6\xa0\xb9\x01\x2c\x5d\x41\x66\x2f\xfc\x1a\xe7\x35\x03\x19\x45\x8b\x69\xaf\xab\xab\x7b\xda\xf2\x32\xea\xc0\x7d\xa8\xa0\x11\x4c\xb4\x96\x84\x0a\x5e\x43\x86\xd2\x92\x8f\x3c\x1b\xc5\xb1\x7f\xc4\x20\x56\xb1\x87\xcf\xbe\xc4\xff\xdb\xb6\x7c\xaf\x0b\xc0\x67\xe8\x2e\x81\xaf\xef\xab\x93\x48\xc1\x37\x33\xd6\xe0\x43\x3a\x83\x61\xe4\xc8\xf7\x88\x72\xa1\x94\xf7\x95\xec\xb8\x7d\x7e\xf8\xf0\x0f\x1f\xfe\xe1\xc3\xdf\xb9\x0f\xb7\x86\xf2\xc0\x88\xff\x03\xe7\x7f\xeb\x5f\x3c\x3c\xb9\x5e\x4e\xff\x0f\x37\xb3\xf5\xf8\x9f\xdf\xd6\x9a\xe1\xca\x3b\xf6\x71\x27\xc0\xfc\x0d\x64\xcc\x21\xa0\xf1\xff\xf1\x93\x5c\xec\x48\xc1\xc6\x89\x92\x36\xfd\xe3\x12\x08\xe2\xd5\x65\x85\x06\x1d\x0b\xb8\x32\x7a\x27\x52\x4a\x07\xf7\x52\xf8\x7b\xe7\x6f\x26\xcd\x5a\x94\xd4\xb1\x58\xfe\x6a\x1d\x96\x95\xdf\x3c\xe1\x1e\x61\xb5\xea\xc7\xbf\xdf\x4b\x74\x70\x39\x1c\xbe\x3a\x1f\x5e\x9c\x0f\x2f\xd7\x17\x2f\x47\xc3\x17\xa3\xe1\xcb\xf7\x6c\x02\x8f\xfc\x3e\xb8\xb8\xfc\xef\xfb\x63\xf7\x18\xec\x08\x38\x47\xd7\x91\x3b\x8a\x3f\xe0\x1e\xc1\x74\x79\xf3\xd3\x78\x35\x5e\x2f\x57\xf0\x1a\xae\xd7\xb3\xb6\xb1\x93\x80\xfc\xeb\x76\x71\x3c\x9d\x2e\x7f\x59\xac\xff\xed\x3e\x2e\xc2\xb8\x86\x37\xd2\xd8\xc0\xf8\x22\xbe\xf1\x2f\x39\x89\x21\x74\x4f\xf4\x6a\xdc\xbe\xf6\x4f\x39\x48\x68\x75\xfa\x18\x85\x7f\x7a\xaf\x7f\x8a\xb6\xdf\x7b\x7f\x04\x00\x00\xff\xff\xb2\x12\xab\xb2\x7e\x12\x00\x00")
+var _mutationGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\xc1\x52\xdb\x48\x10\xbd\xfb\x2b\x1a\x5f\x92\x54\x39\xa6\x80\x9b\xab\x72\x30\xb6\x01\x17\xac\xa1\x6c\xb3\x97\x54\xca\x1a\x4b\x2d\x69\x82\x34\xa3\x9d\x19\x01\xce\xd6\xfe\xfb\xf6\x8c\x46\x58\x96\x0d\x49\x76\x13\x2e\xc0\x68\xd4\xef\x75\xf7\x7b\xdd\x32\x9b\x02\xe1\x8f\xd2\x30\xc3\xa5\x80\xbf\x3b\x40\x3f\xdd\x6e\xd7\xfd\xbe\x2b\x75\x0a\x0c\x34\x4f\x04\x46\x60\x14\x13\x9a\x85\xee\x9e\x91\x60\x52\x84\x30\x65\x5c\x00\x13\x11\x3c\x31\x6e\xa0\x14\x86\x67\xee\x81\xc2\xbf\x4a\xd4\x86\xde\x0a\x92\x92\xd1\x8b\x06\x31\x00\xae\x21\x47\x03\x6b\x8c\xa5\x42\x87\xa0\xd0\x94\x4a\x70\x91\x00\x37\x1a\xf0\x19\xc3\xb2\x8a\xaf\x58\x88\x7d\x58\x52\xa8\x26\x2c\x05\x28\x88\x13\x85\x65\x89\x45\xae\x69\x48\x11\xf3\xa4\x54\x74\x2e\x64\x84\xba\x22\xe2\x00\x88\x55\xce\x1e\xe8\x88\xfe\xe0\x82\xee\x33\x58\x67\x32\x7c\x00\xa9\x08\xae\xe0\x0a\x75\xbf\xe3\x6e\x0e\x77\x90\x14\x7e\xc5\xd0\xf2\x5f\x6f\x1a\x99\x56\x74\x35\x65\x0c\xa8\x14\x85\x48\x65\x16\x59\xf6\xf6\x4a\x60\xb1\xa5\x0e\xfc\xa3\x1c\xb5\x66\x09\xf6\x77\x2a\x6a\xd9\x2f\xb7\x30\xef\xdd\xa1\xbb\x60\x53\x2d\x58\xf8\xb0\x5b\xe8\x1e\x68\x96\x23\xc4\x1c\xb3\x88\x60\xb5\x03\x5a\xcb\x68\x03\x32\xde\x02\x06\xc7\x8f\x27\xc7\x8e\xe1\xb1\x05\x58\x35\x02\x04\xdd\x17\x88\xc6\xe9\x00\xee\x1c\x54\x83\xca\x54\x14\xa5\x39\xea\x6c\x09\xdd\x49\xaa\x17\xd8\x22\x13\x64\xc6\x63\xb4\x90\xa6\xd5\x10\x16\x1b\x54\xf0\x94\xf2\x30\xad\x9f\x85\x68\xdb\x54\x55\x0a\xa3\x2d\xfc\x8b\x0e\x08\xfc\x7e\x71\xb5\xba\xbc\x1f\xce\x87\xb3\xe5\x64\x02\x9f\x60\x3a\x5b\x9d\xdf\xdc\x8e\xae\x9b\xf0\x56\x7b\x26\x55\xb2\x4c\xd2\x46\xa6\x7b\xf9\x11\x43\xd2\x19\x8b\x5c\x41\x34\x8a\xe8\x95\xec\x4b\x8d\x37\x98\xb0\x70\x63\x03\x0f\xe0\x5c\xca\x0c\xa9\x8d\x9f\x20\x66\x99\xae\xc4\xf8\x61\xe0\x14\xdf\x28\xca\x1c\x75\x21\x85\xc6\xa3\xce\x3f\x9d\x0e\xb7\x15\x7a\xa5\x70\xb5\x71\x16\x64\x15\x46\x99\x93\xe0\xf6\xab\xd5\xf3\xd5\xe4\x0a\xb4\x51\x56\x36\xe4\x83\x1c\xde\x07\x8b\xe9\xe5\xea\xfa\x64\xd5\xef\xf7\x83\x0f\x15\x63\xfd\x12\x67\x00\x9f\x17\xee\xf2\xd1\x17\xdf\x9d\xee\x48\xe6\x05\x3d\xd1\xb6\x01\x36\xeb\xc2\x53\x7a\x0e\x9c\x15\xfd\xff\x23\x49\xc5\x7e\x36\x17\x0a\x71\xcc\x0c\x0b\x7a\x80\x9c\xc0\x95\x2d\xa6\x20\x33\x92\x44\x83\x6f\x19\x5f\xfb\x1a\x85\xdb\xa0\x03\xa8\x10\xa9\x38\x5d\x7b\xb7\xeb\x71\xaf\xf0\xf9\x23\x8a\x90\x5a\x11\xd5\x52\x0d\x2b\x10\x88\x09\x05\x22\x82\xf1\x32\x3f\xc4\xa0\x19\xf6\x8d\x90\x8d\x82\x35\x83\x51\x7a\x75\x00\xd7\x0d\x14\x65\xde\x16\x92\x6f\x42\x5b\xa4\x4f\xac\x1e\x2d\x14\xde\xce\xab\x6a\x04\x54\xd1\x77\xa5\xe7\xde\xad\x06\x44\xd3\xda\xed\x68\xb1\xcc\x32\xf9\x54\x4d\x07\x77\x9b\x8e\x94\xcc\xe1\x04\xa4\x2b\x71\xa1\x64\x54\x86\xa8\x2a\x88\xab\xe1\x6c\x7c\x7b\x71\xb1\x58\x9d\xfc\x12\x90\xd3\x16\x88\x6e\xa1\x9c\xfe\x12\x94\xb3\xef\xa0\x9c\xfd\x30\x4a\xc1\xb4\xb6\x7d\xa5\x63\xae\x14\x3e\x52\x2c\xbe\xe6\x19\x37\x04\x28\x4b\x11\x31\xb5\xf1\xad\x98\xcf\x27\x7f\x4e\xe6\x8b\xe9\xf9\xcd\xc4\xb6\xd8\xd8\xd5\xf4\x8a\x23\x7d\xaf\x1b\x38\xd3\xf1\x56\x1f\xf6\x91\x23\x75\xf0\x70\x56\xe6\x03\xb8\xa7\xe9\x76\x76\x5a\x5b\x6a\xb2\xbb\x79\x0e\x9b\x97\x36\x54\xe0\x02\x90\x79\x44\xb6\x71\x09\xfb\x89\x6c\x4f\xdf\x69\x98\x8e\x7b\x40\xba\x5c\x53\xdd\xac\x13\x0d\xa7\x25\x60\x58\x5e\xf4\xbb\x35\xdb\x90\xc6\x5f\x23\x9d\xa5\x3d\x69\xe9\x79\xb1\x1c\x5e\xbe\x68\x99\xb9\x8d\x06\x2c\x0c\xb1\x30\xbe\x8a\x7b\x0e\x19\x8e\x46\x93\xbb\xe5\x64\xdc\xf9\x29\xfd\xd7\x49\x66\x52\x24\x44\xb3\x5a\x71\xbf\xc1\x14\x34\x41\x20\xa7\x85\x7f\xd8\x15\x3f\x05\xa1\x30\x97\x8f\x84\xe0\xe2\xee\x71\xef\xed\xbf\xc3\xb3\x8c\x3e\x37\x76\x3e\x1a\x2a\xf4\x8b\xdb\xf9\xf5\x4e\xc1\xfe\xb7\x86\x2d\x3a\x2d\x3e\x5e\x29\x22\x63\xc4\x4a\x79\xb1\xfe\xb8\xbc\x17\xf4\x1d\x56\xea\x96\xc8\x49\x42\x49\xbd\x35\x9d\x38\x8e\xde\xd2\x7e\x95\xd2\xf9\x5b\xe9\xf4\xec\xf0\xdf\x26\x6d\x52\x66\x7c\x07\x95\x9d\xc0\xb2\x34\x3d\xda\x12\x65\x96\x59\xa1\x43\x50\xcb\xcb\x6f\x8a\x96\xb1\x0e\xfa\xca\xb3\x98\x55\x5e\x20\xa1\xb5\x86\x09\xa4\xec\xd1\x12\xf3\x07\x51\x2d\x18\xf7\x69\x59\xd4\xca\x7c\xb3\x27\xbd\xca\x84\x9a\x3e\x28\x2d\x49\x2f\x27\xcf\x31\x25\xfb\xc9\x38\xd6\xff\xc5\xe7\x3b\x61\x6b\x27\xf8\xd5\xda\xec\x61\xf0\x1b\x47\x02\xe9\xe3\x5f\x2f\x8f\x63\x8f\x99\x0b\x00\x00")
+
+func mutationGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		_mutationGraphql,
+		"mutation.graphql",
+	)
+}
+
+func mutationGraphql() (*asset, error) {
+	bytes, err := mutationGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mutation.graphql", size: 2969, mode: os.FileMode(420), modTime: time.Unix(1792295926, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x58\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\x71\xcd\x5e\x92\xc2\x31\x9c\xac\xed\x83\x81\x3e\xd8\x6e\xd6\x04\x4b\xe2\x2d\xf1\x56\xa0\x2f\x11\x2d\x51\x16\x51\x89\xf2\x48\x2a\xae\x5b\xec\x7f\xdf\x77\x24\x2d\x2b\xbf\xd0\xb5\xeb\xd0\x3e\x34\x08\x12\x99\x3c\xf2\xee\xbe\xbb\xfb\xee\x64\xb7\x5e\x4a\xfa\xbd\x91\x66\x4d\x1f\x77\x88\x76\x77\x77\xf1\xf7\xcd\xe8\xf2\xe2\xf4\xe2\xf5\x90\x66\x85\xb2\x84\x5f\x41\xe3\xe3\xd9\x28\xc8\xf5\xe9\x74\x46\xe7\xa7\xaf\x4f\x66\x74\x35\x3b\x3d\x3b\xa3\xc9\xc9\xe8\xe2\xf5\x71\x7f\x07\x07\x2f\xa5\x33\x4a\xde\x48\x72\x85\xa4\x52\x58\x47\x22\x75\xaa\xd6\xb6\x87\x15\x81\x4f\x46\x92\x32\x06\x12\xc6\xaa\x79\x29\x7b\x24\x74\xe6\xb7\x86\x38\x7d\xb8\x4f\xba\x76\x2a\x57\x32\xc3\x3a\x8e\xa6\x75\xa3\x5d\x8f\x6a\x83\xcd\xa3\x7d\x5a\x09\x58\xd2\xb8\xa2\x36\xea\x03\x44\xe6\xeb\x8e\x54\x54\x6f\x9b\xd2\x59\xaf\xe6\x3a\x6a\xbe\xee\x91\x91\xae\x31\x1a\x27\x94\xa6\xa0\x5b\xe2\xce\x4c\x1a\xda\xcb\x4d\x5d\x61\x2d\x95\xda\x91\xab\xa9\x2e\x79\x35\x9e\xdc\xef\x51\xa3\x4b\x69\x2d\x25\xc2\x42\x22\x53\x7a\x91\x30\x1a\x56\x06\x75\x17\xd3\xd9\xf1\x90\x1a\xdb\x88\xb2\x5c\xf7\xbc\xcf\x73\x91\xbe\x83\x18\x44\xcc\x8d\x4a\xa1\x26\xc7\x32\x8e\x54\x12\x66\x67\x54\x40\x01\xa3\x79\xed\x4c\xa3\x53\xe1\x64\x76\x4d\x2b\xa5\xb3\x7a\xc5\x92\x10\x74\xb5\xc1\x4d\x4b\x6f\x44\xd7\x2f\x91\xf9\xeb\x93\xb4\x31\xb6\x36\x09\x7b\xc2\x9f\x8d\xb4\x4b\x58\x1a\x71\x5c\x0a\xd8\xaa\x9c\x37\x82\xbd\x69\xa5\xf1\x9c\xd6\xda\x29\xdd\x48\x08\x2d\x94\x16\x78\x5e\xf4\x3b\xe1\xb5\x00\xd5\x1d\x94\xea\x06\x28\x85\x53\x3d\xc2\xdd\x32\x55\xec\x1b\x01\x78\x56\x17\xad\x06\x38\x1b\xab\x17\xb5\xb4\x08\x44\xbf\x4d\x9d\x85\x74\xa3\x60\xf9\x49\xf0\x66\x14\xc0\xdc\xc3\x1e\x64\xe2\x1e\x69\x51\x49\x36\xeb\x2f\x9f\x79\x79\xdd\x82\xbe\xeb\xe5\xa2\xf3\x43\xba\x42\x3e\xe9\xc5\x93\x9d\x70\x7a\x02\x27\x0c\x04\x3f\x75\x3c\x8d\x72\x9b\xf3\xf1\xf8\xb9\x78\xaf\xaa\xa6\x22\xdd\x54\x73\x20\x0c\xc4\xe3\xa9\x5b\x19\xe2\xe3\x05\x94\x64\x9f\x08\x27\xa8\x54\x15\x30\x05\x0c\xf5\x0a\x02\xac\xcb\x4b\xa4\x58\x61\xec\x0e\x07\x83\x41\xd0\xea\x05\x87\x74\xaa\xdd\x8b\x67\xf4\x92\x37\xa2\xde\xe9\x92\xb5\x88\x32\x22\x7b\x2b\x1c\xab\x42\x22\x59\xd7\x75\x43\xa5\xcc\x1d\x6c\xca\x91\x48\xe2\x9d\xd4\x14\x53\x33\x64\x34\xdb\x4a\x4b\x24\xaf\xaa\x9b\xa8\x1b\xb7\x78\x43\x12\x0f\xb9\xf7\x23\x09\x80\xf4\x23\x0a\x5e\xdb\x1d\x0c\xde\x14\xb8\x1a\x71\xe4\x94\x89\xce\x73\xb5\xb4\x00\x78\xad\x1c\x6b\x2e\x05\xeb\x82\x16\x49\x55\x8d\xe7\x58\x27\xb5\x96\x51\x43\x5b\x16\x43\x1a\xd7\x75\x29\x51\x8e\x2f\x29\x17\xa5\x95\x51\xdb\x19\x30\x33\x34\x2f\x6b\x24\x24\x50\xa7\x39\xa2\x9a\x09\x4e\x71\xa5\xd3\xb2\xb1\xc8\xb7\x12\x84\x32\x11\x1a\x85\x4f\x73\x89\x62\x82\x0d\x2b\xe5\x0a\x4a\x80\xf7\x98\x0f\xce\x54\x25\x93\xa8\x70\xb3\x76\xd1\x54\x11\xe8\xa8\xe8\x44\x2d\x8a\x2f\xd6\x54\xe0\xf0\x3d\x55\xed\xe2\x3d\x5d\x5d\xa7\x1c\x4e\x3c\xac\x8b\x69\xc7\xd6\x25\x17\x54\xc4\x30\x57\x06\x20\x86\x73\x4b\x53\x67\x4d\xca\x3c\x07\x3c\x91\xbd\xb9\x93\x31\xaf\xf8\xc6\x4f\x20\x02\x8b\xee\x02\xc2\x96\x83\xae\xf1\xf7\x21\x40\x3e\xc7\x4a\xcf\xda\x0f\x1a\x39\x97\x48\x7e\xf9\x49\x2b\xbb\xc0\xdd\x03\xb3\x63\x27\xd1\xfe\x90\x1e\xe4\x0a\x94\xb9\x96\xfe\x11\x85\x1f\xdc\xd9\x0d\xf7\x5c\x49\x61\xd2\x22\x30\x2d\x5f\x97\x16\x02\x15\x0b\xb3\x56\xc2\xc4\xda\x34\x42\xdb\x90\xd9\xf4\x54\xbe\x97\x69\xe3\x1f\x99\x0e\xa4\x7d\x0a\x6a\x64\x43\xb1\x90\xf8\x4a\x49\xfa\xe1\xfe\x50\x15\x45\xa7\x0e\xb6\x4c\x6b\x49\x56\x4b\xc7\xb0\x39\xd0\x38\x6e\xf7\xd5\x5a\x88\x1b\x96\x16\x69\x21\x03\x35\xa3\x10\x02\xdb\x4b\xf2\xbc\xe9\xbb\x58\xc0\x11\x26\x81\x4d\xa2\x26\xb4\xd6\x21\xd8\x64\x25\xd6\x96\x59\xc0\xaa\xcc\x47\x1e\xdc\x8e\xf0\xd4\x09\xb2\x44\x96\x9e\x87\x36\x5e\x59\xef\xb3\x44\x0b\x5d\x15\x0a\xce\x5b\xb5\x60\x2e\xf1\xfd\xd4\x97\xa6\x70\x69\xc1\x3d\x47\x96\xb2\xe2\xfa\xe4\x36\xc9\xe7\x99\x28\x2f\x8f\xcf\xa7\x7f\x1e\xbf\xda\x96\x75\x40\x6c\x2e\x53\xd1\x58\xdf\x9e\xbc\x89\xcc\x80\xb5\x59\x08\xad\x3e\x78\x7a\x8f\xc6\x5e\x49\x09\x53\x6d\x48\x0c\xeb\xe0\x6e\xc5\x8a\x7c\xf7\x06\x86\x30\x18\xb6\x27\x57\xcd\xdc\xa6\x46\x79\x92\x4b\x6e\x85\x2b\x98\x3e\xdb\x86\xc4\xfe\x12\x9c\x0a\xdd\xc0\x8b\x66\x39\x1b\x12\x03\x1b\x06\x91\x33\xe0\xd5\x80\x80\x59\x25\xf4\xed\xb6\xc2\x3e\x66\x77\x9a\xc2\xe7\xf0\xcc\x88\xb4\x5c\xc0\x41\x84\xee\x46\x94\x60\xdf\x10\x4f\xb1\x89\x93\x2c\xc3\x66\x2c\x85\x82\xdb\x2e\x72\xca\x97\x44\x77\x6c\x89\xf2\x7b\x99\x5c\x06\xfe\xf3\x19\xd5\x95\x98\xea\x72\x9d\xec\xf7\xb7\xa6\x3f\xc6\x5c\x9f\xc5\x5e\x23\xfa\x20\x4d\xcd\x26\x7d\x33\x3f\x1e\xa5\xc5\xd8\xe9\x04\x62\x44\x99\x70\xe8\x56\x0a\xed\x22\xa4\x29\x17\x4c\x8a\xe6\xe0\x07\x94\xcd\x74\xd2\xb6\x40\xec\x9a\x98\x2a\xa4\x72\x2e\x33\x56\x4f\x99\xb2\x69\x20\x02\x99\xf5\xb7\x93\x25\xb6\xdb\x64\x6e\x8b\xb4\x2d\x9a\xee\x50\x64\xdb\xe9\x8b\xfb\x25\xc6\x56\xe7\x87\x37\x91\x7b\x60\x38\xeb\x7c\x5a\xf3\x28\x11\x1b\x33\x2e\x18\x4f\x67\x27\x50\x6d\x64\x6c\x8e\x7b\x9b\x32\xe4\x01\x8b\x4d\xe7\x0f\x5d\x40\x1e\xea\xb2\x21\x27\xfd\xdc\xc0\x2a\xb6\xf3\xc6\xa6\x9d\xf3\x80\xc7\x23\x46\x77\x0d\x51\xc8\x85\x7f\x82\x75\x18\x1e\x6e\x65\xcf\x23\xa3\xc5\xdd\x96\x5e\x23\x5c\xb1\x50\x03\xce\xed\xfc\xa0\x7d\x2c\xe4\x3a\xc4\x80\xad\xda\x86\x59\x95\xca\xad\xdb\x9c\xeb\xd3\x14\xdb\x66\xa5\xfc\x58\xc9\x63\x0f\xe5\x32\x52\xcc\xe6\xba\x66\x79\x2b\xb7\x7c\x1a\x75\xcc\xbd\x9b\x41\xf7\xc7\x03\x96\x02\xfd\x5f\x3d\x46\x10\x97\x31\x86\x4f\xfe\x0d\xff\x6f\xc2\xf2\xbd\x36\x00\xaf\xa1\xdb\x04\xbe\x3e\xaf\x8e\x23\x04\xdf\x8c\x58\x03\x0f\xc1\xff\x41\xc4\xc8\xc7\x48\xe2\x5d\x43\x7b\x5e\xc9\xb7\xdd\xe7\x07\x0f\xff\xe0\xe1\x1f\x3c\xfc\x9d\xf3\xf0\x86\x50\xee\x10\xf1\x4f\x74\xf0\x45\x3f\xf1\xf0\xf8\x6c\x3a\xf9\x95\xce\x8f\x67\xa3\xff\x7e\xdb\x86\x0c\x2f\x3d\x63\x6f\x7b\x02\x9d\x62\xdc\x65\x0c\xf1\x62\xeb\xff\xf1\xce\x02\x65\x88\x92\xe2\xd7\x96\xa4\xb7\x6d\x02\x21\x79\xeb\x6a\x29\x8c\x70\x9c\xc0\x78\xe5\xb9\xc1\x44\x9e\xf5\x6f\xa9\xf0\xf7\x9e\xbe\x1a\xaf\xf9\xc5\xa5\x43\xb1\xfc\xd1\x3a\x51\x2d\x7d\xe7\x09\xf7\x28\x5b\xeb\x5e\x9c\xdf\x31\x9a\xd3\xd1\x60\xf0\xe2\x60\x70\x78\x30\x38\x9a\x1d\x3e\x1f\x0e\x9e\x0d\x07\xcf\xdf\x32\x09\x3c\xb0\xde\x3f\x3c\xfa\xf9\xed\x36\x7a\xae\x7d\x57\xea\x32\x72\x27\xe3\x5b\xbb\x87\x34\x99\x9e\xff\x36\xba\x1c\xcd\xa6\x97\x08\xed\xd9\xec\x78\x13\xd8\x71\xb0\xfc\xeb\x46\x71\x34\x99\x4c\xff\xb8\x98\xfd\xdf\x71\xbc\x08\xe5\x1a\xbe\x21\x89\x01\x8c\x5f\x0c\x25\xfe\x25\x27\x45\x7d\xb9\x47\x62\x35\xda\x7c\x0d\x35\x61\x21\x64\xf4\xde\x43\x10\xde\xfb\x9e\xe9\x31\xd8\xfe\xde\xf9\x07\xf1\x67\xc6\x01\x29\x15\x00\x00")
 
 func queryGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -152,12 +173,12 @@ func queryGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "query.graphql", size: 4734, mode: os.FileMode(420), modTime: time.Unix(1600451382, 0)}
+	info := bindataFileInfo{name: "query.graphql", size: 5417, mode: os.FileMode(420), modTime: time.Unix(1792292130, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _query_alphaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x41\x4f\xe3\x38\x14\xbe\xf7\x57\xbc\xf6\xb0\x5b\x24\xd4\xc3\xee\xad\x12\x87\xd0\x84\xa5\xa2\x34\x6c\x13\x0e\xab\x15\xaa\x9c\xe4\xb5\xb1\x70\xec\xca\x76\x86\x89\x10\xff\x7d\x64\x27\x6e\x9b\x92\x99\x02\x1a\x55\x9a\x11\xb9\x10\x99\xf7\xbe\xf7\x3d\xe7\xfb\xfc\x5c\x5d\x6d\x10\xfe\x2d\x51\x56\xf0\xdc\x03\x00\x18\x0c\x06\xde\xec\xee\xda\x83\x7f\x50\x03\x01\x45\xf9\x9a\x21\x24\x4c\xa4\x8f\x90\x54\x40\xb5\x82\xa9\x0f\x42\xda\x37\x5e\x16\x09\xca\x11\xfc\x27\x4a\x48\x09\xe7\x42\x83\xda\x60\x4a\x57\x15\x24\x42\xe7\xa3\xc1\x60\x60\x41\x6d\xfa\xd0\xbe\x9a\x87\x66\x63\x88\xb4\xa4\x7c\x7d\xbe\x5d\xe3\x65\x31\x86\x7b\xca\xf5\xdf\x7f\xd9\xb5\xb3\x31\x5c\x9a\xac\x9e\x63\x65\xff\xee\x53\x63\x54\x69\x10\x2b\x48\x05\xd7\x92\xa4\x1a\xb4\x78\x44\xae\x5a\xf1\xf5\xd2\xae\xb2\x5b\x37\xcf\xac\xc9\xb7\x31\xa0\xaa\x22\x11\x4c\xc1\x30\x08\xa3\x33\xd0\x02\x56\x94\x69\x94\xa0\x73\x04\x89\xaa\x64\x5a\x01\x59\x13\xca\x95\xee\x44\xb3\x28\x51\x0d\x32\x86\xff\xeb\xf6\xfa\x0f\xbd\x37\x94\x76\x0d\x28\x18\xa2\x50\x54\x8c\xec\xf2\x87\x49\x4c\x1c\xdc\x51\x1a\x93\x52\x2a\x21\xa1\x54\x98\xc1\x4a\x48\xd8\x90\x35\xe5\x44\x53\xc1\x3b\xc3\x53\x1b\xee\x3e\x5d\x37\xe4\x2d\xf9\x4a\x8b\xb2\x68\x94\x61\x7a\x74\xbc\xb5\x00\xca\x53\x56\x66\x08\x94\x03\x69\xd6\x3b\x41\x18\x2d\xa8\xde\xaa\xa1\x33\x24\xb6\x3b\x47\xb4\x96\x34\x29\x35\xd6\x3d\x68\x01\x4a\x48\xbd\xbf\x5d\x9d\xc9\x26\xe8\x8a\x22\xcb\xc6\x10\x87\x37\xc1\x3c\x5a\x46\xe1\x22\x5e\x5e\x4d\x83\x99\x0f\x17\x70\x1d\xce\xfc\x60\x11\x75\x17\xf6\xa9\xc4\xd4\x6c\x91\xe9\xe2\x29\xa7\x69\xfe\xae\xb2\xa1\xcc\xd0\x6c\xa1\xa9\x17\x2e\xfc\x60\x01\x17\xe0\x07\xd1\xc4\x69\x3e\x6e\xbe\x20\xaf\x8b\xf4\x8f\xcb\xbf\xd6\x50\x42\x18\xe1\x29\x2a\xfb\x1d\x49\xe3\x42\x9a\x02\x49\x53\x51\x72\xdd\x42\x69\xd6\x2e\x9b\x94\x6e\x77\xc4\x39\xba\x40\x78\xca\x85\xc2\x5d\x89\x4a\x94\x40\xa4\xe9\x55\x4b\x8a\x5f\xd0\x88\xa1\x0b\xa2\x49\x77\x82\xe9\x9f\x4c\x84\x9f\xce\xfe\xd5\x6c\xe8\x4d\x26\xe1\xfd\x3c\x5e\x5e\x7a\x33\x6f\x3e\x09\x0e\x0c\xe9\xdd\x9a\x7f\x9e\xd6\x8f\xdb\x28\xb1\x31\xe8\x66\xcb\x0f\x48\x2e\xc3\xbb\x78\x1a\xce\xfb\x0f\xce\xbb\x5e\xcb\x57\x3f\xd1\xc4\xdb\x09\xf7\x47\x23\xe6\xd7\x33\xee\xb8\x99\x6d\xd8\x9f\x6a\x07\x76\x60\xe3\xef\xb9\xd8\xc5\x1f\xb1\xf1\x7e\x89\x9a\xe4\x5b\x0b\xd4\xd1\x47\xe0\xdb\xbe\xca\x05\xcb\x50\xaa\x8f\xfa\xe8\xba\x4e\xff\x9c\x8f\x6f\x9c\x8f\xbf\xab\x2d\x5f\x7a\xbd\x1e\xf2\xb2\xd8\xaf\x53\xdf\x82\xbd\x66\x22\xdb\x9a\x2f\x4d\xd4\xeb\xbb\xc2\x73\xcb\x88\xb6\xbb\xa4\x6a\x8d\x1d\x18\xd2\x11\x8e\xc0\x8e\x1e\xc2\x36\x39\xe1\x65\x81\x92\xa6\x84\xb1\xea\xf5\xa9\xd0\x09\xb7\x93\x4a\x7d\x9b\x75\xea\x6f\x05\xbb\x3b\x8b\xe3\xfa\xa3\x03\xf5\x24\xac\x49\x61\xaf\x0e\x7b\xac\x91\x65\xed\xdc\x5a\x3e\xad\xdd\x7d\x07\x5f\x77\x39\xe1\xa4\xc0\x93\x91\xec\xd6\xda\x01\x43\x67\x63\x14\x0a\x94\x26\x8f\x98\xb9\x42\x74\x7b\xc6\x9f\x83\xce\xa9\x32\x3f\x9a\x80\xac\x56\x98\x6a\x10\x46\xf2\xee\x8c\x74\x50\x41\x18\x2d\xa7\xf3\xc9\xec\xde\x0f\x96\x51\xec\xdd\x04\xbe\xa1\xf2\x2d\x00\x00\xff\xff\x92\xa8\xc9\x8f\xb5\x0d\x00\x00")
+var _query_alphaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x58\x51\x4f\xdb\x30\x10\x7e\xef\xaf\x38\x78\xd8\x40\x42\x7d\xd8\xde\x2a\xed\x21\xb4\x65\x54\x94\x86\xd1\xa0\x69\x9a\xa6\xca\x49\xaf\xc4\xc2\xb1\xbb\xd8\x61\xab\x26\xfe\xfb\xce\x4e\xdc\xa6\x25\xac\x80\x10\x13\x52\xf3\xd2\xd4\x3d\x7f\xf7\x9d\xef\xee\xcb\xa5\x66\x31\x47\xf8\x52\x60\xbe\x80\x3f\x2d\xa0\x6b\x7f\x7f\x3f\x18\x5e\x9c\x06\xf0\x19\x0d\x30\xd0\x5c\x5e\x0b\x84\x58\xa8\xe4\x06\xe2\x05\x70\xa3\x61\xd0\x03\x95\xbb\x3b\x59\x64\x31\xe6\x6d\xf8\xa6\x0a\x48\x98\x94\xca\x80\x9e\x63\xc2\x67\x0b\x88\x95\x49\xdb\x04\xe6\x40\xdd\xf6\x03\x77\x6b\x2f\x3e\xed\xc0\xd8\xe4\x04\x7d\xb4\x5c\x23\xa8\x0e\x5c\x71\x69\x3e\x7e\x70\x6b\x87\x1d\x38\xb6\xbb\x5a\x9e\x95\xfb\xac\x53\x13\x5c\x1b\x50\x33\x48\x94\x34\x39\x4b\x0c\x18\x75\x83\x52\xaf\xd9\x97\x4b\x2b\xcf\x7e\xdd\x5e\xc3\x6a\xbf\xb3\x01\xbd\xc8\x62\x25\x34\x1c\xf4\xc3\xf1\x21\xad\xc1\x8c\x0b\x83\x39\x98\x14\x21\x47\x5d\x08\x0a\x97\x5d\x33\x2e\xb5\x69\x44\x73\x28\xe3\x12\xa4\x03\xdf\xcb\xf0\xf6\x7e\xb4\x1e\xe1\xda\x07\x40\xce\x51\x69\xae\xda\x6e\xf9\xd9\x24\xba\x1e\x6e\x2b\x8d\x6e\x91\x6b\xca\x64\xa1\x71\x0a\x33\xba\x99\xb3\x6b\x2e\x99\xe1\x4a\x36\x9a\x27\xce\xdc\xa7\xae\x19\xf2\x9c\xfd\xe6\x59\x91\x55\x95\x61\x63\xf4\xbc\x29\x1a\x2e\x13\x51\x4c\x91\x3e\x29\x7d\xe5\x7a\x23\x88\xe0\x19\x37\xcb\x6a\x68\x34\x89\xdc\xc9\x31\x43\x54\xe2\xc2\x60\x19\x03\xb9\x20\x82\xa6\x7e\x5c\x8d\x9b\xad\xd1\x09\x47\x41\x65\x18\x85\x67\xfd\xd1\x78\x32\x0e\x2f\xa3\xc9\xc9\xa0\x3f\xec\xc1\x27\x38\x0d\x87\xbd\xfe\xe5\xb8\xd9\x71\x8f\xe7\x98\xd8\x23\xb2\x51\xfc\x4a\x79\x92\x3e\xc9\x6d\x98\x4f\xd1\x1e\xa1\xf5\x17\x5e\x92\x1b\xf2\xd7\xeb\x8f\xbb\xcd\xce\xbe\xa6\x14\xa4\x4b\x6a\x86\x86\x8e\xac\x90\xda\xba\x4d\x91\x4d\x21\x53\x53\x3c\x22\x7f\xa6\xc8\xa5\x73\x7d\xcb\x44\x81\xda\x95\x15\x7d\x13\x8c\x4a\x8c\xe7\x39\xde\x62\xae\x79\xbc\xec\x61\x5b\x3b\x76\x7b\x65\xe6\xa0\xdc\x2f\x8d\x04\xea\x00\xa1\x14\x0b\x6a\x4a\xa5\x04\x32\x49\xb4\x67\x4c\x68\xf4\xbd\x1a\x55\x95\x27\xcb\xc3\xd9\xdb\xde\xb6\x65\xed\xc7\x4c\x30\x99\x10\x6d\x5b\x7f\xac\x52\x0f\x9e\x00\x4b\x12\x55\x48\xb3\x86\x52\xad\x1d\x57\x5b\x9a\xbb\x3a\xa2\xa0\x2a\x43\x4a\x8f\xd2\xb8\x72\xb1\x20\x95\x62\xb9\xcd\x11\x55\x0d\x85\x65\x8b\xb8\x09\xa2\xda\xee\x0b\x7d\xef\xd5\x9a\x67\xa7\x48\x6f\x4d\x3e\x82\x6e\x37\xbc\x1a\x45\x93\xe3\x60\x18\x8c\xba\xfd\x0d\x21\x09\xce\xed\x8f\xff\x49\x47\xd4\xdc\xa2\xdb\x23\xdf\x20\x39\x09\x2f\xa2\x41\x38\x7a\x28\x05\xee\xa9\x4b\x47\xe3\x68\xa0\x74\x4a\x51\xf1\x4b\x6b\xcd\x54\x35\x12\x89\x0f\x4e\x8f\x2a\xc5\x31\x48\x45\xb4\xb4\xf8\x65\xc5\x8b\xc5\x1a\xa5\x69\xc3\x25\xfe\x2c\x28\x62\xed\x2c\x57\x8a\x96\x52\xd9\x29\x9a\x3d\x28\xf4\xd8\x7a\x63\xa4\x33\xd3\x76\x73\x57\x1a\xc7\x6c\x54\x9b\x13\xde\xb8\x66\x06\x6b\x7a\xf6\x82\xe2\xb9\x9c\x88\xde\x55\x22\x72\x7f\x26\xda\x2e\xa2\xce\xec\xbd\x5e\x81\x6d\xc8\xe7\x43\xea\xe9\xed\xb7\xc8\x67\xdd\x45\x49\xf2\xb1\x0e\x4a\xeb\x2d\xf0\xeb\x7a\x96\x2a\x41\xed\xa2\x9f\xab\x5f\xa7\xe5\xf6\xdd\x3c\xf5\xc8\x79\x6a\x27\x87\x3b\x39\x7c\x49\x39\xbc\x6b\xb5\x5a\x48\x1d\x50\xcf\x6f\xf9\xb6\x1a\x50\x8a\x5d\xf9\xd8\x5c\xdf\x55\x56\xf7\x67\xfa\xe5\xab\x6d\xcb\x17\x8c\x7d\x97\xad\x8f\x59\x70\xc0\xdb\xd8\x06\x37\x6a\x31\x31\x4f\x19\x01\x61\xce\x13\x26\xc4\xe2\xbe\x1a\x37\xc2\xad\x5a\xb4\x7c\xeb\xf4\xaa\xb3\x66\xec\xdf\x2d\x3c\xd7\x7f\x0d\x10\xaf\xc2\x9a\x65\x6e\x54\xae\xb1\xa6\x3e\x5e\xdf\x5b\xb6\xed\xda\xe9\x3e\x81\xaf\x1f\xc6\x25\xcb\xf0\xd5\x48\x36\xf7\xf8\x06\x43\x2f\x9f\x34\xe2\x82\x36\xec\x86\x14\xaf\x72\xc4\x97\xcf\x56\xdb\xcb\x5c\xdb\x3f\x37\x80\xcd\x66\x54\x90\xa0\xac\xd4\xf8\x67\x93\x87\xa2\x0c\x4c\x06\xa3\xee\xf0\xaa\xd7\x9f\x8c\xa3\xe0\xac\xdf\xb3\x54\xfe\x02\x50\xee\xff\x95\x5d\x11\x00\x00")
 
 func query_alphaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -172,12 +193,12 @@ func query_alphaGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "query_alpha.graphql", size: 3509, mode: os.FileMode(420), modTime: time.Unix(1599276610, 0)}
+	info := bindataFileInfo{name: "query_alpha.graphql", size: 4445, mode: os.FileMode(420), modTime: time.Unix(1792297229, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4e\xce\x48\xcd\x4d\x54\xa8\xe6\x52\x50\x50\x50\x28\x2c\x4d\x2d\xaa\xb4\x52\x08\x04\x51\x60\x81\xe2\xd2\xa4\xe2\xe4\xa2\xcc\x82\x92\xcc\xfc\x3c\x2b\x85\x60\x24\x1e\x57\x2d\x17\x20\x00\x00\xff\xff\x52\xd9\x58\xe5\x3b\x00\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2b\x4e\xce\x48\xcd\x4d\x54\xa8\xe6\x52\x00\x82\xc2\xd2\xd4\xa2\x4a\x2b\x85\x40\x10\x05\x16\xc8\x2d\x2d\x49\x2c\xc9\xcc\xcf\xb3\x52\xf0\x85\xb2\xc0\xc2\xc5\xa5\x49\xc5\xc9\x45\x99\x05\x10\xa9\x60\x24\x1e\x57\x2d\x17\x00\x2c\x13\x71\xb2\x52\x00\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -192,7 +213,7 @@ func schemaGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.graphql", size: 59, mode: os.FileMode(420), modTime: time.Unix(1588358533, 0)}
+	info := bindataFileInfo{name: "schema.graphql", size: 82, mode: os.FileMode(420), modTime: time.Unix(1792295560, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -217,7 +238,7 @@ func search_transactionGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _subscriptionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xe7\x57\xbc\xea\xe4\x04\x8a\x90\x7e\xa0\x07\x01\x39\xd8\xa8\x8b\x18\x48\x2c\xd4\x76\x9b\x2b\x47\xcb\xa1\xb8\xf0\x72\x56\xde\x0f\xb1\x4a\xd1\xff\x5e\xcc\x92\x92\x68\xb7\x6e\xd1\x53\x72\xc8\x49\x14\xb9\x33\xf3\xe6\xed\x9b\x37\x69\xbf\x65\xdc\xe6\x75\x34\xc1\x6e\x93\xf5\x82\x3f\x5e\x00\xc0\x6c\x36\x2b\xbf\xb7\x4c\xc1\xb4\x48\x2d\x63\xed\xbc\xb9\x37\x2d\x59\x41\xe3\x43\x4f\xa1\xd6\x5f\xa4\x40\x12\xc9\x94\xd8\x57\xfc\x3b\x9b\x5c\x1e\x53\x20\xc3\xf1\x15\xd6\x14\xb9\x86\x17\x54\x0f\x99\xc3\xbe\x5a\xbc\x28\x79\x3f\x9e\xdf\x5c\x2f\x41\xae\xa7\x7d\x84\xf1\x12\x6d\xcd\xa1\x94\xa9\xb2\xd4\xbe\x42\x63\xd9\xd5\x98\xd4\x8a\x05\x09\xc7\x39\xfa\xd6\x9a\x16\xd1\x6e\x84\x1c\x52\x4b\xa9\xc4\x75\x94\x4c\x6b\x65\x03\x76\xdc\xb1\xa4\x52\xa6\xa7\x58\x72\x90\x49\xb8\xb9\xfc\xb0\xfa\xed\xf2\x27\x34\xc1\x77\x25\x62\xe8\x65\xcd\x86\x72\x64\xf8\x66\xe8\x30\x22\xb0\x0f\x1b\x12\xfb\x89\xb4\x93\xc5\x23\x3e\x06\x14\x77\xa7\x9e\xe3\xcf\x03\xbe\xb3\xf2\xb9\x1c\xad\x1b\xcd\x37\x32\xf7\x8b\x76\x8d\xf7\x24\x9b\x4c\x1b\x46\x4c\xc1\xca\x66\x76\x3c\x5c\x48\x59\xe2\xb6\xbc\xfe\xe6\xc5\x29\xc9\x7b\xdf\x73\x18\x10\x41\x72\x87\xb5\xcf\x52\x53\xd8\xcf\x61\xc5\xb8\x1c\xed\x8e\xdd\x7e\x81\x73\x08\x6f\x28\xd9\x1d\x63\x47\x2e\x33\x3a\x26\x89\xa0\x31\x32\xb0\x1b\x3e\x26\x5f\x5a\x6e\x99\x6a\xf8\x00\x47\x31\xc1\x86\xc0\x3b\x0e\xd1\xae\xdd\x78\xbb\x38\xab\x79\xcb\x52\x2b\x8d\x7a\x65\xd3\x13\x2b\x71\xfb\xea\xe5\xe2\x04\xdd\xf9\xfe\x42\x83\xae\x73\xb7\xc4\x95\xa4\x1f\x7f\x98\xc0\x7f\x67\x37\xed\x17\x89\x1f\xe7\xa8\x24\x3b\x57\x3d\xaa\x27\x1e\xed\x80\xd8\xd9\xce\xa6\x38\xd7\x6a\x81\x1b\x1f\x78\xbc\x72\x4d\x69\x65\x84\xd1\xe4\x94\x43\x91\xcc\x51\x47\x13\x62\x34\xd3\xf3\xcc\xac\xb6\xf4\x90\x19\x35\x25\xc2\xd6\xb2\xe1\x41\xc2\x7b\x9f\x61\x48\xb0\xa5\x18\xb1\x26\x73\xaf\x2d\x1b\x2f\xc9\x4a\x66\xfd\x1a\x46\x20\xb0\x0d\x6c\x82\xf6\x85\xda\x46\xe3\x45\xd8\x24\xae\x17\xb8\xe1\x14\x2c\xef\x58\x3f\x1f\x45\x5e\x99\x1c\xa2\x0f\x93\x81\xd2\xb7\x81\xe3\xd6\x4b\xe4\x38\xf4\x60\x23\x0c\x39\xb7\xc0\x55\x82\x8d\x88\xd4\x14\xc6\x55\xc6\x7a\x3a\x52\xc7\x18\xf2\x68\x82\x8b\xd5\xdd\x3b\xd4\x36\xf0\x30\x00\x38\x3b\x8c\x28\x49\x5d\xa0\xeb\x9f\xa9\x52\x86\xd0\x83\xca\xa7\x22\x57\xb2\x4b\x09\xc9\xdd\x9a\x83\xa2\x09\x1c\xb3\x4b\x51\x27\x85\xa9\xe3\x21\xe3\x54\x76\x1a\x33\xd2\x8a\xb7\x78\x33\x49\xf7\xb1\x65\x35\x9e\xcc\x73\x78\x71\xfb\x31\xc5\xc0\xe6\x21\xad\x97\xc2\x38\xef\x07\xa6\xb5\xf6\x49\x25\xd6\xd9\xb4\x3f\x4a\x75\x81\x95\xaa\xa0\xb7\x91\xe7\x20\xe7\x7c\x8f\x86\x47\x93\x39\xa4\xcb\xdb\x47\xd2\x2c\x2a\x9c\x80\x7d\x2a\xc0\x25\x2e\xbc\x77\x4c\x82\xb7\x68\xc8\x45\x9e\xa0\x9f\xcd\xae\x1a\x88\x97\xd7\x9f\x38\x78\x1d\x93\xda\x1a\x4a\x1c\x8b\x34\x7a\x92\xa4\x95\x3a\x0a\xf7\xc3\x9d\x0c\xbd\xf5\xda\x72\x60\x1a\x50\x39\x1d\x95\xc1\xc5\x54\xe8\x7a\x98\x83\xde\x28\x1d\x6f\x1c\xbd\x4d\x2d\x08\x55\x31\xe8\x0a\xfc\x90\xd5\x45\xfd\x38\x15\x07\x77\xed\xad\x73\x58\xab\xf8\x25\x81\x12\xb4\x02\x2a\xcd\xff\xa1\x24\xbd\x92\xc4\x61\x47\xae\x3a\x96\xbb\xd3\xb9\xb0\x21\xa6\x63\xea\xe4\x35\xc3\x93\x02\x2a\x20\x3a\xa0\x7f\xdc\x23\x05\x86\xf8\x1e\xdb\xe0\x0d\xc7\xf8\xb4\xa1\x13\x55\x5a\x6a\x98\x5e\x5d\x40\xff\x88\xaa\xf4\x7c\x12\xd5\x21\xc5\x84\xec\x89\xa0\x9e\x86\x2f\xf1\xab\x95\xf4\xfd\x77\x27\x79\xbd\x5c\x8e\x7e\x3e\x71\xfe\xd1\xf8\x6f\x46\x62\x47\xf3\xfe\xf7\xc5\x79\x98\x8e\xbf\x6d\xce\xa7\x8b\xf3\xb9\xbd\x79\xbd\xba\xbb\x5c\x16\x02\x1e\xef\x49\xb5\xb0\xa4\x03\x5b\x46\xfc\x50\x26\x8e\x9e\xf1\x5f\x3b\xec\x62\x3c\xff\x99\x96\x98\xb6\x43\xeb\xc8\x3a\x9a\xbe\x01\x0d\x97\x3b\x57\xa3\x1f\x9f\xf5\xf5\x9b\xd1\xab\x0b\xa5\xbc\xb1\x22\xc5\xe6\xa7\x1e\xfc\x75\x1d\xfe\x4f\xfc\x73\xbc\xfe\x16\x6b\xd6\x03\xcf\x7a\xd8\xd7\x75\xf6\x05\xaf\xb3\x42\x76\x4b\x3b\x2e\x4c\x73\xfd\xf9\x17\xda\x73\x76\x79\xf0\x98\x89\x5f\xfe\xf9\x57\x00\x00\x00\xff\xff\x93\x1e\x2f\x48\x7e\x0c\x00\x00")
+var _subscriptionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x58\x4d\x6f\x1b\x37\x10\xbd\xfb\x57\x4c\x7d\x72\x02\x45\x49\xda\xa2\x07\x01\x3d\xd8\x89\x8b\x18\x70\xac\xd4\x76\x92\x43\x51\x74\xa9\xdd\x91\xc4\x9a\x22\x37\x24\xd7\xea\xa6\xe8\x7f\xef\xcc\x90\x6b\x51\xb6\x55\xa3\x69\x81\xe4\x10\x5f\x24\x71\xc9\xf9\x78\x7c\xf3\x66\xd6\xb1\x6f\x11\x2e\xba\x59\xa8\xbd\x6e\xa3\x76\x16\xfe\xdc\x03\xfa\xdb\xdf\xdf\x97\xcf\x0b\x54\xbe\x5e\x42\x5c\x22\xcc\x8c\xab\xaf\xea\xa5\xd2\x16\xe6\xce\xaf\x95\x6f\xf8\x13\xa2\x57\x36\xa8\x5a\xce\x3e\xc6\x3f\xb0\xee\xe4\x2b\x2d\xd7\x18\x1e\xc3\x4c\x05\x6c\x80\x16\xaa\x0f\x1d\xfa\xbe\x1a\xef\x89\xdd\xf7\x87\xe7\x67\x13\x50\x66\xad\xfa\x00\xb5\xb3\x41\x37\xe8\xc5\x4d\xd5\xd9\xc6\x55\x30\xd7\x68\x1a\x28\x7c\x05\x89\x04\xc3\x08\xd6\x4b\x4d\x21\x05\xbd\xb0\xca\xd0\x11\x15\xe5\xdc\x4a\xc5\x7a\xa9\xed\x02\xd0\xe0\x0a\x6d\x14\x37\x6b\x15\xc4\x06\xc5\x07\xe7\xc7\xaf\xa7\xef\x8e\x5f\xc2\xdc\xbb\x95\x9c\x48\xb9\xcc\xb0\x56\x5d\x40\x70\xf3\x94\x61\x00\x8f\xce\x2f\x94\xd5\x1f\x15\x67\x32\xde\xc2\x23\x45\x71\xb9\xc9\x39\xfc\x94\xe2\x3b\x90\xc7\xb2\xb5\x99\xb3\xbd\x8c\xdc\xcf\x9c\x35\x9c\x2a\xbb\xe8\xd4\x02\x21\x44\x4f\x31\xee\xdf\x6c\x16\x50\x26\x70\x21\xcb\xdf\xec\x6d\x8c\x9c\xba\x35\x01\x22\x11\x81\xed\x56\x30\x73\x84\x8b\xf2\xfd\x88\xf2\xa9\x4d\x17\xf4\x35\x9a\x7e\x0c\x87\x60\x71\x41\x71\x5e\x23\x5c\x2b\xd3\x11\x0c\x48\xa1\x81\xca\x27\x3d\x9a\xf4\x30\x3a\x49\x79\x89\x8a\x2e\xc3\x83\x51\x21\x82\xf6\x1e\xaf\xd1\x07\x3d\x33\xf9\x76\xe1\xa0\xc1\x16\x6d\xc3\x30\xf2\x95\x95\x3b\xa6\xd6\xf4\xd5\xa3\xf1\x26\x74\xe3\xd6\x47\x7c\xe8\xac\x5b\x4d\xe0\xc4\xc6\x1f\xbe\x2f\xc2\x7f\xa5\x17\xcb\x2f\x32\x7e\xf2\x58\xd9\xce\x98\x6a\xcb\x9f\x75\xb0\x4c\x11\x1b\xbd\xd2\x91\x48\x46\xde\x3c\x12\xf7\x30\x5f\x39\x9b\xd4\x36\x87\x31\xef\x62\xe7\x85\x32\x37\x3c\x2a\x80\x61\x4b\xbb\x91\x99\xb6\x8a\x2e\x1d\x1a\x15\x15\xb4\x1a\x6b\x4c\x14\xee\x5d\x07\xb5\xb2\xd0\xaa\x10\xa8\x68\x28\x17\xf2\x45\x85\x11\xb5\xa5\xdd\xf4\xd4\xe7\x40\x40\xcf\x41\x47\xe0\xbc\xa0\xd1\x81\xb6\x58\xac\x23\x36\x63\x38\x47\x62\x11\xad\xf3\xe3\x1b\x92\x57\x75\xe7\x83\xf3\x45\x41\xf1\xaa\xc7\xd0\x12\x77\x31\xa4\x1c\x34\xd5\xa0\x32\x66\x0c\x27\x84\x6a\x80\xa0\xe6\x82\x38\xd3\x98\x77\x07\xb5\xa2\x2c\xc5\x0e\x1b\x38\x9a\x5e\xbe\x22\xd7\x1e\x53\x01\xc0\xc1\x50\xa2\xca\x36\x12\x3a\xff\x28\x99\x92\x8e\x0e\x2c\x2f\x49\xce\x60\x8b\x0b\xa2\xc8\x8c\x12\xa2\x68\x28\xb4\xce\xc4\xc0\x95\x82\xe4\x37\x59\x2c\x69\xc7\x67\x32\xac\xf0\x23\x3c\x2b\xcc\xbd\x5f\x22\x0b\x4f\x87\x23\xba\x7d\xd3\x67\x13\x09\xcd\xc1\xac\xb3\x82\x38\xf6\x09\x69\xf6\xbd\x61\x89\x36\x3a\xf6\x37\x54\x1d\xc3\x94\x59\xb0\xd6\x81\x0c\x12\x3c\x6e\x0d\x73\xcc\x22\x33\x98\xeb\xda\x2d\x6a\x0a\x0b\x8b\x60\x6f\x13\x70\x02\x47\xce\x19\xa2\x1c\x45\x3e\x57\x26\x60\x11\xfd\xfe\xfe\xc9\x9c\x88\x68\x9f\x7c\x44\xef\xb8\x4c\x1a\x5d\xab\x48\x57\xc4\xd4\x58\x2b\x1b\xd9\xd3\x4a\xf9\xab\x74\x27\x29\xb7\x35\xa7\x4c\xdf\x52\x54\x86\x4b\x25\xa9\x18\x13\x9d\x37\x13\xa8\x9a\xeb\x69\xb8\x71\x58\xeb\xb8\xa4\xdf\x95\x08\x74\x05\xf8\xa1\x63\x15\x75\xb9\x2a\x06\x75\x5d\x6b\x63\x48\x1a\x89\x73\xe4\x97\xe8\xc9\x1e\xa0\x62\xfb\xaf\xc5\x28\xc1\x8f\x9e\x2a\xa8\xba\x71\x77\xc9\x75\xa1\x3d\xd5\xe5\x60\x9a\x8c\x92\x85\x5b\x0e\x98\x40\x6a\x88\x7e\x3b\x47\x45\x25\x65\x09\xe4\xd6\x3b\x6a\x1d\xe1\x76\x42\x1b\xa8\xd8\x55\xaa\x5e\x6e\x40\xf7\x46\x25\x39\x6f\x48\x35\x98\x28\xc0\x2e\x08\x75\xfb\xf8\x04\xde\x52\xa9\x7f\xf7\xed\x86\x5e\x8f\x26\x59\xcf\x0b\xe5\xcf\xc2\x7f\x9e\x81\xcd\xe2\xfd\xcf\x8d\x73\xa8\x8e\x3b\x9d\xf3\x76\xe3\xdc\xd5\x37\xcf\xa6\x97\xc7\x13\x01\x60\xbb\x4f\xb2\x84\x45\x2e\x58\x29\xf1\xc1\x4d\xc8\x9a\xf1\x50\x0f\x3b\xca\xfb\x3f\x53\x13\xe3\x74\xd4\x8c\xa8\x56\x8b\xa6\xaa\x74\xb9\x23\x16\xfa\xfc\x9d\x97\x9f\x65\xad\x16\x48\x71\xa1\xad\x15\x99\x2f\x35\xf8\x6b\x3b\xfc\x97\xf1\x8f\xe0\xc9\x73\x02\x93\x37\xec\xd4\xb0\xaf\xed\xec\x0b\x6e\x67\x02\xf6\x52\x11\x4a\x8c\x34\x99\xff\xec\x0d\x6d\x97\x5c\x0e\x1a\xb3\x4b\x2f\x53\x72\xe2\x58\x87\xe8\x48\x6f\x58\x0a\xa8\x59\xd4\x35\x85\x1f\x87\xf7\x80\x11\x45\xda\x1a\xd5\x0f\x94\xe5\xad\x94\x76\x16\x32\x50\x73\x92\xf0\x0d\x4b\x22\x43\x38\x77\x9c\xea\x70\x40\x5a\xca\xb0\x7d\x4b\x3d\x1e\x7c\x33\x09\x11\xdb\xcc\xbc\x11\x87\x56\xbd\x3d\x7b\x39\xad\x80\x97\xf3\xeb\x48\xd8\xbc\x8f\x64\x61\xff\xff\xdf\x40\x04\xa8\xc3\x84\xca\xab\x04\xd5\x61\x4a\xa7\x90\xef\xc3\x0d\x6a\xb9\xa3\xd3\xad\x86\x3b\x18\x6f\x2e\x36\xc3\x7c\x9f\x8a\x7f\x52\x89\x17\x33\xca\x7f\xaa\xf0\x7c\x43\xd5\xc9\xf9\xf9\xf1\xbb\xe3\xf3\x8b\x93\xa3\xd3\xe3\x6a\x57\xc1\xef\xac\xd1\x4c\xca\xfb\x50\xdb\xc1\xc7\x37\x5d\xe0\x31\x89\xaf\x95\xab\xaa\x68\xd5\xb9\x3c\xd2\xdd\xb1\x46\x24\x7e\x71\x12\x64\x5b\x1b\xfe\x42\x57\xea\x56\x14\x5d\x59\x2e\xa3\x8c\x07\x13\x91\xe1\x12\x37\x32\x57\x85\xc8\x5d\x95\x8e\x2d\x1c\x32\x83\xbc\xeb\x16\xcb\xd4\x16\x4b\xc7\x94\x65\x4b\x51\x31\xdb\x17\xec\x7b\x08\xc4\xd9\xb9\x5e\x74\x5c\x05\xd6\x35\x64\x80\xee\xdb\x88\xb9\x34\x15\x88\x1f\x99\xba\x84\x5d\xb9\xe3\x48\x53\x48\xd5\x92\xf4\x9f\x98\x72\xc5\x13\x47\x17\x73\x21\x1c\x6e\x39\xf7\xf8\xbb\xdc\x1a\xcc\xfa\x22\x7d\x6a\x2e\x61\x6b\x1c\x95\xe1\x92\xd6\xbd\x27\xe2\x2d\x9d\x69\x86\xb2\xab\x38\x36\x17\xaa\xfc\x88\xc0\x09\x94\xf4\x36\xb5\x39\xbb\x42\x31\x2e\xa2\x8a\x5d\x49\x6a\x06\xa4\x25\xe4\xb6\x2f\x64\x94\x04\x5d\xe8\x42\x02\x90\x07\x04\xd7\x88\x80\xdc\xb8\xad\x9e\x5e\x3f\x7f\x2a\x41\x3f\x65\x37\xbf\x15\x06\xaa\x0d\x6d\x8a\xd5\x09\xbc\x11\x57\x45\x40\x27\xb6\xed\x62\x59\x19\x42\x92\x7c\x5f\x85\xab\x3b\x0e\x08\x72\x12\x09\x25\x6c\xae\x68\xce\x69\x76\xb8\x27\x15\x38\xa5\xfe\x5f\xf7\x6c\x78\xa7\xb4\xbe\xb9\x0f\xa6\x87\x65\x35\xba\x2b\xe4\xd1\xd0\x28\x9e\xb3\x08\x0a\xbb\xc0\x1b\x05\xbc\x3b\x3c\x0c\x50\xf6\x32\x97\xe7\x99\x3c\xdf\x3f\x5b\x5a\x61\x54\x23\x69\x1b\xab\xa1\xc2\x3c\x4d\x0a\xf1\xb6\x04\xcb\x1b\x4a\xc0\x38\x86\x17\x1b\x97\x2a\x47\x43\xaf\xda\x68\xe6\x70\x10\xba\xb6\x35\x34\x0c\x31\x63\x28\x8a\x47\xa9\xe1\xc9\xbf\x74\x92\x81\x2a\xeb\x53\x90\xd1\x1e\x57\x6d\xec\xef\x93\xc5\xa3\x94\x5d\xf6\x54\x50\x67\x5a\x34\x50\xe1\xc7\xbd\x30\x90\x08\xa7\x08\x06\x39\x0c\x77\x04\x32\x4c\xe0\x97\x2c\x91\xbf\xee\xed\xb6\x5f\xda\xe5\x4c\x49\xa0\xe8\xd9\xa6\x7e\x82\xd4\x2d\xcf\xfa\xa5\x0f\xd9\xfa\x62\x58\xff\x54\x4f\x52\x84\xc9\x47\xe8\x57\x33\x67\x6e\x7b\xb8\x48\xab\x3b\xec\x7f\x4e\xb5\x7f\x60\x9e\x93\xdc\x69\x50\xe3\x97\xd2\x4c\x52\x8e\x69\x86\x89\x87\xe4\xe5\xa1\x0e\xb0\x45\x90\xa2\x66\xfe\xfa\x1b\xe9\xa0\xf7\x63\x09\x15\x00\x00")
 
 func subscriptionGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -232,12 +253,12 @@ func subscriptionGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "subscription.graphql", size: 3198, mode: os.FileMode(420), modTime: time.Unix(1588358533, 0)}
+	info := bindataFileInfo{name: "subscription.graphql", size: 5385, mode: os.FileMode(420), modTime: time.Unix(1792296837, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _tokenmetaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x4f\x8f\xdb\xb6\x13\xbd\xeb\x53\x4c\xf4\x3b\xec\xaf\x40\x2b\xa3\xf9\xb3\x49\x05\xf4\xe0\x75\xdd\x62\x81\x66\x13\xac\xdd\x53\x51\x34\x34\x39\xb6\xa6\x2b\x91\x0a\x39\xda\x3f\x28\xf6\xbb\x17\x24\x45\xc9\x96\xbd\x68\x72\x68\xb2\x87\x85\x38\x9e\x79\x6f\xe6\xf1\x71\xf2\x3c\x5f\x57\x08\x0b\xa3\x35\x4a\x26\xa3\x81\x1f\x5a\x84\xad\xb1\x20\x60\x6d\x6e\x50\xe7\x79\x9e\x85\x58\x38\xed\x25\xfe\x9d\x01\x00\xe4\x79\xfe\x61\x53\x1b\x79\xf3\x01\xc8\x01\x57\x08\xe1\x04\x82\xe1\xae\x22\x59\x85\x10\xfb\x52\x50\x82\x85\x4f\xba\x15\x35\x29\x0f\xeb\xeb\x43\xf6\x35\x6e\x4b\xb8\xe8\xbf\xb2\x84\x3b\x87\x9a\x1c\x83\xd9\x02\xaa\x1d\x3a\x60\x13\x81\x5c\xaa\x0d\xe1\x12\x7e\x0f\x9d\x2d\xd5\x0e\xff\x78\x36\x14\x5f\xea\xad\xb1\x8d\x88\x23\x19\x10\xa4\xa0\x15\x3b\xd2\x21\x92\x00\x5a\xb1\x43\x9f\x58\xc2\xfb\xfe\x2b\x7b\xcc\xb2\x40\xed\x48\xef\xea\x7e\x68\xb0\xe8\x5a\xa3\x1d\x16\x87\x62\x78\xca\x51\x86\x15\x22\x54\xcc\xad\x2b\x67\x33\x65\xa4\x2b\xd4\xb6\x73\x58\x90\x99\xa1\x71\x64\x66\x6d\xb7\xa9\x49\x7e\x27\x5a\x72\x33\x8b\x5b\xb4\xa8\x25\xce\x1c\x0a\x2b\xab\x99\xec\xac\x33\x76\x98\x2c\x1e\x4b\x58\xb1\x25\xbd\x1b\xa7\xf2\x77\x15\x5b\x32\x9b\xbf\x50\x72\x91\x0a\xb4\x51\x58\xc6\x9f\x9e\x4d\x67\x08\xc2\x9e\x98\x21\x09\xbe\x3f\xc2\xc7\x0e\x35\x93\xa8\x41\x77\xcd\x06\xad\x17\x9f\x2b\x72\xfd\xa5\x7a\x2d\x2b\x04\x59\x09\xd2\x23\x75\xc8\x2c\xe1\x37\xd2\x7c\xfe\xb2\xef\x95\xd4\xd8\xfc\x29\x49\x0f\x85\x1c\x3b\x58\x18\xcd\x56\x48\x06\xae\x04\x83\xb4\x28\x18\xd5\x9e\x87\xa8\xc0\xa2\x84\x20\x68\xc1\x09\x28\x28\xd6\x17\x1e\x6b\xb6\x7a\x68\x36\xa6\x0e\x93\x04\x88\x1e\x63\xf9\x6e\x95\x6a\x5d\xc8\x38\xa1\x76\xc8\x6f\x2d\x4a\x72\xfb\xae\x49\x81\x38\xf3\x8b\xe7\xd3\x8a\xd4\x0b\x90\x73\x9d\x97\x26\xf4\x9b\xca\x53\x70\xca\x76\x35\x2a\x1e\x50\x2a\x53\x2b\x1c\x2d\xd1\x1f\x27\x3a\x27\xce\x33\x07\x8d\xb8\xa7\xa6\x6b\xc0\x75\x6d\x5b\x3f\xa4\xb2\x3e\xba\x0a\xc1\xff\xc7\x37\x51\xc2\x7c\xb5\x5a\xae\xff\xfc\xf9\xdd\xf5\xdb\xf9\x1a\x7e\x8c\xc7\x6f\x9e\x10\xe0\xcc\xbf\x3c\x16\xf5\x04\x38\xc4\x3e\x0f\x36\x1a\xe1\xe9\x7d\x33\x97\xd2\x74\x9a\xe1\x42\xd4\x42\x4b\x1c\x3c\xd2\xc7\xfb\xf0\x57\x5e\x41\xa2\x6f\x72\x13\xbb\x39\x5a\x46\x87\xcd\x7e\xd9\xad\x74\xcc\xfd\xe5\xd7\xd3\x44\x9f\xd3\x8b\xea\xb0\xd1\xe0\x8c\x53\x13\xfc\xa7\x7b\x21\xb9\xcd\x3f\x2c\xd2\xbb\x11\x2c\x95\xf6\x93\x7c\xdd\x8d\x32\x6f\x42\x93\x61\x0f\xa7\x61\x2b\xac\x15\x50\xdc\xc5\x7d\x93\x83\x97\xa3\x70\x9f\xf7\x22\x17\xf1\x76\xc1\xe2\xc7\x8e\xac\xd7\xd5\x04\xdd\x48\x77\x08\x48\x5c\xa1\xf5\x2f\xf4\x4e\x58\x05\xc6\xc2\x46\xc8\x1b\xff\xed\x60\x6b\x4d\x03\x62\x78\x23\xbd\x95\x51\x01\xd6\xd8\xa0\x66\x37\x38\x33\x39\x79\xbc\xd1\xe8\xa1\x34\xd8\x96\xac\xe3\x54\x96\x82\x1e\xf7\x5b\xe8\x1c\x02\xb1\xef\x29\x9a\x31\x4d\x6e\xda\xd6\x38\x62\x04\x45\x36\xee\x83\x41\x7d\x16\x96\x17\x13\x8f\x9e\xa4\xad\xc5\xbf\xb3\x26\x25\x86\x87\xae\xd5\x13\xd8\xa4\x15\x49\xc1\xe8\xe0\xae\xc2\xa0\x9a\xff\x87\x7e\xe1\x08\xd0\x78\xcf\xe1\x69\x0f\x0b\x5d\xb8\x2b\xbc\x67\xaf\x4c\x09\x17\xc6\xd4\x28\xf4\xa7\x41\xb5\x16\x6f\xc9\x74\x6e\x0a\xf7\xbe\x8f\x4f\x20\x1f\xb3\x0c\x75\xd7\x1c\x7a\x61\xb8\x87\x5b\x51\x77\x08\x77\xc4\x71\x4f\x0e\x56\x04\xa1\x55\xef\x63\xef\x6f\x38\xfb\xfe\xf9\x8b\x97\xc5\xab\xf3\xd7\x6f\xbc\xd1\xcf\x12\x6d\x00\x85\xf8\xf7\x3f\xf0\x39\xaf\x8a\xf3\xd7\x6f\x7e\xf0\x49\xc7\x14\xa6\xe3\x09\x8b\xb1\x7b\x24\x45\x64\xf1\x24\x03\xc1\xe5\xd5\x7a\xf9\xcb\xf2\x7a\x9f\xc0\xe3\x7f\x52\xfb\xda\x44\xba\x23\x86\xe2\x80\xe2\xa7\xe5\xe2\xf2\xed\xfc\xd7\xa3\x19\xb2\xc7\xec\x9f\x00\x00\x00\xff\xff\xa4\x1b\xf5\xed\x20\x0b\x00\x00")
+var _tokenmetaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x58\x5b\x6f\xdb\x36\x14\x7e\xcf\xaf\x60\xb2\x87\xb6\x80\xe7\xa4\x69\xda\x6e\x06\xf6\x90\x66\xce\x1a\x34\x4d\xb3\x38\xdd\x50\x0c\x43\x4c\x4b\x74\xcc\x45\x12\x55\x92\x8a\x6b\x0c\xfb\xef\xfb\x0e\x2f\xba\xd9\xee\x65\xc0\x5a\x3f\x18\x16\x45\x9e\xfb\xf9\xbe\x43\xef\xed\xed\x5d\x2f\x04\x3b\x51\x45\x21\x12\x2b\x55\xc1\xec\xaa\x14\x6c\xae\x34\xe3\xec\x5a\xdd\x89\x62\x6f\x6f\x6f\xc7\xad\xb9\xa7\xd6\xc6\xbf\x77\x18\x3e\x78\x3d\x9d\x65\x2a\xb9\x9b\x32\x69\x98\x85\x2c\xf7\xc4\xb8\x65\xcb\x85\x4c\x16\x6e\xc9\xd2\x51\x96\x72\xcb\x69\xd3\x3d\xcf\x64\x4a\x62\xe9\xbc\xdb\x7d\x25\xe6\x23\xf6\x22\xfc\xda\x89\x72\x8f\x59\x26\x8d\x65\x6a\xce\x44\x7a\x2b\x20\x5c\x79\x41\x26\x9e\x75\xcb\x23\xf6\x87\xb3\x6c\x8c\x87\x3f\x77\xeb\xc3\x67\x05\x7c\xc8\xb9\x77\x49\x31\x2e\x53\x56\xf2\x5b\x59\xb8\x95\x28\x00\x2b\x82\x36\x8e\xd8\x65\xf8\xb5\xf3\xcf\xce\x8e\x53\x6d\x64\x71\x9b\x05\xa7\x99\x16\xa6\x54\x85\x11\xc3\x6e\x30\x48\x65\x13\x86\x89\x10\x6c\x61\x6d\x69\x46\xfb\xfb\xa9\x4a\xcc\x30\x9d\x57\x38\x22\xd5\xbe\x50\x06\xdf\x65\x35\xcb\x64\xf2\x3d\x2f\xa5\xd9\xd7\x62\x2e\xb4\x28\x12\xb1\x6f\x04\xd7\xc9\x62\x3f\xa9\xb4\x51\xba\xf6\xcc\x3f\x8e\xd8\xc4\x6a\xd8\xd1\x78\x45\xb9\xf2\x26\xa9\xd9\x5f\xc8\xc3\x30\x1e\x28\x54\x2a\x46\xfe\xd5\x6e\xdf\x07\x17\xd8\x0d\x3e\xc4\x80\xb7\x5d\x78\x5f\x89\xc2\x4a\x9e\xb1\xa2\xca\x67\x42\x53\xf0\xed\x02\x39\xf3\x49\xa5\x58\xc2\x82\x64\xc1\x65\xd1\xa8\x76\x3b\x47\xec\xad\x2c\xec\xb3\xa3\x60\xab\x4c\x1b\xe3\x37\x85\xb4\x1b\xc8\xc6\x02\xd4\x97\xd5\x3c\xb1\xd0\x83\x0a\x4a\xb4\xe0\x56\xa4\xad\x1a\x92\x43\x31\x1c\x31\x17\xd0\xa1\x8d\x82\x5c\xc4\xc2\xc1\xf5\x98\x4d\x56\xf9\x4c\x65\xce\x13\x27\x22\xc8\x18\xbf\x99\xc4\xb3\xc6\xed\xd8\x10\x6d\xb7\xbf\xd4\x22\x91\xa6\x5d\x35\x71\xc1\xfb\xfc\xe4\xb0\x7f\x22\xda\x82\x62\x37\x15\x85\xc6\xd9\x1b\x8f\xc7\xc5\xbe\xb6\x8b\x26\xe2\x4e\xca\x42\x65\xa9\x68\x4a\x22\x3c\xf6\xe2\x5c\xeb\x34\x96\x17\x29\xd7\x3e\x56\x8d\x01\x79\x99\x89\x1c\x29\x35\x9d\xa0\x0d\xd8\x52\xf3\xb2\x14\xe9\x00\x49\xa1\x2d\xdc\x18\x61\xcd\x00\x5d\xab\x72\x99\xc4\x27\x7f\xc2\xac\x8c\x15\x39\x03\x22\xa8\x42\x30\x9e\xa6\x48\xc8\x6c\xe5\xf4\xa8\x52\x68\x1c\xd1\x43\x36\xce\x4b\xbb\x72\xb0\xe1\x5b\x94\xc9\x22\x15\x1f\x68\xa7\xc0\xa2\x70\xbb\x6b\x13\x97\x1c\xcd\x0c\xfb\xee\xc8\x00\x8f\x12\x3c\xcb\xd8\x82\xdf\xfb\x8d\x96\xcf\x32\xf4\x3b\x35\xfe\x7a\xa2\xa3\x94\x2d\xe9\x7a\x60\x58\xce\x3f\xc8\xbc\xca\x99\xa9\xca\x32\x5b\xc5\x73\x61\x75\xe2\x16\x1f\x7a\x70\x18\xb1\xe3\xc9\x64\x7c\x7d\x73\xfa\xe6\xea\xf5\xf1\x35\xfb\xc9\x3f\x3e\xda\x2e\xda\x2a\x8b\xde\xe8\x0a\x76\x6b\xff\x51\xac\xe6\x85\x01\x12\x0c\x42\x76\x19\x5c\x0b\xd2\xc9\x4f\x0b\xf8\x93\x09\xa5\xe5\xf6\x56\x8b\x5b\xd7\x0a\x14\x79\x32\x26\x17\x00\xd3\xb9\x56\xb9\x8b\x98\xd4\x5a\xdc\xa3\x3a\x24\x02\xe7\x7b\x15\x19\xb0\xa8\x54\x95\x08\x24\x33\x6d\x05\xcf\x9a\x87\x56\x95\x2f\x7d\x35\x9d\xcb\x5c\xda\x58\xc6\x30\xf4\xf1\xc1\xa3\x80\x22\x13\xda\x19\x5a\x77\x52\x9b\x42\x39\xe1\x2c\xd4\x90\x01\xe0\x4d\xdd\xe6\xa1\x93\x3b\xed\x36\xb5\x93\xd0\x74\x76\xf4\xd5\x78\x4c\x11\x2c\xe3\x00\xf7\xc3\x23\xb8\x0e\xb0\x1b\x00\x9f\x32\x68\xa1\x0a\x50\x6c\x1a\x59\x61\x4a\x31\x97\xb9\xa8\x63\x1d\x85\x1c\x1e\x2d\x82\xa1\x51\xae\x53\xb7\x1e\xda\xae\xba\xe7\x20\xa1\xd5\x17\x2b\x7b\x9e\x7e\x5c\xd7\x39\xd7\x20\x22\x1b\x5b\x34\x6a\xf4\x6d\x0c\x18\x6b\x34\x34\x35\x13\x33\x10\xe9\xcb\x3f\xee\xb6\x18\xec\xb5\x82\x48\x40\x0d\xba\x97\x4d\x1d\x68\x4c\x5d\x7d\x4c\xb5\xb0\x52\xd3\x83\xe3\xe1\x9e\xba\x87\xd0\x97\xd3\xc9\xc7\x07\x07\x8f\x06\x8c\xa4\xe2\x61\x2e\xb5\xb1\x75\x11\xb8\x02\x3b\x59\xf0\xa2\x45\x9f\x93\xd6\x62\xdb\x8a\x53\x3a\x19\x39\xbd\x2e\xc3\x01\x3b\x40\xe3\x42\x5d\xa1\xc2\x3b\xea\xe9\x56\x99\xae\x44\xa3\x4e\x82\xe7\x1c\xd7\x00\xe1\xd6\xe1\xeb\x9c\xf2\xb2\x5e\xbf\x2d\x61\xdb\x47\x05\xc7\x2d\x4d\xc5\x75\xd2\xd3\x54\x5e\x03\xac\xd3\x98\xd2\x3a\x78\x0d\x7d\x54\x85\x5d\x37\x6e\x02\x18\x89\xe1\x0d\x47\x35\xbc\x7b\x5f\x71\xb0\xa4\x95\xa2\x3e\x7f\xaf\xb2\x2a\x17\x5f\xda\xff\x8d\x65\x29\xf5\x57\x01\xc4\xe6\x89\x33\xc5\x78\x02\x34\x94\xfc\x3a\xb9\x51\x59\x55\x48\xf0\xf4\x44\x14\x9b\x09\xe1\x93\x52\xa9\xa8\x50\xfc\xe9\x36\xc9\x57\xfe\xfd\x26\xd9\xa7\x3c\x33\x82\x30\x3b\x13\x2d\x1c\xaa\x71\x86\x01\xb7\x9d\x12\x3f\x28\x2c\xc1\x02\x6a\x89\xb6\x5a\x2a\x6d\x17\x64\x91\xc7\xa6\x41\xe4\x83\x08\x2b\x96\x6a\x49\x15\x80\xbd\x44\x41\x31\x06\x33\x6d\x63\xe0\xbd\x90\x26\x51\xc4\x57\x16\xc3\xce\x0b\xa5\x40\x5c\x45\xaf\x06\x7c\x1f\x85\xe4\xcf\x78\xc6\x51\x7c\xc8\x87\xf7\xff\x85\x7f\x6e\xe5\x77\xc1\x41\x4e\xed\x06\xea\x81\x3c\x5b\x88\x0c\xd5\x2e\x31\x06\x08\x4d\xad\xd8\x6d\xa2\x4b\xbf\x38\x62\xa7\x99\xe2\xb6\x67\x4a\xbb\xa5\x82\x41\xf4\x12\x58\xf2\xe6\xd5\xf8\xe2\x66\xf2\xf6\xf2\xf2\xfc\xdd\xcd\xc9\xcb\xe3\x8b\x5f\xc6\x37\xd7\xef\x2e\xc7\x8d\x5d\xbf\xfa\x02\x5b\xf9\x61\x21\x25\xf2\xf5\x5d\x5f\x37\x03\xcf\xc9\xa1\x2f\x2a\xb8\x4d\xfd\x53\xaf\x5f\x03\xf8\x60\x19\xbe\x77\x1b\xf0\xf3\x4d\x72\xd6\x9d\xe5\x04\x06\xbe\xad\x2e\xb4\xf0\xde\xcf\x01\x4b\xcc\xb9\xf5\x1c\xb7\x94\xa8\x02\xd4\x46\x0d\x67\x49\x7b\x1a\x3f\x9b\x4c\xde\x8e\x37\x1d\x27\x08\xd3\x6a\x55\x0b\xe8\x43\x60\x14\x70\x35\xbe\x3e\xbb\x1a\x07\xd2\xda\x7e\xad\x09\xc5\xc0\x42\x35\xd4\xac\xd5\x2d\x92\x6f\x7c\xd3\x09\x1d\x1b\x4b\x78\xed\xce\xd3\x35\xf6\xeb\x5e\x7e\xd6\x75\x7f\xfd\x5b\x50\x2f\x3e\x9b\xef\x43\xfd\xb6\x87\x7f\x9b\x3c\xf8\x5f\xaf\x1f\xb1\xda\x68\x38\xc0\x8b\x75\xcc\x0d\x9e\x7c\xdb\x8b\xcb\xb1\x83\x93\xee\x28\x41\xd0\x47\xc8\x67\x9b\x70\xd7\xb5\xec\x03\xf7\xa5\x7c\xf7\x3b\xc1\x7c\x43\x1b\xba\x72\x17\x05\x28\xe2\x29\x86\x96\x54\x80\x17\x74\x85\x69\xd6\x5b\x11\x53\x9b\x38\x04\x75\xa6\xf0\xd0\x73\x2e\x3d\x68\xb0\x42\xf5\x66\x87\xd6\xdc\xd1\xac\x76\xb9\xa2\x5d\xe1\x5e\x74\x74\x7b\x0a\x98\x11\x3c\x8f\x00\xe0\x07\xa4\x29\xa0\x7e\x66\x12\x2d\x4b\x6a\x9e\xd6\xed\xb9\xbd\xeb\x2a\xf4\x48\x53\x49\xaf\xc0\x5a\x24\xd7\x6b\x18\x60\x06\xf4\xfb\x1d\x78\xe0\x7a\xe5\xb0\xa8\xeb\xa1\xf1\xe3\x9d\x8b\xcf\xb4\xb9\x4b\xc5\xb7\xf5\xec\xe8\x38\xe4\xc5\xf1\xf9\xf1\xc5\xc9\x78\x33\x7d\x50\x83\x14\x62\xd9\x34\xc7\xbc\x9d\x44\xd0\x19\x26\x01\x4f\x20\x64\xcd\x41\xc0\xc5\xe9\xd5\xf8\xf5\x9b\xdf\xc6\x37\x41\xf4\xb4\x97\xec\x7e\x3b\x75\xb4\x79\x4b\x1d\x3c\x93\xf0\xaa\x4c\x5d\xe7\x04\x12\x25\xbf\x7a\xd7\x59\x77\x20\x8c\xd5\x0d\x6e\xad\x8f\x81\x48\x7a\x83\xae\x21\x5d\x0b\xba\xbb\x16\x1f\x1f\x0c\xa3\xc8\x4b\x5c\x66\xe9\x2a\x34\xe3\x54\x36\x6a\x5b\x8e\xf1\x06\x38\x87\x11\x2e\x4c\x26\xb4\x87\x69\x79\xbb\xc0\xec\x34\xb7\x18\x28\xdc\xbf\x20\x5e\xff\x36\x78\x8a\xdc\xb8\x21\x35\x2d\x5a\x6c\x15\x36\x5d\xa6\x8a\x1a\xc9\x68\x82\x8e\x88\x83\x7c\xa0\x1f\xe4\x5c\x36\x4e\x52\x83\x05\xc9\x9f\x27\x4b\x8b\x1c\xb3\x54\x7d\xbe\x9b\xdc\xb6\x88\x90\x24\xe8\x6c\x5d\x60\x78\xa7\xf8\x3a\x56\x38\xee\x0f\x9d\x74\xe2\x11\x1b\xca\xde\x57\x34\x9e\x50\x20\x09\x0b\x65\x81\x3e\x16\x28\x07\x84\x0e\xd5\xb5\xa4\x6b\xbf\x2b\xf8\xe4\x8e\x7e\x1b\x7f\x75\xe5\x35\xef\x05\x7a\x82\x00\x11\xfe\xb0\xa8\x1b\x2d\xb2\x53\x13\x43\x1f\xf8\x58\xd5\xee\x6e\x13\x8f\xd5\xb7\x3d\xc8\x1d\x30\x30\x0f\xe5\x1e\x36\x79\x82\x89\x68\xa6\xca\x12\x10\x6e\x31\x59\xc0\xe6\xce\x08\x81\x99\x54\xdb\x93\x5e\x62\x37\xaa\x75\xf7\xc9\x4f\x68\x8d\x91\xa8\xc9\xbb\x48\xb7\xc8\x06\x5a\xc8\x04\xfe\x1b\xba\x56\xb9\xa8\xd1\x97\xa0\xf6\xe4\x68\xe5\x0f\xd6\xd1\x75\xfd\x5f\x10\x37\x17\x58\xa3\xc8\xb4\xb0\xed\x73\x44\x81\x02\xee\xa5\xaa\x4c\x5f\xdc\x65\x58\xef\x89\x8c\x25\xdd\xc1\xf7\x3a\x0f\x18\x6f\x2a\xe1\x9b\x9e\xbc\xaf\xe9\xc5\xff\x8d\xe1\xd9\x0b\x9c\xc5\x1e\x3c\x3e\x7c\x72\x34\x7c\xfa\xec\xf9\x0f\x44\x5e\x0f\xa2\x5a\x27\x94\xf9\xcf\x77\x8c\xf6\x3c\x1d\x62\xcf\x8f\xb4\x69\x5d\x85\xaa\x6c\x4f\x0b\x72\xd1\x28\x19\x7a\x2d\xa4\xa4\x56\x70\x76\x71\x3d\xfe\x65\x7c\xd5\x56\x40\xf2\x3f\xcb\x7c\x62\x16\x07\x05\x7d\x0d\xc3\x8e\x8a\x9f\xc7\x27\x67\xaf\x8f\xcf\xd7\x7c\x40\xe4\xfe\x05\xf4\x2a\x48\x8a\x5b\x17\x00\x00")
 
 func tokenmetaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -252,7 +273,7 @@ func tokenmetaGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "tokenmeta.graphql", size: 2848, mode: os.FileMode(420), modTime: time.Unix(1599276610, 0)}
+	info := bindataFileInfo{name: "tokenmeta.graphql", size: 5979, mode: os.FileMode(420), modTime: time.Unix(1792300587, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -332,6 +353,7 @@ var _bindata = map[string]func() (*asset, error){
 	"accounthist.graphql": accounthistGraphql,
 	"block.graphql": blockGraphql,
 	"blockmeta.graphql": blockmetaGraphql,
+	"mutation.graphql": mutationGraphql,
 	"query.graphql": queryGraphql,
 	"query_alpha.graphql": query_alphaGraphql,
 	"schema.graphql": schemaGraphql,
@@ -384,6 +406,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"accounthist.graphql": &bintree{accounthistGraphql, map[string]*bintree{}},
 	"block.graphql": &bintree{blockGraphql, map[string]*bintree{}},
 	"blockmeta.graphql": &bintree{blockmetaGraphql, map[string]*bintree{}},
+	"mutation.graphql": &bintree{mutationGraphql, map[string]*bintree{}},
 	"query.graphql": &bintree{queryGraphql, map[string]*bintree{}},
 	"query_alpha.graphql": &bintree{query_alphaGraphql, map[string]*bintree{}},
 	"schema.graphql": &bintree{schemaGraphql, map[string]*bintree{}},
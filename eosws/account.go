@@ -16,14 +16,20 @@ package eosws
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
 	"github.com/dfuse-io/derr"
 	eos "github.com/eoscanada/eos-go"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
 	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
 	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
 	"github.com/dfuse-io/kvdb"
+	"go.uber.org/zap"
 )
 
 var AccountGetterInstance AccountGetter
@@ -52,29 +58,323 @@ func NewApiAccountGetter(api *eos.API) *APIAccountGetter {
 }
 
 func (ws *WSConn) onAccount(ctx context.Context, msg *wsmsg.GetAccount) {
-	_, ok := ws.AuthorizeRequest(ctx, msg)
+	authReq, ok := ws.AuthorizeRequest(ctx, msg)
 	if !ok {
 		return
 	}
 
-	accountFromDB, err := ws.db.GetAccount(ctx, msg.Data.Name)
-	if err != nil && !isAccountNotFoundError(err) {
-		ws.EmitErrorReply(ctx, msg, derr.Wrapf(err, "unable to retrieve account: %s", msg.Data.Name))
+	if msg.Fetch && authReq.Cursor != nil {
+		ws.EmitErrorReply(ctx, msg, WSMessageDataValidationError(ctx, fmt.Errorf("'fetch' cannot be used along a 'cursor', the stream resumes where it left off")))
 		return
 	}
 
-	accountFromAPI, err := ws.accountGetter.GetAccount(ctx, msg.Data.Name)
+	if msg.Fetch {
+		accountFromDB, err := ws.db.GetAccount(ctx, msg.Data.Name)
+		if err != nil && !isAccountNotFoundError(err) {
+			ws.EmitErrorReply(ctx, msg, derr.Wrapf(err, "unable to retrieve account: %s", msg.Data.Name))
+			return
+		}
+
+		accountFromAPI, err := ws.accountGetter.GetAccount(ctx, msg.Data.Name)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, derr.Wrapf(err, "unable to retrieve account: %s", msg.Data.Name))
+			return
+		}
+
+		account := mdl.ToV1Account(accountFromDB)
+		account.AccountResp = accountFromAPI
+		account.HasContract = accountFromAPI.LastCodeUpdate.Time != time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		metrics.DocumentResponseCounter.Inc()
+		ws.EmitReply(ctx, msg, wsmsg.NewAccount(account))
+	}
+
+	if msg.Listen {
+		ws.listenAccountDeltas(ctx, msg, authReq)
+	}
+}
+
+func (ws *WSConn) listenAccountDeltas(ctx context.Context, msg *wsmsg.GetAccount, authReq *AuthorizedRequest) {
+	var irrRef bstream.BlockRef
+	if authReq.Cursor != nil {
+		irrRef = authReq.Cursor.lib
+	} else {
+		irrID, err := ws.irreversibleFinder.IrreversibleIDAtBlockNum(ctx, authReq.StartBlockNum)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+			return
+		}
+		irrRef = bstream.NewBlockRefFromID(irrID)
+	}
+
+	cursors := newCursorEmitter(ctx, ws, msg, authReq.Cursor)
+	resources := newAccountResourcesHistory(ctx, ws.stateClient, msg.Data.Name, irrRef)
+
+	var handler bstream.Handler
+	handler = bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		step := obj.(*forkable.ForkableObject).Step
+		if step != forkable.StepNew && step != forkable.StepUndo && step != forkable.StepRedo {
+			return nil
+		}
+
+		blk := block.ToNative().(*pbcodec.Block)
+
+		var before *accountResources
+		if step == forkable.StepUndo {
+			before = resources.before(blk)
+		}
+
+		for _, delta := range accountDeltasFromBlock(blk, msg.Data.Name, step, before) {
+			metrics.DocumentResponseCounter.Inc()
+			cursors.EmitReply(ctx, msg, delta)
+		}
+
+		return nil
+	})
+
+	if freq := msg.WithProgress; freq != 0 {
+		handler = NewProgressHandler(handler, cursors, msg, ctx)
+	}
+
+	blocknumGate := bstream.NewBlockNumGate(uint64(authReq.StartBlockNum), bstream.GateInclusive, cursors.Handler(handler), bstream.GateOptionWithLogger(zlog))
+	forkableHandler := forkable.New(resources.Handler(blocknumGate), forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+
+	metrics.IncListeners("get_account")
+	source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), forkableHandler, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
+
+	source.OnTerminating(func(_ error) {
+		metrics.CurrentListeners.Dec("get_account")
+	})
+	err := ws.RegisterListener(ctx, msg.ReqID, func() error {
+		source.Shutdown(nil)
+		return nil
+	})
 	if err != nil {
-		ws.EmitErrorReply(ctx, msg, derr.Wrapf(err, "unable to retrieve account: %s", msg.Data.Name))
+		source.Shutdown(nil) // important to ensure that OnRunFunc is run
+		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
 		return
 	}
 
-	account := mdl.ToV1Account(accountFromDB)
-	account.AccountResp = accountFromAPI
-	account.HasContract = accountFromAPI.LastCodeUpdate.Time != time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	ws.EmitReply(ctx, msg, wsmsg.NewListening(authReq.StartBlockNum))
+	go source.Run()
+}
+
+// accountDeltasFromBlock extracts the changes to `account`'s permissions, resource limits, resource
+// usage and RAM usage. On undo, the changes are returned in reverse order, so that the last one
+// leaves the account in the state it was before the block, the resource limits and usage changed by
+// the block being replaced by a single delta carrying their value `before` it, omitted when unknown.
+func accountDeltasFromBlock(blk *pbcodec.Block, account string, step forkable.StepType, before *accountResources) (out []*wsmsg.AccountDelta) {
+	newDelta := func(trxID string) *wsmsg.AccountDelta {
+		delta := wsmsg.NewAccountDelta(blk.Number, blk.Id, step, trxID)
+		out = append(out, delta)
+		return delta
+	}
+
+	for _, trxTrace := range blk.TransactionTraces() {
+		for _, permOp := range trxTrace.PermOps {
+			if permOp.OldPerm.GetOwner() != account && permOp.NewPerm.GetOwner() != account {
+				continue
+			}
+
+			op := mdl.ToV1PermissionOp(permOp)
+			if step == forkable.StepUndo {
+				op.Old, op.New = op.New, op.Old
+				switch op.Operation {
+				case "ins":
+					op.Operation = "rem"
+				case "rem":
+					op.Operation = "ins"
+				}
+			}
+
+			newDelta(trxTrace.Id).Data.PermissionOp = op
+		}
+
+		for _, ramOp := range trxTrace.RamOps {
+			if ramOp.Payer != account {
+				continue
+			}
+
+			op := mdl.ToV1RAMOp(ramOp)
+			if step == forkable.StepUndo {
+				op.Delta = -op.Delta
+				op.Usage = uint64(int64(op.Usage) + op.Delta)
+			}
+
+			newDelta(trxTrace.Id).Data.RAMOp = op
+		}
+
+		if step != forkable.StepUndo {
+			forEachAccountResources(trxTrace.RlimitOps, account, func(limits *mdl.ResourceLimits, usage *mdl.ResourceUsage) {
+				delta := newDelta(trxTrace.Id)
+				delta.Data.Limits, delta.Data.Usage = limits, usage
+			})
+		}
+	}
+
+	if step != forkable.StepUndo {
+		forEachAccountResources(blk.RlimitOps, account, func(limits *mdl.ResourceLimits, usage *mdl.ResourceUsage) {
+			delta := newDelta("")
+			delta.Data.Limits, delta.Data.Usage = limits, usage
+		})
+
+		return out
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	changed := accountResourcesAfter(blk, account, &accountResources{})
+	if changed.limits != nil && before != nil && before.limits != nil {
+		newDelta("").Data.Limits = before.limits
+	}
+	if changed.usage != nil && before != nil && before.usage != nil {
+		newDelta("").Data.Usage = before.usage
+	}
+
+	return out
+}
+
+// forEachAccountResources calls `f` with the resource limits or usage of `account` set by each op
+func forEachAccountResources(rlimitOps []*pbcodec.RlimitOp, account string, f func(limits *mdl.ResourceLimits, usage *mdl.ResourceUsage)) {
+	for _, rlimitOp := range rlimitOps {
+		switch v := rlimitOp.Kind.(type) {
+		case *pbcodec.RlimitOp_AccountLimits:
+			// Pending limits only become effective at the end of the block, in a block level op
+			if v.AccountLimits.Owner == account && !v.AccountLimits.Pending {
+				f(mdl.ToV1ResourceLimits(v.AccountLimits), nil)
+			}
+		case *pbcodec.RlimitOp_AccountUsage:
+			if v.AccountUsage.Owner == account {
+				f(nil, mdl.ToV1ResourceUsage(v.AccountUsage))
+			}
+		}
+	}
+}
+
+// accountResources is the resource limits and usage of an account after a block, a `nil` value is
+// unknown, it did not change since the stream's last irreversible block.
+type accountResources struct {
+	blockNum uint64
+	limits   *mdl.ResourceLimits
+	usage    *mdl.ResourceUsage
+}
+
+// accountResourcesAfter returns the resource limits and usage of `account` after `blk`, starting from
+// their value `before` it
+func accountResourcesAfter(blk *pbcodec.Block, account string, before *accountResources) *accountResources {
+	after := &accountResources{blockNum: uint64(blk.Number), limits: before.limits, usage: before.usage}
+	apply := func(limits *mdl.ResourceLimits, usage *mdl.ResourceUsage) {
+		if limits != nil {
+			after.limits = limits
+		}
+		if usage != nil {
+			after.usage = usage
+		}
+	}
+
+	for _, trxTrace := range blk.TransactionTraces() {
+		forEachAccountResources(trxTrace.RlimitOps, account, apply)
+	}
+	forEachAccountResources(blk.RlimitOps, account, apply)
+
+	return after
+}
+
+// accountResourcesHistory records the resource limits and usage of an account after each reversible
+// block, the chain only recording their new values, undoing a block requires the ones it replaced. The
+// values that did not change since the stream's last irreversible block are read from statedb at it.
+type accountResourcesHistory struct {
+	ctx         context.Context
+	stateClient pbstatedb.StateClient
+	account     string
+	irrRef      bstream.BlockRef
+
+	afterBlock   map[string]*accountResources
+	irreversible *accountResources
+}
+
+func newAccountResourcesHistory(ctx context.Context, stateClient pbstatedb.StateClient, account string, irrRef bstream.BlockRef) *accountResourcesHistory {
+	return &accountResourcesHistory{
+		ctx:         ctx,
+		stateClient: stateClient,
+		account:     account,
+		irrRef:      irrRef,
+		afterBlock:  map[string]*accountResources{},
+	}
+}
+
+// Handler must be placed right after the forkable, it must see every block the forkable emits
+func (h *accountResourcesHistory) Handler(next bstream.Handler) bstream.Handler {
+	return bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		fObj := obj.(*forkable.ForkableObject)
+
+		switch fObj.Step {
+		case forkable.StepNew, forkable.StepRedo:
+			blk := block.ToNative().(*pbcodec.Block)
+
+			before, found := h.afterBlock[blk.PreviousID()]
+			if !found {
+				before = &accountResources{}
+			}
+			h.afterBlock[blk.Id] = accountResourcesAfter(blk, h.account, before)
+		case forkable.StepIrreversible:
+			// Only the reversible blocks can be undone, the last irreversible one is kept as their parent
+			for id, resources := range h.afterBlock {
+				if resources.blockNum < fObj.ForkDB.LIBNum() {
+					delete(h.afterBlock, id)
+				}
+			}
+		}
+
+		return next.ProcessBlock(block, obj)
+	})
+}
+
+// before returns the resource limits and usage of the account before `blk`, a `nil` value when it
+// cannot be determined
+func (h *accountResourcesHistory) before(blk *pbcodec.Block) *accountResources {
+	out := &accountResources{}
+	if resources, found := h.afterBlock[blk.PreviousID()]; found {
+		*out = *resources
+	}
+
+	if out.limits != nil && out.usage != nil {
+		return out
+	}
+
+	irreversible := h.irreversibleResources()
+	if out.limits == nil {
+		out.limits = irreversible.limits
+	}
+	if out.usage == nil {
+		out.usage = irreversible.usage
+	}
+
+	return out
+}
+
+func (h *accountResourcesHistory) irreversibleResources() *accountResources {
+	if h.irreversible != nil {
+		return h.irreversible
+	}
+
+	resp, err := h.stateClient.GetAccountResources(h.ctx, &pbstatedb.GetAccountResourcesRequest{BlockNum: h.irrRef.Num(), Account: h.account})
+	if err != nil || resp == nil {
+		zlog.Warn("unable to retrieve account resources at last irreversible block, not emitting their undo deltas", zap.String("account", h.account), zap.Stringer("irreversible_block", h.irrRef), zap.Error(err))
+		return &accountResources{}
+	}
+
+	h.irreversible = &accountResources{blockNum: h.irrRef.Num()}
+	if resp.Limits != nil {
+		h.irreversible.limits = mdl.ToV1ResourceLimits(resp.Limits)
+	}
+	if resp.Usage != nil {
+		h.irreversible.usage = mdl.ToV1ResourceUsage(resp.Usage)
+	}
 
-	metrics.DocumentResponseCounter.Inc()
-	ws.EmitReply(ctx, msg, wsmsg.NewAccount(account))
+	return h.irreversible
 }
 
 func isAccountNotFoundError(err error) bool {
@@ -15,11 +15,24 @@
 package eosws
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	eos "github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func Test_onGetAccount(t *testing.T) {
@@ -39,6 +52,11 @@ func Test_onGetAccount(t *testing.T) {
 			msg:            `{"type":"get_account","req_id":"abc", "fetch":true, "data": { "name": "eoscanadacom" }}`,
 			expectedOutput: []string{`{"type":"account","req_id":"abc","data":{"account":{"creator":{"created":"eoscanadacom","creator":"bozo","block_id":"","block_num":0,"block_time":"1970-01-01T00:00:00Z","trx_id":""},"account_name":"eoscanadacom","privileged":false,"last_code_update":"1970-01-01T00:00:00","created":"2018-06-10T13:04:15","core_liquid_balance":"71603.4182 EOS","ram_quota":308040,"ram_usage":13324,"net_weight":85000,"cpu_weight":175000,"net_limit":{"used":105,"available":6595725,"max":6595830},"cpu_limit":{"used":1319,"available":15926,"max":17245},"permissions":[{"perm_name":"active","parent":"owner","required_auth":{"threshold":4,"accounts":[{"permission":{"actor":"eoscanadaaaa","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaab","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaac","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaad","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaae","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaaf","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaag","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaah","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaai","permission":"active"},"weight":1}],"waits":[{"wait_sec":10800,"weight":1}]}},{"perm_name":"blacklistops","parent":"active","required_auth":{"threshold":1,"keys":[{"key":"EOS7idX86zQ6M3mrzkGQ9MGHf4btSECmcTj4i8Le59ga7CpSpZYy5","weight":1}]}},{"perm_name":"claimer","parent":"active","required_auth":{"threshold":1,"keys":[{"key":"EOS7NFuBesBKK5XHHLtzFxm7S57Eq11gUtndrsvq3Mt3XZNMTHfqc","weight":1}]}},{"perm_name":"day2day","parent":"active","required_auth":{"threshold":1,"accounts":[{"permission":{"actor":"eoscanadaaaa","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaac","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaaf","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaag","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaah","permission":"active"},"weight":1},{"permission":{"actor":"eoscanadaaai","permission":"active"},"weight":1}]}},{"perm_name":"eosforumdapp","parent":"active","required_auth":{"threshold":1,"keys":[{"key":"EOS7YNS1swh6QWANkzGgFrjiX8E3u8WK5CK9GMAb6EzKVNZMYhCH3","weight":1}]}},{"perm_name":"owner","parent":"","required_auth":{"threshold":5,"accounts":[{"permission":{"actor":"eoscanadaaaa","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaab","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaac","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaad","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaae","permission":"active"},"weight":2},{"permission":{"actor":"eoscanadaaaf","permission":"active"},"weight":1}],"waits":[{"wait_sec":86400,"weight":1},{"wait_sec":604800,"weight":2}]}}],"total_resources":{"owner":"eoscanadacom","net_weight":"8.5000 EOS","cpu_weight":"17.5000 EOS","ram_bytes":306640},"self_delegated_bandwidth":{"from":"eoscanadacom","to":"eoscanadacom","net_weight":"7.0000 EOS","cpu_weight":"17.0000 EOS"},"refund_request":null,"voter_info":{"owner":"eoscanadacom","proxy":"","producers":[],"staked":1530000,"last_vote_weight":665716568638.4147,"proxied_vote_weight":0,"is_proxy":0},"linked_permissions":null,"account_verifications":null,"has_contract":false}}}`},
 		},
+		{
+			name:           "neither fetch nor listen",
+			msg:            `{"type":"get_account","req_id":"abc", "data": { "name": "eoscanadacom" }}`,
+			expectedOutput: []string{fmt.Sprintf(`{"data": {"code":"ws_message_data_validation_error", "details":{"reason":"one of 'listen' or 'fetch' required (both supported)"}, "message":"The received message data is not valid.", "trace_id":"%s"}, "req_id":"abc", "type":"error"}`, defaultTraceID)},
+		},
 		{
 			name:           "invalid_account path",
 			msg:            `{"type":"get_account","req_id":"abc", "fetch":true, "data": { "name": "eoscanadacomcomcom" }}`,
@@ -70,6 +88,7 @@ func Test_onGetAccount(t *testing.T) {
 				NewTestIrreversibleFinder("00000002a", nil),
 				0,
 				12,
+				nil,
 			)
 
 			conn, closer := newTestConnection(t, handler)
@@ -81,3 +100,154 @@ func Test_onGetAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountDeltasFromBlock(t *testing.T) {
+	block := &pbcodec.Block{
+		Id:     "00000002a",
+		Number: 2,
+		UnfilteredTransactionTraces: []*pbcodec.TransactionTrace{
+			{
+				Id: "trx.1",
+				PermOps: []*pbcodec.PermOp{
+					{Operation: pbcodec.PermOp_OPERATION_INSERT, NewPerm: &pbcodec.PermissionObject{Id: 10, ParentId: 2, Owner: "eoscanadacom", Name: "claimer", Authority: &pbcodec.Authority{Threshold: 1}}},
+					{Operation: pbcodec.PermOp_OPERATION_INSERT, NewPerm: &pbcodec.PermissionObject{Id: 11, ParentId: 4, Owner: "someoneelse", Name: "claimer"}},
+				},
+				RamOps: []*pbcodec.RAMOp{
+					{Operation: pbcodec.RAMOp_OPERATION_UPDATEAUTH_CREATE, Payer: "eoscanadacom", Delta: 100, Usage: 1100},
+					{Operation: pbcodec.RAMOp_OPERATION_UPDATEAUTH_CREATE, Payer: "someoneelse", Delta: 100, Usage: 500},
+				},
+				RlimitOps: []*pbcodec.RlimitOp{
+					{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountUsage{AccountUsage: &pbcodec.RlimitAccountUsage{Owner: "eoscanadacom", CpuUsage: &pbcodec.UsageAccumulator{LastOrdinal: 2, ValueEx: 30, Consumed: 3}, RamUsage: 1100}}},
+					{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountLimits{AccountLimits: &pbcodec.RlimitAccountLimits{Owner: "eoscanadacom", Pending: true, CpuWeight: 10}}},
+				},
+			},
+		},
+		RlimitOps: []*pbcodec.RlimitOp{
+			{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountLimits{AccountLimits: &pbcodec.RlimitAccountLimits{Owner: "eoscanadacom", CpuWeight: 10, NetWeight: 5, RamBytes: 8000}}},
+			{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountLimits{AccountLimits: &pbcodec.RlimitAccountLimits{Owner: "someoneelse", CpuWeight: 10}}},
+		},
+	}
+
+	toJSON := func(deltas []*wsmsg.AccountDelta) (out []string) {
+		for _, delta := range deltas {
+			data, err := json.Marshal(delta.Data)
+			require.NoError(t, err)
+			out = append(out, string(data))
+		}
+		return
+	}
+
+	permission := `{"id":10,"parent_id":2,"perm_name":"claimer","last_updated":"0001-01-01T00:00:00Z","required_auth":{"threshold":1}}`
+
+	assert.Equal(t, []string{
+		`{"block_num":2,"block_id":"00000002a","step":"new","trx_id":"trx.1","permission_op":{"op":"ins","action_idx":0,"new":` + permission + `}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"new","trx_id":"trx.1","ram_op":{"op":"updateauth_create","action_idx":0,"payer":"eoscanadacom","delta":100,"usage":1100}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"new","trx_id":"trx.1","usage":{"net_usage":null,"cpu_usage":{"last_ordinal":2,"value_ex":30,"consumed":3},"ram_usage":1100}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"new","limits":{"net_weight":5,"cpu_weight":10,"ram_bytes":8000}}`,
+	}, toJSON(accountDeltasFromBlock(block, "eoscanadacom", forkable.StepNew, nil)))
+
+	// The limits and usage before the block are sent last, once
+	before := &accountResources{
+		limits: &mdl.ResourceLimits{CPUWeight: 1, NetWeight: 1, RAMBytes: 4000},
+		usage:  &mdl.ResourceUsage{RAMUsage: 1000},
+	}
+	assert.Equal(t, []string{
+		`{"block_num":2,"block_id":"00000002a","step":"undo","trx_id":"trx.1","ram_op":{"op":"updateauth_create","action_idx":0,"payer":"eoscanadacom","delta":-100,"usage":1000}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"undo","trx_id":"trx.1","permission_op":{"op":"rem","action_idx":0,"old":` + permission + `}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"undo","limits":{"net_weight":1,"cpu_weight":1,"ram_bytes":4000}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"undo","usage":{"net_usage":null,"cpu_usage":null,"ram_usage":1000}}`,
+	}, toJSON(accountDeltasFromBlock(block, "eoscanadacom", forkable.StepUndo, before)))
+
+	// Unknown previous limits and usage are not sent
+	assert.Equal(t, []string{
+		`{"block_num":2,"block_id":"00000002a","step":"undo","trx_id":"trx.1","ram_op":{"op":"updateauth_create","action_idx":0,"payer":"eoscanadacom","delta":-100,"usage":1000}}`,
+		`{"block_num":2,"block_id":"00000002a","step":"undo","trx_id":"trx.1","permission_op":{"op":"rem","action_idx":0,"old":` + permission + `}}`,
+	}, toJSON(accountDeltasFromBlock(block, "eoscanadacom", forkable.StepUndo, &accountResources{})))
+
+	assert.Empty(t, accountDeltasFromBlock(block, "nobody", forkable.StepNew, nil))
+}
+
+type accountResourcesStateClient struct {
+	*pbstatedb.MockStateClient
+	requests []*pbstatedb.GetAccountResourcesRequest
+}
+
+func (c *accountResourcesStateClient) GetAccountResources(ctx context.Context, in *pbstatedb.GetAccountResourcesRequest, opts ...grpc.CallOption) (*pbstatedb.GetAccountResourcesResponse, error) {
+	c.requests = append(c.requests, in)
+	return &pbstatedb.GetAccountResourcesResponse{
+		Limits: &pbcodec.RlimitAccountLimits{Owner: in.Account, CpuWeight: 1},
+		Usage:  &pbcodec.RlimitAccountUsage{Owner: in.Account, RamUsage: 100},
+	}, nil
+}
+
+func TestAccountResourcesHistory(t *testing.T) {
+	limits := func(cpuWeight int64) *pbcodec.RlimitOp {
+		return &pbcodec.RlimitOp{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountLimits{AccountLimits: &pbcodec.RlimitAccountLimits{Owner: "alice", CpuWeight: cpuWeight}}}
+	}
+	usage := func(ramUsage uint64) *pbcodec.RlimitOp {
+		return &pbcodec.RlimitOp{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_AccountUsage{AccountUsage: &pbcodec.RlimitAccountUsage{Owner: "alice", RamUsage: ramUsage}}}
+	}
+	block := func(id, previousID string, rlimitOps ...*pbcodec.RlimitOp) *bstream.Block {
+		block, err := codec.BlockFromProto(&pbcodec.Block{
+			Id:        id,
+			Number:    eos.BlockNum(id),
+			Header:    &pbcodec.BlockHeader{Previous: previousID, Timestamp: &timestamp.Timestamp{}},
+			RlimitOps: rlimitOps,
+		})
+		require.NoError(t, err)
+		return block
+	}
+
+	stateClient := &accountResourcesStateClient{MockStateClient: pbstatedb.NewMockStateClient()}
+	history := newAccountResourcesHistory(context.Background(), stateClient, "alice", bstream.NewBlockRefFromID("00000001a"))
+
+	var deltas []string
+	handler := history.Handler(bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		step := obj.(*forkable.ForkableObject).Step
+		if step != forkable.StepNew && step != forkable.StepUndo {
+			return nil
+		}
+
+		blk := block.ToNative().(*pbcodec.Block)
+
+		var before *accountResources
+		if step == forkable.StepUndo {
+			before = history.before(blk)
+		}
+
+		for _, delta := range accountDeltasFromBlock(blk, "alice", step, before) {
+			switch {
+			case delta.Data.Limits != nil:
+				deltas = append(deltas, fmt.Sprintf("%s %s limits %d", delta.Data.Step, delta.Data.BlockID, delta.Data.Limits.CPUWeight))
+			case delta.Data.Usage != nil:
+				deltas = append(deltas, fmt.Sprintf("%s %s usage %d", delta.Data.Step, delta.Data.BlockID, delta.Data.Usage.RAMUsage))
+			}
+		}
+		return nil
+	}))
+
+	forkableHandler := forkable.New(handler, forkable.WithExclusiveLIB(bstream.NewBlockRefFromID("00000001a")))
+	for _, block := range []*bstream.Block{
+		block("00000002a", "00000001a", limits(10)),
+		block("00000003a", "00000002a", limits(20), usage(300)),
+		block("00000004a", "00000003a", limits(30)),
+		block("00000003b", "00000002a"),
+		block("00000004b", "00000003b"),
+		block("00000005b", "00000004b"),
+	} {
+		require.NoError(t, forkableHandler.ProcessBlock(block, nil))
+	}
+
+	// The usage did not change since the last irreversible block, it is read from statedb at it
+	assert.Equal(t, []string{
+		"new 00000002a limits 10",
+		"new 00000003a limits 20",
+		"new 00000003a usage 300",
+		"new 00000004a limits 30",
+		"undo 00000004a limits 20",
+		"undo 00000003a limits 10",
+		"undo 00000003a usage 100",
+	}, deltas)
+	require.Len(t, stateClient.requests, 1)
+	assert.Equal(t, uint64(1), stateClient.requests[0].BlockNum)
+}
@@ -17,6 +17,7 @@ package eosws
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/dfuse-io/bstream"
 	"github.com/dfuse-io/bstream/forkable"
@@ -24,6 +25,7 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
 	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
 	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/dfuse-io/dfuse-eosio/filtering"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	_ "github.com/eoscanada/eos-go/forum"
 	"github.com/golang/protobuf/ptypes"
@@ -46,6 +48,70 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 		msg.Data.ActionNames = string(msg.Data.ActionName)
 	}
 
+	// An empty filter matches every action
+	actionFilter, err := filtering.NewActionTraceFilter("get_action_traces", msg.Data.Filter)
+	if err != nil {
+		ws.EmitErrorReply(ctx, msg, WSMessageDataValidationError(ctx, fmt.Errorf("invalid data.filter: %w", err)))
+		return
+	}
+
+	// Undo and redo steps are only streamed to the clients resuming from a cursor, the actions of the
+	// blocks are otherwise streamed once, as the blocks come in, like they always were
+	streamedSteps := forkable.StepNew
+	if msg.IrreversibleOnly {
+		streamedSteps = forkable.StepIrreversible
+	} else if authReq.Cursor != nil {
+		streamedSteps = forkable.StepNew | forkable.StepUndo | forkable.StepRedo
+	}
+
+	cursors := newCursorEmitter(ctx, ws, msg, authReq.Cursor)
+	handler := newActionTracesHandler(ctx, msg, cursors, actionFilter, streamedSteps)
+
+	var irrRef bstream.BlockRef
+	if authReq.Cursor != nil {
+		irrRef = authReq.Cursor.lib
+	} else {
+		irrID, err := ws.irreversibleFinder.IrreversibleIDAtBlockNum(ctx, authReq.StartBlockNum)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+			return
+		}
+		irrRef = bstream.NewBlockRefFromID(irrID)
+	}
+
+	if freq := msg.WithProgress; freq != 0 {
+		progHandler := NewProgressHandler(handler, cursors, msg, ctx)
+		if msg.IrreversibleOnly {
+			progHandler.SetStepFilter(forkable.StepIrreversible)
+		}
+		handler = progHandler
+	}
+
+	forkableHandler := newActionTracesForkable(handler, cursors, uint64(authReq.StartBlockNum), irrRef)
+
+	metrics.IncListeners("get_action_traces")
+	source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), forkableHandler, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
+
+	source.OnTerminating(func(_ error) {
+		metrics.CurrentListeners.Dec("get_action_traces")
+	})
+	err = ws.RegisterListener(ctx, msg.ReqID, func() error {
+		source.Shutdown(nil)
+		return nil
+	})
+	if err != nil {
+		source.Shutdown(nil) // important to ensure that OnRunFunc is run
+		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
+		return
+	}
+
+	ws.EmitReply(ctx, msg, wsmsg.NewListening(authReq.StartBlockNum))
+	go source.Run()
+}
+
+// newActionTracesHandler turns the blocks of the streamed steps into `action_trace` messages, the
+// actions of an undone block are streamed in reverse order, flagged with `undo`.
+func newActionTracesHandler(ctx context.Context, msg *wsmsg.GetActionTraces, cursors *cursorEmitter, actionFilter *filtering.CELFilter, streamedSteps forkable.StepType) bstream.Handler {
 	// Support multiple things
 	targetAccounts := mapString(msg.Data.Accounts)
 	targetReceivers := targetAccounts
@@ -54,9 +120,15 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 	}
 	targetActions := mapString(msg.Data.ActionNames)
 
-	handler := bstream.HandlerFunc(func(block *bstream.Block, _ interface{}) error {
+	return bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		step := obj.(*forkable.ForkableObject).Step
+		if step&streamedSteps == 0 {
+			return nil
+		}
+
 		blk := block.ToNative().(*pbcodec.Block)
 
+		var outs []*wsmsg.ActionTrace
 		for _, trx := range blk.TransactionTraces() {
 			if trx.Receipt == nil || trx.Receipt.Status != pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED {
 				// We do **not** stream transaction for that are not properly executed
@@ -83,6 +155,10 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 					continue
 				}
 
+				if !actionFilter.MatchTransactionActionTrace(trx, act) {
+					continue
+				}
+
 				rawTrace, err := mdl.ToV1ActionTraceRaw(act, allActions, msg.Data.WithInlineTraces)
 				if err != nil {
 					return err
@@ -93,6 +169,7 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 				out.Data.BlockID = blk.Id
 				stamp, _ := ptypes.Timestamp(blk.Header.Timestamp)
 				out.Data.BlockTime = stamp
+				out.Data.Undo = step == forkable.StepUndo
 
 				if msg.Data.WithRAMOps {
 					out.Data.RAMOps = mdl.ToV0RAMOps(trx.RAMOpsForAction(act.ExecutionIndex))
@@ -114,51 +191,36 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 					out.Data.TableOps = mdl.ToV0TableOps(trx.TableOpsForAction(act.ExecutionIndex))
 				}
 
-				metrics.DocumentResponseCounter.Inc()
-				ws.EmitReply(ctx, msg, out)
+				outs = append(outs, out)
 			}
 		}
 
-		return nil
-	})
-
-	irrID, err := ws.irreversibleFinder.IrreversibleIDAtBlockNum(ctx, authReq.StartBlockNum)
-	if err != nil {
-		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
-		return
-	}
-
-	if freq := msg.WithProgress; freq != 0 {
-		progHandler := NewProgressHandler(handler, ws, msg, ctx)
-		if msg.IrreversibleOnly {
-			progHandler.SetStepFilter(forkable.StepIrreversible)
+		if step == forkable.StepUndo {
+			for i, j := 0, len(outs)-1; i < j; i, j = i+1, j-1 {
+				outs[i], outs[j] = outs[j], outs[i]
+			}
 		}
-		handler = progHandler.ProcessBlock
-	}
 
-	if msg.IrreversibleOnly {
-		forkableHandler := forkable.New(handler, forkable.WithLogger(zlog), forkable.WithFilters(forkable.StepIrreversible))
-		handler = forkableHandler.ProcessBlock
-	}
-
-	blocknumGate := bstream.NewBlockNumGate(uint64(authReq.StartBlockNum), bstream.GateInclusive, handler, bstream.GateOptionWithLogger(zlog))
-	metrics.IncListeners("get_action_traces")
-	irrRef := bstream.NewBlockRefFromID(irrID)
-	source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), blocknumGate, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
+		for _, out := range outs {
+			metrics.DocumentResponseCounter.Inc()
+			cursors.EmitReply(ctx, msg, out)
+		}
 
-	source.OnTerminating(func(_ error) {
-		metrics.CurrentListeners.Dec("get_action_traces")
-	})
-	err = ws.RegisterListener(ctx, msg.ReqID, func() error {
-		source.Shutdown(nil)
 		return nil
 	})
-	if err != nil {
-		source.Shutdown(nil) // important to ensure that OnRunFunc is run
-		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
-		return
-	}
+}
 
-	ws.EmitReply(ctx, msg, wsmsg.NewListening(authReq.StartBlockNum))
-	go source.Run()
+// newActionTracesForkable places the cursor emitter right after the forkable so it sees all the steps it
+// needs to track the stream position, and to detect an unknown cursor once it became irreversible, the
+// steps that are not streamed are dropped by `handler`. Every block triggers a new longest chain, so
+// the blocks still flow as they come in, forks included.
+func newActionTracesForkable(handler bstream.Handler, cursors *cursorEmitter, startBlockNum uint64, irrRef bstream.BlockRef) *forkable.Forkable {
+	blocknumGate := bstream.NewBlockNumGate(startBlockNum, bstream.GateInclusive, cursors.Handler(handler), bstream.GateOptionWithLogger(zlog))
+
+	return forkable.New(blocknumGate,
+		forkable.WithLogger(zlog),
+		forkable.WithExclusiveLIB(irrRef),
+		forkable.WithFilters(forkable.StepNew|forkable.StepUndo|forkable.StepRedo|forkable.StepIrreversible),
+		forkable.EnsureAllBlocksTriggerLongestChain(),
+	)
 }
@@ -16,6 +16,7 @@ package eosws
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -28,9 +29,12 @@ import (
 	"time"
 
 	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
 	"github.com/dfuse-io/bstream/hub"
 	"github.com/dfuse-io/dauth/authenticator"
 	"github.com/dfuse-io/dfuse-eosio/codec"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/dfuse-io/dfuse-eosio/filtering"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
 	"github.com/dfuse-io/dstore"
@@ -38,6 +42,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
 
@@ -54,10 +59,12 @@ func TestOnGetActionsTraces(t *testing.T) {
 		return fmt.Sprintf(`{"type":"listening","req_id":%q,"data":{"next_block":2}}`, reqID)
 	}
 
-	actionTraceRespWithBlock := func(blockID string, reqID string, index int, trace string) string {
+	// cursorIndex is the position of the message among the ones emitted for its block
+	actionTraceRespWithBlock := func(blockID string, reqID string, cursorIndex int, index int, trace string) string {
 		ref := bstream.NewBlockRefFromID(blockID)
+		cursor := &streamCursor{step: forkable.StepNew, block: ref, lib: bstream.NewBlockRefFromID("00000001a"), index: uint32(cursorIndex)}
 
-		out := fmt.Sprintf(`{"type":"action_trace","req_id":%q,"data":{"block_num":%d,"block_id":"%s","block_time":"0001-01-01T00:00:00Z","trx_id":"trx.1","idx":%d,"trace":%s}`, reqID, ref.Num(), blockID, index, trace)
+		out := fmt.Sprintf(`{"type":"action_trace","req_id":%q,"cursor":%q,"data":{"block_num":%d,"block_id":"%s","block_time":"0001-01-01T00:00:00Z","trx_id":"trx.1","idx":%d,"trace":%s}`, reqID, cursor.String(), ref.Num(), blockID, index, trace)
 		out, _ = sjson.SetRaw(out, "data.trace.closest_unnotified_ancestor_action_ordinal", "0")
 		out, _ = sjson.SetRaw(out, "data.trace.console", `""`)
 		out, _ = sjson.SetRaw(out, "data.trace.block_num", `0`)
@@ -72,8 +79,8 @@ func TestOnGetActionsTraces(t *testing.T) {
 		return out
 	}
 
-	actionTraceResp := func(reqID string, index int, trace string) string {
-		return actionTraceRespWithBlock("00000002a", reqID, index, trace)
+	actionTraceResp := func(reqID string, cursorIndex int, index int, trace string) string {
+		return actionTraceRespWithBlock("00000002a", reqID, cursorIndex, index, trace)
 	}
 
 	tests := []struct {
@@ -94,8 +101,8 @@ func TestOnGetActionsTraces(t *testing.T) {
 			expectedMsgFactory: func(reqID string) []string {
 				return []string{
 					listeningResp(reqID),
-					actionTraceResp(reqID, 0, `{"inline_traces":[],"receiver":"eosioknights","act":{"account":"eosioknights","name":"transfer","authorization":[]}}}`),
-					actionTraceResp(reqID, 2, `{"inline_traces":[],"receiver":"eosiobuddies","act":{"account":"eosiobuddies","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 0, 0, `{"inline_traces":[],"receiver":"eosioknights","act":{"account":"eosioknights","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 1, 2, `{"inline_traces":[],"receiver":"eosiobuddies","act":{"account":"eosiobuddies","name":"transfer","authorization":[]}}}`),
 				}
 			},
 		},
@@ -111,8 +118,8 @@ func TestOnGetActionsTraces(t *testing.T) {
 			expectedMsgFactory: func(reqID string) []string {
 				return []string{
 					listeningResp(reqID),
-					actionTraceResp(reqID, 0, `{"inline_traces":[],"receiver":"eosioknights","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
-					actionTraceResp(reqID, 2, `{"inline_traces":[],"receiver":"eosiobuddies","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 0, 0, `{"inline_traces":[],"receiver":"eosioknights","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 1, 2, `{"inline_traces":[],"receiver":"eosiobuddies","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
 				}
 			},
 		},
@@ -128,7 +135,7 @@ func TestOnGetActionsTraces(t *testing.T) {
 			expectedMsgFactory: func(reqID string) []string {
 				return []string{
 					listeningResp(reqID),
-					actionTraceResp(reqID, 1, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 0, 1, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
 				}
 			},
 		},
@@ -147,7 +154,33 @@ func TestOnGetActionsTraces(t *testing.T) {
 
 				return []string{
 					listeningResp(reqID),
-					actionTraceResp(reqID, 1, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 0, 1, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+				}
+			},
+		},
+		{
+			name: "cel filter",
+			blocks: []archiveFiles{{"0000000000", acceptedBlockWithActions(t, "00000002a", statusExecuted,
+				"eosioknights:eosiofriends:transfer",
+				"eosiofriends:eosiofriends:transfer",
+				"eosiobuddies:eosiofriends:transfer",
+				"eosiobuddies:eosiofriends:rebirth",
+			)}},
+			listenData: `{"accounts":"eosiofriends","receivers":"eosioknights|eosiofriends|eosiobuddies","filter":"notif && action == 'transfer' && trx_action_count == 4 && receiver != 'eosioknights'"}`,
+			expectedMsgFactory: func(reqID string) []string {
+				return []string{
+					listeningResp(reqID),
+					actionTraceResp(reqID, 0, 2, `{"inline_traces":[],"receiver":"eosiobuddies","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+				}
+			},
+		},
+		{
+			name:       "invalid cel filter",
+			blocks:     []archiveFiles{{"0000000000", acceptedBlockWithActions(t, "00000002a", statusExecuted, "eosiofriends:eosiofriends:transfer")}},
+			listenData: `{"accounts":"eosiofriends","filter":"receiver"}`,
+			expectedMsgFactory: func(reqID string) []string {
+				return []string{
+					fmt.Sprintf(`{"type":"error","req_id":%q,"data":{"code":"ws_message_data_validation_error","details":{"reason":"invalid data.filter: invalid return type \"primitive:STRING\""},"message":"The received message data is not valid.","trace_id":"%s"}}`, reqID, defaultTraceID),
 				}
 			},
 		},
@@ -166,7 +199,7 @@ func TestOnGetActionsTraces(t *testing.T) {
 			expectedMsgFactory: func(reqID string) []string {
 				return []string{
 					listeningResp(reqID),
-					actionTraceRespWithBlock("00000003a", reqID, 0, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
+					actionTraceRespWithBlock("00000003a", reqID, 0, 0, `{"inline_traces":[],"receiver":"eosiofriends","act":{"account":"eosiofriends","name":"transfer","authorization":[]}}}`),
 				}
 			},
 		},
@@ -186,10 +219,10 @@ func TestOnGetActionsTraces(t *testing.T) {
 				fmt.Printf("For test1 %q, reqID is %q\n", "match all multiple", reqID)
 				return []string{
 					listeningResp(reqID),
-					actionTraceResp(reqID, 1, `{"inline_traces":[],"receiver":"eosioforlife","act":{"account":"eosioforlife","name":"transfer","authorization":[]}}}`),
-					actionTraceResp(reqID, 2, `{"inline_traces":[],"receiver":"eosioforlife","act":{"account":"eosioforlife","name":"issue","authorization":[]}}}`),
-					actionTraceResp(reqID, 3, `{"inline_traces":[],"receiver":"eosio.system","act":{"account":"eosio.system","name":"transfer","authorization":[]}}}`),
-					actionTraceResp(reqID, 4, `{"inline_traces":[],"receiver":"eosio.system","act":{"account":"eosio.system","name":"issue","authorization":[]}}}`),
+					actionTraceResp(reqID, 0, 1, `{"inline_traces":[],"receiver":"eosioforlife","act":{"account":"eosioforlife","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 1, 2, `{"inline_traces":[],"receiver":"eosioforlife","act":{"account":"eosioforlife","name":"issue","authorization":[]}}}`),
+					actionTraceResp(reqID, 2, 3, `{"inline_traces":[],"receiver":"eosio.system","act":{"account":"eosio.system","name":"transfer","authorization":[]}}}`),
+					actionTraceResp(reqID, 3, 4, `{"inline_traces":[],"receiver":"eosio.system","act":{"account":"eosio.system","name":"issue","authorization":[]}}}`),
 				}
 			},
 		},
@@ -214,6 +247,7 @@ func TestOnGetActionsTraces(t *testing.T) {
 				NewTestIrreversibleFinder("00000001a", nil),
 				0,
 				12,
+				nil,
 			)
 
 			conn, closer := newTestConnection(t, handler, &testCredentials{startBlock: 2})
@@ -239,6 +273,122 @@ func TestOnGetActionsTraces(t *testing.T) {
 	}
 }
 
+func TestOnGetActionsTraces_ResumeFromCursor(t *testing.T) {
+	archiveStore := dstore.NewMockStore(nil)
+	archiveStore.SetFile("0000000000", acceptedBlockWithActions(t, "00000002a", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+		"eosiofriends:eosiofriends:transfer",
+		"eosiofriends:eosiofriends:issue",
+		"eosiofriends:eosiofriends:rebirth",
+	))
+
+	subscriptionHub := newTestSubscriptionHub(t, 0, archiveStore)
+	handler := NewWebsocketHandler(nil, nil, nil, subscriptionHub, pbstatedb.NewMockStateClient(), nil, nil, nil, NewTestIrreversibleFinder("00000001a", nil), 0, 12, nil)
+
+	conn, closer := newTestConnection(t, handler, &testCredentials{startBlock: 1})
+	defer closer()
+
+	cursor := func(index uint32) string {
+		return (&streamCursor{step: forkable.StepNew, block: bstream.NewBlockRefFromID("00000002a"), lib: bstream.NewBlockRefFromID("00000001a"), index: index}).String()
+	}
+
+	err := conn.WriteMessage(1, []byte(fmt.Sprintf(`{"type":"get_action_traces","req_id":"abc","listen":true,"cursor":%q,"data":{"accounts":"eosiofriends"}}`, cursor(0))))
+	require.NoError(t, err)
+	go subscriptionHub.Launch()
+
+	// The first action was already received by the client, the stream resumes right after it
+	validateOutput(t, "abc", []string{`{"type":"listening","req_id":"abc","data":{"next_block":1}}`}, conn, 5*time.Second)
+	for i, name := range []string{"issue", "rebirth"} {
+		output := nextMessage(t, "abc", conn, 5*time.Second)
+		require.Equal(t, cursor(uint32(i+1)), gjson.Get(output, "cursor").String())
+		require.Equal(t, name, gjson.Get(output, "data.trace.act.name").String())
+	}
+}
+
+func TestOnGetActionsTraces_InvalidCursor(t *testing.T) {
+	subscriptionHub := newTestSubscriptionHub(t, 0, nil)
+	handler := NewWebsocketHandler(nil, nil, nil, subscriptionHub, pbstatedb.NewMockStateClient(), nil, nil, nil, NewTestIrreversibleFinder("00000001a", nil), 0, 12, nil)
+
+	conn, closer := newTestConnection(t, handler)
+	defer closer()
+
+	err := conn.WriteMessage(1, []byte(`{"type":"get_action_traces","req_id":"abc","listen":true,"start_block":2,"cursor":"abc","data":{"accounts":"eosiofriends"}}`))
+	require.NoError(t, err)
+
+	output := nextMessage(t, "abc", conn, 5*time.Second)
+	require.Equal(t, "error", gjson.Get(output, "type").String())
+	require.Contains(t, gjson.Get(output, "data.details.reason").String(), "only one of 'start_block' or 'cursor' can be specified")
+}
+
+func TestActionTracesHandler_ResumeOnForkedOutBlock(t *testing.T) {
+	trx := func(id string) string {
+		return fmt.Sprintf(`{"id":%q,"receipt":{"status":"TRANSACTIONSTATUS_EXECUTED"},"actionTraces":[`+
+			`{"receiver":"eosiofriends","action":{"account":"eosiofriends","name":"transfer"}},`+
+			`{"receiver":"eosiofriends","action":{"account":"eosiofriends","name":"issue"}}]}`, id)
+	}
+
+	blocks := []*bstream.Block{
+		testBlock(t, "00000002a", "00000001a", "", 1, trx("trx.2a")),
+		testBlock(t, "00000003a", "00000002a", "", 1, trx("trx.3a")),
+		testBlock(t, "00000003b", "00000002a", "", 1, trx("trx.3b")),
+		testBlock(t, "00000004b", "00000003b", "", 1, trx("trx.4b")),
+	}
+
+	stream := func(resume *streamCursor, steps forkable.StepType) (messages []string, cursors []string) {
+		ctx := context.Background()
+		msg := &wsmsg.GetActionTraces{CommonIn: wsmsg.CommonIn{ReqID: "abc"}}
+		msg.Data.Accounts = "eosiofriends"
+
+		actionFilter, err := filtering.NewActionTraceFilter("get_action_traces", "")
+		require.NoError(t, err)
+
+		emitter := NewTestEmitter(ctx, nil)
+		cursorEmitter := newCursorEmitter(ctx, emitter, msg, resume)
+
+		var lib bstream.BlockRef = bstream.NewBlockRefFromID("00000001a")
+		if resume != nil {
+			lib = resume.lib
+		}
+
+		forkableHandler := newActionTracesForkable(newActionTracesHandler(ctx, msg, cursorEmitter, actionFilter, steps), cursorEmitter, 2, lib)
+		for _, block := range blocks {
+			require.NoError(t, forkableHandler.ProcessBlock(block, nil))
+		}
+
+		for _, message := range emitter.messages {
+			trace := message.(*wsmsg.ActionTrace)
+			step := "new"
+			if trace.Data.Undo {
+				step = "undo"
+			}
+
+			messages = append(messages, step+" "+trace.Data.BlockID+" "+gjson.GetBytes(trace.Data.Trace, "act.name").String())
+			cursors = append(cursors, trace.Cursor)
+		}
+		return
+	}
+
+	// Without a cursor, the blocks are streamed once as they come in, forked out ones included
+	messages, cursors := stream(nil, forkable.StepNew)
+	require.Equal(t, []string{
+		"new 00000002a transfer", "new 00000002a issue",
+		"new 00000003a transfer", "new 00000003a issue",
+		"new 00000003b transfer", "new 00000003b issue",
+		"new 00000004b transfer", "new 00000004b issue",
+	}, messages)
+
+	// Resuming on the forked out block, its actions are undone in reverse order before switching fork
+	resume, err := decodeStreamCursor(cursors[2])
+	require.NoError(t, err)
+
+	resumed, _ := stream(resume, forkable.StepNew|forkable.StepUndo|forkable.StepRedo)
+	require.Equal(t, []string{
+		"new 00000003a issue",
+		"undo 00000003a issue", "undo 00000003a transfer",
+		"new 00000003b transfer", "new 00000003b issue",
+		"new 00000004b transfer", "new 00000004b issue",
+	}, resumed)
+}
+
 type archiveFiles struct {
 	name    string
 	content []byte
@@ -37,6 +37,7 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/eosws/rest"
 	stateHelper "github.com/dfuse-io/dfuse-eosio/eosws/statedb"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/pushtrx"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/dgrpc"
 	"github.com/dfuse-io/dipp"
@@ -56,6 +57,7 @@ import (
 // Deprecated: The features in the eosws package will be moved to other packages like Dgraphql
 type Config struct {
 	HTTPListenAddr              string
+	GRPCListenAddr              string
 	NodeosRPCEndpoint           string
 	NodeosRPCPushExtraEndpoints []string
 	BlockmetaAddr               string
@@ -259,6 +261,8 @@ func (a *App) Run() error {
 		return fmt.Errorf("blockmeta connection error: %w", err)
 	}
 
+	pusher := pushtrx.NewPusher(api, subscriptionHub, headInfoHub, a.Config.NodeosRPCProxyRetries, extraAPIs)
+
 	wsHandler := eosws.NewWebsocketHandler(
 		abiGetter,
 		accountGetter,
@@ -271,6 +275,7 @@ func (a *App) Run() error {
 		irrFinder,
 		a.Config.FilesourceRateLimitPerBlock,
 		a.Config.MaxStreamCountPerConnection,
+		pusher,
 	)
 
 	auth, err := authenticator.New(a.Config.AuthPlugin)
@@ -410,7 +415,7 @@ func (a *App) Run() error {
 
 	authTxPusher := dauthMiddleware.NewAuthMiddleware(auth, eosws.EOSChainErrorHandler).Handler(
 		dmetering.NewMeteringMiddleware(
-			rest.NewTxPusher(api, subscriptionHub, headInfoHub, a.Config.NodeosRPCProxyRetries, extraAPIs),
+			rest.NewTxPusher(pusher),
 			meter,
 			"eosws", "Push Transaction",
 			true, true,
@@ -462,7 +467,11 @@ func (a *App) Run() error {
 	//////////////////////////////////////////////////////////////////////
 	statedbRestRouter.Path("/v0/state/abi").Handler(statedbProxy)
 	statedbRestRouter.Path("/v0/state/abi/bin_to_json").Handler(statedbProxy)
+	statedbRestRouter.Path("/v0/state/account_resources").Handler(statedbProxy)
+	statedbRestRouter.Path("/v0/state/code").Handler(statedbProxy)
 	statedbRestRouter.Path("/v0/state/permission_links").Handler(statedbProxy)
+	statedbRestRouter.Path("/v0/state/permissions").Handler(statedbProxy)
+	statedbRestRouter.Path("/v0/state/resource_limits").Handler(statedbProxy)
 	statedbRestRouter.Path("/v0/state/key_accounts").Handler(statedbProxy)
 	statedbRestRouter.Path("/v0/state/table").Handler(statedbProxy)
 	statedbRestRouter.Path("/v0/state/table/row").Handler(statedbProxy)
@@ -545,6 +554,14 @@ func (a *App) Run() error {
 		go a.Shutdown(server.ListenAndServe())
 	}()
 
+	if a.Config.GRPCListenAddr != "" {
+		grpcServer := pushtrx.NewServer(pusher)
+		grpcServer.OnTerminated(a.Shutdown)
+		a.OnTerminating(grpcServer.Shutdown)
+
+		go grpcServer.Serve(a.Config.GRPCListenAddr)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ package eosws
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/dfuse-io/derr"
 	eos "github.com/eoscanada/eos-go"
@@ -31,6 +32,9 @@ type AuthorizedRequest struct {
 	StartBlockID  string // has precedence over startBlockNum
 	StartBlockNum uint32
 	IsFutureBlock bool
+
+	// Cursor is set when the stream resumes from a cursor, the start block being its last irreversible block
+	Cursor *streamCursor
 }
 
 func (ws *WSConn) AuthorizeRequest(ctx context.Context, msg wsmsg.IncomingMessager) (*AuthorizedRequest, bool) {
@@ -49,6 +53,21 @@ func (ws *WSConn) authorizeRequest(msg wsmsg.IncomingMessager, headBlock string)
 	common := msg.GetCommon()
 	reqStartBlock := common.StartBlock
 
+	var cursor *streamCursor
+	if common.Cursor != "" {
+		if common.StartBlock != 0 {
+			return nil, WSMessageDataValidationError(ws.Context, fmt.Errorf("only one of 'start_block' or 'cursor' can be specified"))
+		}
+
+		var err error
+		cursor, err = decodeStreamCursor(common.Cursor)
+		if err != nil {
+			return nil, WSMessageDataValidationError(ws.Context, fmt.Errorf("invalid cursor: %w", err))
+		}
+
+		reqStartBlock = int64(cursor.lib.Num())
+	}
+
 	headBlockNum := eos.BlockNum(headBlock)
 
 	if reqStartBlock == 0 {
@@ -88,5 +107,6 @@ func (ws *WSConn) authorizeRequest(msg wsmsg.IncomingMessager, headBlock string)
 	return &AuthorizedRequest{
 		StartBlockNum: startBlockNum,
 		IsFutureBlock: isFutureBlock,
+		Cursor:        cursor,
 	}, nil
 }
@@ -0,0 +1,184 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/dfuse-io/opaque"
+)
+
+// streamCursor is the position of a streamed message, the fork step and block that produced it,
+// the index of the message among the ones emitted for this step and block, as well as the last
+// irreversible block known at that time, from which a stream resuming at this cursor restarts.
+//
+// v1: c1:<step>:<block_id>:<lib_id>:<index>
+type streamCursor struct {
+	step  forkable.StepType
+	block bstream.BlockRef
+	lib   bstream.BlockRef
+	index uint32
+}
+
+func newStreamCursor(block bstream.BlockRef, fObj *forkable.ForkableObject) *streamCursor {
+	lib := bstream.NewBlockRef(fObj.ForkDB.LIBID(), fObj.ForkDB.LIBNum())
+
+	// The LIB was already moved past the irreversible segment, resuming must start right before it
+	if fObj.Step == forkable.StepIrreversible && len(fObj.StepBlocks) > 0 {
+		first := fObj.StepBlocks[0].Block
+		lib = bstream.NewBlockRef(first.PreviousID(), first.Num()-1)
+	}
+
+	return &streamCursor{
+		step:  fObj.Step,
+		block: bstream.NewBlockRef(block.ID(), block.Num()),
+		lib:   lib,
+	}
+}
+
+func decodeStreamCursor(in string) (*streamCursor, error) {
+	plain, err := opaque.FromOpaque(in)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode opaque cursor: %w", err)
+	}
+
+	parts := strings.Split(plain, ":")
+	if len(parts) != 5 || parts[0] != "c1" {
+		return nil, fmt.Errorf("unsupported cursor format")
+	}
+
+	step, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid step: %w", err)
+	}
+
+	index, err := strconv.ParseUint(parts[4], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index: %w", err)
+	}
+
+	if parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("block and last irreversible block IDs are required")
+	}
+
+	return &streamCursor{
+		step:  forkable.StepType(step),
+		block: bstream.NewBlockRefFromID(parts[2]),
+		lib:   bstream.NewBlockRefFromID(parts[3]),
+		index: uint32(index),
+	}, nil
+}
+
+func (c *streamCursor) String() string {
+	out, _ := opaque.ToOpaque(fmt.Sprintf("c1:%d:%s:%s:%d", c.step, c.block.ID(), c.lib.ID(), c.index))
+	return out
+}
+
+func (c *streamCursor) samePosition(other *streamCursor) bool {
+	return c.step == other.step && c.block.ID() == other.block.ID()
+}
+
+// cursorEmitter tags the messages emitted while processing a block with their stream cursor. When
+// resuming from a cursor, it drops everything the client already received up to the cursor, the
+// forkable replaying from the cursor's last irreversible block, the stream continues exactly where
+// it stopped, undo steps included when the client was on a fork.
+type cursorEmitter struct {
+	Emitter
+
+	ctx    context.Context
+	msg    wsmsg.IncomingMessager
+	resume *streamCursor
+
+	current *streamCursor
+	index   uint32
+	skip    uint32
+}
+
+func newCursorEmitter(ctx context.Context, emitter Emitter, msg wsmsg.IncomingMessager, resume *streamCursor) *cursorEmitter {
+	// A cursor on the last irreversible block itself can only be resumed right after it
+	if resume != nil && resume.block.Num() <= resume.lib.Num() {
+		resume = nil
+	}
+
+	return &cursorEmitter{Emitter: emitter, ctx: ctx, msg: msg, resume: resume}
+}
+
+// Handler must be placed right after the forkable, it tracks the cursor of the block being
+// processed and drops the blocks preceding the resume cursor.
+func (e *cursorEmitter) Handler(next bstream.Handler) bstream.Handler {
+	return bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		cursor := newStreamCursor(block, obj.(*forkable.ForkableObject))
+
+		skip := uint32(0)
+		if e.resume != nil {
+			if !cursor.samePosition(e.resume) {
+				if cursor.step == forkable.StepIrreversible && block.Num() >= e.resume.block.Num() {
+					err := AppCursorNotFoundError(e.ctx, e.resume.block.ID())
+					e.Emitter.EmitErrorReply(e.ctx, e.msg, err)
+					return err
+				}
+
+				return nil
+			}
+
+			skip = e.resume.index + 1
+			e.resume = nil
+		}
+
+		e.current = cursor
+		e.index = 0
+		e.skip = skip
+
+		return next.ProcessBlock(block, obj)
+	})
+}
+
+// nextCursor reserves the cursor of the next message emitted for the block being processed, `ok`
+// is false when the client already received that message before resuming.
+func (e *cursorEmitter) nextCursor() (cursor string, ok bool) {
+	if e.current == nil {
+		return "", true
+	}
+
+	index := e.index
+	e.index++
+	if index < e.skip {
+		return "", false
+	}
+
+	c := *e.current
+	c.index = index
+
+	return c.String(), true
+}
+
+func (e *cursorEmitter) EmitReply(ctx context.Context, originatingMsg wsmsg.IncomingMessager, msg wsmsg.OutgoingMessager) {
+	cursor, ok := e.nextCursor()
+	if !ok {
+		return
+	}
+
+	if cursorMsg, isCursorMsg := msg.(wsmsg.CursorSetter); isCursorMsg && cursor != "" {
+		cursorMsg.SetCursor(cursor)
+	}
+
+	e.Emitter.EmitReply(ctx, originatingMsg, msg)
+}
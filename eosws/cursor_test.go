@@ -0,0 +1,114 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	v1 "github.com/dfuse-io/eosws-go/mdl/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCursor_Decode(t *testing.T) {
+	cursor := &streamCursor{
+		step:  forkable.StepUndo,
+		block: bstream.NewBlockRefFromID("00000003a"),
+		lib:   bstream.NewBlockRefFromID("00000001a"),
+		index: 4,
+	}
+
+	decoded, err := decodeStreamCursor(cursor.String())
+	require.NoError(t, err)
+	assert.Equal(t, forkable.StepUndo, decoded.step)
+	assert.Equal(t, "00000003a", decoded.block.ID())
+	assert.Equal(t, uint64(3), decoded.block.Num())
+	assert.Equal(t, "00000001a", decoded.lib.ID())
+	assert.Equal(t, uint64(1), decoded.lib.Num())
+	assert.Equal(t, uint32(4), decoded.index)
+
+	_, err = decodeStreamCursor("not-a-cursor")
+	assert.Error(t, err)
+}
+
+func TestCursorEmitter_Resume(t *testing.T) {
+	blocks := []*bstream.Block{
+		bstream.TestBlockWithLIBNum("00000002a", "00000001a", 1),
+		bstream.TestBlockWithLIBNum("00000003a", "00000002a", 1),
+		bstream.TestBlockWithLIBNum("00000003b", "00000002a", 1),
+		bstream.TestBlockWithLIBNum("00000004b", "00000003b", 1),
+	}
+
+	// Emits two table deltas per block, returning each message along with its cursor
+	stream := func(resume *streamCursor) (messages []string, cursors []string) {
+		msg := &wsmsg.GetTableRows{CommonIn: wsmsg.CommonIn{ReqID: "abc"}}
+		emitter := NewTestEmitter(context.Background(), nil)
+		cursorEmitter := newCursorEmitter(context.Background(), emitter, msg, resume)
+
+		handler := cursorEmitter.Handler(bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+			step := obj.(*forkable.ForkableObject).Step
+			for i := 0; i < 2; i++ {
+				cursorEmitter.EmitReply(context.Background(), msg, wsmsg.NewTableDelta(uint32(block.Num()), &v1.DBOp{Key: fmt.Sprintf("%s-%d", block.ID(), i)}, step))
+			}
+			return nil
+		}))
+
+		var lib bstream.BlockRef = bstream.NewBlockRefFromID("00000001a")
+		if resume != nil {
+			lib = resume.lib
+		}
+
+		forkableHandler := forkable.New(handler, forkable.WithExclusiveLIB(lib), forkable.WithFilters(forkable.StepNew|forkable.StepUndo))
+		for _, block := range blocks {
+			require.NoError(t, forkableHandler.ProcessBlock(block, nil))
+		}
+
+		for _, message := range emitter.messages {
+			delta := message.(*wsmsg.TableDelta)
+			messages = append(messages, delta.Data.Step+" "+delta.Data.DBOp.Key)
+			cursors = append(cursors, delta.Cursor)
+		}
+		return
+	}
+
+	messages, cursors := stream(nil)
+	require.Equal(t, []string{
+		"new 00000002a-0", "new 00000002a-1",
+		"new 00000003a-0", "new 00000003a-1",
+		"undo 00000003a-0", "undo 00000003a-1",
+		"new 00000003b-0", "new 00000003b-1",
+		"new 00000004b-0", "new 00000004b-1",
+	}, messages)
+
+	for i, cursor := range cursors {
+		resume, err := decodeStreamCursor(cursor)
+		require.NoError(t, err)
+
+		resumed, _ := stream(resume)
+		assert.Equal(t, nilIfEmpty(messages[i+1:]), nilIfEmpty(resumed), "resuming from message #%d (%s)", i, messages[i])
+	}
+}
+
+func nilIfEmpty(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	return in
+}
@@ -451,6 +451,10 @@ func (db *MockDB) ListBlocks(ctx context.Context, startBlockNum uint32, limit in
 	panic("implement me")
 }
 
+func (db *MockDB) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, opts trxdb.ListBlocksOptions) ([]*pbcodec.BlockWithRefs, string, error) {
+	panic("implement me")
+}
+
 func (db *MockDB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) ([]*pbcodec.BlockWithRefs, error) {
 	panic("implement me")
 }
@@ -466,6 +470,10 @@ func (db *MockDB) ListAccountNames(ctx context.Context, concurrentReadCount uint
 	return []string{"eoscanadacom"}, nil
 }
 
+func (db *MockDB) ListAccountTransactions(ctx context.Context, accountName string, lowBlockNum, highBlockNum uint32, opts trxdb.ListAccountTransactionsOptions) ([]*trxdb.AccountTransactionRef, string, error) {
+	panic("implement me")
+}
+
 func (db *MockDB) GetBlockByNum(ctx context.Context, num uint32) (out []*pbcodec.BlockWithRefs, err error) {
 	filename := filepath.Join(db.path, "blocks", fmt.Sprintf("%s.json", fmt.Sprintf("%d", num)))
 	err = readFromFile(filename, out)
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/dfuse-io/derr"
+	"github.com/eoscanada/eos-go"
 )
 
 // Authentication/Authorization Errors
@@ -125,6 +126,32 @@ func DBTrxAppearanceTimeoutError(ctx context.Context, blockID string, trxID stri
 
 // Application Errors
 
+func AppCursorNotFoundError(ctx context.Context, blockID string) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("app_cursor_not_found_error"),
+		"The cursor's block was not found in the stream, it cannot be resumed.",
+		"block_id", blockID,
+	)
+}
+
+func AppTrxPushRejectedError(ctx context.Context, trxID string, apiErr eos.APIError) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("app_trx_push_rejected_error"),
+		"The transaction was rejected by the chain.",
+		"trx_id", trxID,
+		"code", apiErr.ErrorStruct.Code,
+		"name", apiErr.ErrorStruct.Name,
+		"what", apiErr.ErrorStruct.What,
+		"details", apiErr.ErrorStruct.Details,
+	)
+}
+
+func AppTrxPushTimeoutError(ctx context.Context, trxID string, resends int) *derr.ErrorResponse {
+	return derr.HTTPGatewayTimeoutError(ctx, nil, derr.C("app_trx_push_timeout_error"),
+		"The pushed transaction did not become irreversible in a timely matter.",
+		"trx_id", trxID,
+		"resends", resends,
+	)
+}
+
 func AppHeadInfoNotReadyError(ctx context.Context) *derr.ErrorResponse {
 	return derr.HTTPServiceUnavailableError(ctx, nil, derr.C("app_head_info_not_ready_error"),
 		"Head info not ready, please try again later.",
@@ -64,6 +64,7 @@ func Test_onGetHeadInfo(t *testing.T) {
 				NewTestIrreversibleFinder("00000002a", nil),
 				0,
 				12,
+				nil,
 			)
 
 			conn, closer := newTestConnection(t, handler)
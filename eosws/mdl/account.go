@@ -15,8 +15,10 @@
 package mdl
 
 import (
+	"strings"
 	"time"
 
+	"github.com/dfuse-io/dfuse-eosio/codec"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/eoscanada/eos-go"
 	"github.com/golang/protobuf/ptypes"
@@ -65,6 +67,115 @@ type LinkedPermission struct {
 	PermissionName string `json:"permission_name"`
 }
 
+// PermissionOp is a change to one of the account's permissions, `Old` is not set on insertion
+// while `New` is not set on removal.
+type PermissionOp struct {
+	Operation   string             `json:"op"`
+	ActionIndex int                `json:"action_idx"`
+	Old         *AccountPermission `json:"old,omitempty"`
+	New         *AccountPermission `json:"new,omitempty"`
+}
+
+// AccountPermission is a permission as stored on chain, the parent being referenced by its
+// internal id since the parent's name is not part of the permission object.
+type AccountPermission struct {
+	ID           uint64        `json:"id"`
+	ParentID     uint64        `json:"parent_id"`
+	PermName     string        `json:"perm_name"`
+	LastUpdated  time.Time     `json:"last_updated"`
+	RequiredAuth eos.Authority `json:"required_auth"`
+}
+
+type ResourceLimits struct {
+	NetWeight int64 `json:"net_weight"`
+	CPUWeight int64 `json:"cpu_weight"`
+	RAMBytes  int64 `json:"ram_bytes"`
+}
+
+type ResourceUsage struct {
+	NetUsage *UsageAccumulator `json:"net_usage"`
+	CPUUsage *UsageAccumulator `json:"cpu_usage"`
+	RAMUsage uint64            `json:"ram_usage"`
+}
+
+type UsageAccumulator struct {
+	LastOrdinal uint32 `json:"last_ordinal"`
+	ValueEx     uint64 `json:"value_ex"`
+	Consumed    uint64 `json:"consumed"`
+}
+
+func ToV1PermissionOp(in *pbcodec.PermOp) *PermissionOp {
+	return &PermissionOp{
+		Operation:   permOpOperation(in.Operation),
+		ActionIndex: int(in.ActionIndex),
+		Old:         ToV1AccountPermission(in.OldPerm),
+		New:         ToV1AccountPermission(in.NewPerm),
+	}
+}
+
+func permOpOperation(op pbcodec.PermOp_Operation) string {
+	switch op {
+	case pbcodec.PermOp_OPERATION_INSERT:
+		return "ins"
+	case pbcodec.PermOp_OPERATION_UPDATE:
+		return "upd"
+	case pbcodec.PermOp_OPERATION_REMOVE:
+		return "rem"
+	}
+
+	return strings.ToLower(strings.TrimPrefix(op.String(), "OPERATION_"))
+}
+
+func ToV1AccountPermission(in *pbcodec.PermissionObject) *AccountPermission {
+	if in == nil {
+		return nil
+	}
+
+	out := &AccountPermission{
+		ID:       in.Id,
+		ParentID: in.ParentId,
+		PermName: in.Name,
+	}
+
+	if in.LastUpdated != nil {
+		out.LastUpdated, _ = ptypes.Timestamp(in.LastUpdated)
+	}
+
+	if in.Authority != nil {
+		out.RequiredAuth = codec.AuthoritiesToEOS(in.Authority)
+	}
+
+	return out
+}
+
+func ToV1ResourceLimits(in *pbcodec.RlimitAccountLimits) *ResourceLimits {
+	return &ResourceLimits{
+		NetWeight: in.NetWeight,
+		CPUWeight: in.CpuWeight,
+		RAMBytes:  in.RamBytes,
+	}
+}
+
+func ToV1ResourceUsage(in *pbcodec.RlimitAccountUsage) *ResourceUsage {
+	return &ResourceUsage{
+		NetUsage: toV1UsageAccumulator(in.NetUsage),
+		CPUUsage: toV1UsageAccumulator(in.CpuUsage),
+		RAMUsage: in.RamUsage,
+	}
+}
+
+func toV1UsageAccumulator(in *pbcodec.UsageAccumulator) *UsageAccumulator {
+	if in == nil {
+		return nil
+	}
+
+	return &UsageAccumulator{
+		LastOrdinal: in.LastOrdinal,
+		ValueEx:     in.ValueEx,
+		Consumed:    in.Consumed,
+	}
+}
+
 type AccountResponse struct {
 	Name        eos.Name
 	CreatorName eos.Name
@@ -0,0 +1,92 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/dfuse-io/dfuse-eosio/pushtrx"
+	"github.com/dfuse-io/logging"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (ws *WSConn) onPushTransaction(ctx context.Context, msg *wsmsg.PushTransaction) {
+	zlogger := logging.Logger(ctx, zlog)
+
+	trxID := ""
+	if id, err := msg.Data.Transaction.ID(); err == nil {
+		trxID = id.String()
+	}
+
+	pushCtx, cancel := context.WithCancel(ctx)
+	err := ws.RegisterListener(ctx, msg.ReqID, func() error {
+		cancel()
+		return nil
+	})
+	if err != nil {
+		cancel()
+		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
+		return
+	}
+
+	var nextBlock uint32
+	if headBlock := ws.subscriptionHub.HeadBlock(); headBlock != nil {
+		nextBlock = uint32(headBlock.Num()) + 1
+	}
+	ws.EmitReply(ctx, msg, wsmsg.NewListening(nextBlock))
+
+	go func() {
+		err := ws.pusher.PushAndTrack(pushCtx, msg.Data.Transaction, msg.Data.UseLegacyPush, func(status *pushtrx.PushStatus) {
+			var trace *eos.TransactionTrace
+			if status.Trace != nil {
+				trace = codec.TransactionTraceToEOS(status.Trace)
+			}
+
+			metrics.DocumentResponseCounter.Inc()
+			ws.EmitReply(ctx, msg, wsmsg.NewTransactionPushStatus(string(status.Stage), status.TrxID, status.BlockNum, status.BlockID, status.Handoffs, trace))
+		})
+
+		if pushCtx.Err() != nil {
+			// Unlistened or connection closed, nobody to report to
+			return
+		}
+
+		// Frees the stream slot, the transaction is either irreversible or failed
+		ws.ShutdownListener(ctx, msg.ReqID)
+
+		if err == nil {
+			return
+		}
+
+		zlogger.Debug("push transaction failed", zap.String("trx_id", trxID), zap.Error(err))
+
+		var apiErr eos.APIError
+		var timeoutErr *pushtrx.TimeoutError
+		switch {
+		case errors.As(err, &apiErr):
+			ws.EmitErrorReply(ctx, msg, AppTrxPushRejectedError(ctx, trxID, apiErr))
+		case errors.As(err, &timeoutErr):
+			ws.EmitErrorReply(ctx, msg, AppTrxPushTimeoutError(ctx, trxID, timeoutErr.Resends))
+		default:
+			ws.EmitErrorReply(ctx, msg, derr.Wrapf(err, "unable to push transaction %q", trxID))
+		}
+	}()
+}
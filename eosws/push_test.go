@@ -0,0 +1,73 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func Test_onPushTransaction_Validation(t *testing.T) {
+	subscriptionHub := newTestSubscriptionHub(t, 0, nil)
+
+	cases := []struct {
+		name           string
+		msg            string
+		expectedOutput []string
+	}{
+		{
+			name:           "listen required",
+			msg:            `{"type":"push_transaction","req_id":"abc","data":{"transaction":{"signatures":[],"compression":"none","packed_context_free_data":"","packed_trx":"01"}}}`,
+			expectedOutput: []string{fmt.Sprintf(`{"data": {"code":"ws_message_data_validation_error", "details":{"reason":"'listen' is required"}, "message":"The received message data is not valid.", "trace_id":"%s"}, "req_id":"abc", "type":"error"}`, defaultTraceID)},
+		},
+		{
+			name:           "fetch not supported",
+			msg:            `{"type":"push_transaction","req_id":"abc","listen":true,"fetch":true,"data":{"transaction":{"signatures":[],"compression":"none","packed_context_free_data":"","packed_trx":"01"}}}`,
+			expectedOutput: []string{fmt.Sprintf(`{"data": {"code":"ws_message_data_validation_error", "details":{"reason":"'fetch' is not supported"}, "message":"The received message data is not valid.", "trace_id":"%s"}, "req_id":"abc", "type":"error"}`, defaultTraceID)},
+		},
+		{
+			name:           "packed trx required",
+			msg:            `{"type":"push_transaction","req_id":"abc","listen":true,"data":{}}`,
+			expectedOutput: []string{fmt.Sprintf(`{"data": {"code":"ws_message_data_validation_error", "details":{"reason":"'data.transaction.packed_trx' is required"}, "message":"The received message data is not valid.", "trace_id":"%s"}, "req_id":"abc", "type":"error"}`, defaultTraceID)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			handler := NewWebsocketHandler(
+				nil,
+				nil,
+				NewMockDB(""),
+				subscriptionHub,
+				nil,
+				nil,
+				nil,
+				nil,
+				NewTestIrreversibleFinder("00000002a", nil),
+				0,
+				12,
+				nil,
+			)
+
+			conn, closer := newTestConnection(t, handler)
+			defer closer()
+
+			conn.WriteMessage(1, []byte(c.msg))
+
+			validateOutput(t, "", c.expectedOutput, conn, 5*time.Second)
+		})
+	}
+}
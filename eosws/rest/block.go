@@ -22,8 +22,10 @@ import (
 	"github.com/dfuse-io/derr"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/validator"
+	"github.com/eoscanada/eos-go"
 	"github.com/gorilla/mux"
 )
 
@@ -53,7 +55,18 @@ func GetBlocksHandler(db eosws.DB) http.Handler {
 		skip, _ := strconv.Atoi(r.FormValue("skip"))
 		limit, _ := strconv.Atoi(r.FormValue("limit"))
 
-		dbBlocks, err := db.ListBlocks(r.Context(), uint32(skip), limit)
+		// The `after_block_id` parameter is the ID of the last block of the previous
+		// page, resuming right after it so forked blocks are neither skipped nor duplicated.
+		highBlockNum := uint32(skip)
+		afterBlockID := r.FormValue("after_block_id")
+		if afterBlockID != "" {
+			highBlockNum = eos.BlockNum(afterBlockID)
+		}
+
+		dbBlocks, _, err := db.ListBlocksInRange(r.Context(), 0, highBlockNum, trxdb.ListBlocksOptions{
+			Limit:  limit,
+			Cursor: afterBlockID,
+		})
 		if err != nil {
 			eosws.WriteError(w, r, derr.Wrap(err, "failed to get blocks"))
 			return
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	stackdriverPropagation "contrib.go.opencensus.io/exporter/stackdriver/propagation"
+	"github.com/dfuse-io/dfuse-eosio/pushtrx"
 	"github.com/dfuse-io/dmetering"
 	"github.com/eoscanada/eos-go"
 	"go.opencensus.io/plugin/ochttp"
@@ -128,7 +129,7 @@ func (p *ReverseProxy) tryReq(w http.ResponseWriter, r *http.Request, failDirect
 	if resp.StatusCode >= 500 {
 		retryable := true
 		if apiErr := decodeErrorBody(body); apiErr != nil {
-			retryable = isRetryable(*apiErr)
+			retryable = pushtrx.IsRetryable(*apiErr)
 		}
 		zlog.Info("REST error from backend",
 			zap.String("path", r.URL.Path),
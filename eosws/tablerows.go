@@ -58,13 +58,19 @@ func (ws *WSConn) onGetTableRows(ctx context.Context, msg *wsmsg.GetTableRows) {
 		return
 	}
 
-	fetchTableRows(ctx, ws, startBlockNum, msg, ws.abiGetter, ws, ws.stateClient, ws.irreversibleFinder)
+	if msg.Fetch && authReq.Cursor != nil {
+		ws.EmitErrorReply(ctx, msg, WSMessageDataValidationError(ctx, fmt.Errorf("'fetch' cannot be used along a 'cursor', the stream resumes where it left off")))
+		return
+	}
+
+	fetchTableRows(ctx, ws, startBlockNum, authReq.Cursor, msg, ws.abiGetter, ws, ws.stateClient, ws.irreversibleFinder)
 }
 
 func fetchTableRows(
 	ctx context.Context,
 	ws *WSConn,
 	startBlockNum uint32,
+	resume *streamCursor,
 	msg *wsmsg.GetTableRows,
 	abiGetter ABIGetter,
 	emitter Emitter,
@@ -109,74 +115,225 @@ func fetchTableRows(
 	}
 
 	if msg.Listen {
-		_, listenSpan := dtracing.StartSpan(ctx, "ws listen table rows")
+		matcher := newTableDeltaMatcher(msg.Data.Code, []eos.TableName{msg.Data.TableName}, []string{string(*msg.Data.Scope)}, msg.Data.JSON)
+		listenTableDeltas(ctx, ws, startBlockNum, startBlockID, resume, msg, matcher, abiGetter, emitter, irrFinder)
+	}
+}
 
-		var err error
+func (ws *WSConn) onGetMultiTableRows(ctx context.Context, msg *wsmsg.GetMultiTableRows) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("handling get multi table rows stream",
+		zap.String("account", string(msg.Data.Code)),
+		zap.Strings("scopes", msg.Data.Scopes),
+		zap.Int("table_count", len(msg.Data.Tables)),
+	)
+	authReq, ok := ws.AuthorizeRequest(ctx, msg)
+	if !ok {
+		return
+	}
 
-		var abiChangeHandler *ABIChangeHandler
-		tableDeltaHandler := newTableDeltaHandler(ctx, msg, emitter, zlog, func() *eos.ABI {
-			return abiChangeHandler.CurrentABI()
-		})
+	startBlockNum := authReq.StartBlockNum
+	if msg.Fetch && authReq.IsFutureBlock {
+		ws.EmitErrorReply(ctx, msg, AppTableRowsCannotFetchInFutureError(ctx, startBlockNum))
+		return
+	}
 
-		abiChangeHandler, err = NewABIChangeHandler(abiGetter, startBlockNum, msg.Data.Code, tableDeltaHandler, ctx)
-		if err != nil {
-			emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve abi"))
-			return
-		}
+	if msg.Fetch && authReq.Cursor != nil {
+		ws.EmitErrorReply(ctx, msg, WSMessageDataValidationError(ctx, fmt.Errorf("'fetch' cannot be used along a 'cursor', the stream resumes where it left off")))
+		return
+	}
+
+	fetchMultiTableRows(ctx, ws, startBlockNum, authReq.Cursor, msg, ws.abiGetter, ws, ws.stateClient, ws.irreversibleFinder)
+}
 
-		var handler bstream.Handler
-		handler = abiChangeHandler
-		if freq := msg.WithProgress; freq != 0 {
-			handler = NewProgressHandler(abiChangeHandler, emitter, msg, ctx)
+// fetchMultiTableRows emits a `table_snapshot` per table and scope, all tables being read at the
+// same block, then listens for the table deltas of all the matching scopes as a single stream.
+func fetchMultiTableRows(
+	ctx context.Context,
+	ws *WSConn,
+	startBlockNum uint32,
+	resume *streamCursor,
+	msg *wsmsg.GetMultiTableRows,
+	abiGetter ABIGetter,
+	emitter Emitter,
+	stateClient pbstatedb.StateClient,
+	irrFinder IrreversibleFinder,
+) {
+	zlogger := logging.Logger(ctx, zlog)
+
+	startBlockID := ""
+	if msg.Fetch {
+		spanContext, fetchSpan := dtracing.StartSpan(ctx, "fetch multi table rows")
+		if msg.StartBlock == 0 {
+			zlogger.Info("user requested start block 0, let statedb turns into head block instead of us doing it to prevent race condition")
+			startBlockNum = 0
 		}
 
-		var forkablePostGate bstream.Handler
-		var irrID string
-		if startBlockID != "" { //Flux return this ID
-			irrID, err = irrFinder.IrreversibleIDAtBlockID(ctx, startBlockID)
-			if err != nil {
-				emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
-				return
+		for _, table := range msg.Data.Tables {
+			request := &pbstatedb.StreamMultiScopesTableRowsRequest{
+				BlockNum: uint64(startBlockNum),
+				Contract: string(msg.Data.Code),
+				Table:    string(table),
+				Scopes:   append([]string(nil), msg.Data.Scopes...),
+				ToJson:   true,
 			}
-			forkablePostGate = bstream.NewBlockIDGate(startBlockID, bstream.GateExclusive, handler, bstream.GateOptionWithLogger(zlog))
-		} else {
-			irrID, err = irrFinder.IrreversibleIDAtBlockNum(ctx, startBlockNum)
+
+			zlogger.Info("requesting data from statedb", zap.Any("request", request))
+			ref, snapshots, err := fetchStateMultiScopesTableRows(spanContext, stateClient, request)
 			if err != nil {
-				emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+				emitter.EmitErrorReply(ctx, msg, fmt.Errorf("fetch table %q rows: %w", table, err))
+				fetchSpan.End()
 				return
 			}
-			forkablePostGate = bstream.NewBlockNumGate(uint64(startBlockNum), bstream.GateInclusive, handler, bstream.GateOptionWithLogger(zlog))
+
+			for _, snapshot := range snapshots {
+				metrics.DocumentResponseCounter.Inc()
+				emitter.EmitReply(spanContext, msg, snapshot)
+			}
+
+			// Once the first table resolved the block, all other tables are read at the same block
+			if ref.UpToBlock != nil {
+				startBlockID = ref.UpToBlock.ID()
+				startBlockNum = uint32(ref.UpToBlock.Num())
+				zlogger.Info("state client response", zap.Stringer("up_to_block", ref.UpToBlock))
+			}
 		}
+		fetchSpan.End()
+	}
 
-		irrRef := bstream.NewBlockRefFromID(irrID)
-		forkableHandler := forkable.New(forkablePostGate, forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+	if msg.Listen {
+		matcher := newTableDeltaMatcher(msg.Data.Code, msg.Data.Tables, msg.Data.Scopes, msg.Data.JSON)
+		listenTableDeltas(ctx, ws, startBlockNum, startBlockID, resume, msg, matcher, abiGetter, emitter, irrFinder)
+	}
+}
 
-		metrics.IncListeners("get_table_rows")
+// listenTableDeltas streams the database operations matching `matcher` as `table_delta` messages,
+// right after the `resume` cursor when set, otherwise starting right after `startBlockID` when
+// known, at `startBlockNum` if not.
+func listenTableDeltas(
+	ctx context.Context,
+	ws *WSConn,
+	startBlockNum uint32,
+	startBlockID string,
+	resume *streamCursor,
+	msg wsmsg.IncomingMessager,
+	matcher *tableDeltaMatcher,
+	abiGetter ABIGetter,
+	emitter Emitter,
+	irrFinder IrreversibleFinder,
+) {
+	zlogger := logging.Logger(ctx, zlog)
+	_, listenSpan := dtracing.StartSpan(ctx, "ws listen table rows")
 
-		source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), forkableHandler, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
-		source.OnTerminating(func(_ error) {
-			metrics.CurrentListeners.Dec("get_table_rows")
-			listenSpan.End()
-		})
+	var err error
 
-		err = ws.RegisterListener(ctx, msg.ReqID, func() error {
-			zlogger.Debug("fetchTableRows: canceller call", zap.String("req_id", msg.ReqID))
-			source.Shutdown(nil)
-			return nil
-		})
+	cursors := newCursorEmitter(ctx, emitter, msg, resume)
+
+	var abiChangeHandler *ABIChangeHandler
+	var handler bstream.Handler
+	handler = newTableDeltaHandler(ctx, msg, matcher, cursors, zlog, func() *eos.ABI {
+		return abiChangeHandler.CurrentABI()
+	})
+
+	if freq := msg.GetWithProgress(); freq != 0 {
+		handler = NewProgressHandler(handler, cursors, msg, ctx)
+	}
+
+	// The ABI changes must be tracked from the start, even for the blocks skipped up to the resume cursor
+	abiChangeHandler, err = NewABIChangeHandler(abiGetter, startBlockNum, matcher.code, cursors.Handler(handler), ctx)
+	if err != nil {
+		emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve abi"))
+		return
+	}
 
+	var forkablePostGate bstream.Handler
+	var irrID string
+	if resume != nil {
+		irrID = resume.lib.ID()
+		forkablePostGate = abiChangeHandler
+	} else if startBlockID != "" { //Flux return this ID
+		irrID, err = irrFinder.IrreversibleIDAtBlockID(ctx, startBlockID)
 		if err != nil {
-			source.Shutdown(nil) // important to ensure that OnRunFunc is run
-			emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
+			emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
 			return
 		}
+		forkablePostGate = bstream.NewBlockIDGate(startBlockID, bstream.GateExclusive, abiChangeHandler, bstream.GateOptionWithLogger(zlog))
+	} else {
+		irrID, err = irrFinder.IrreversibleIDAtBlockNum(ctx, startBlockNum)
+		if err != nil {
+			emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+			return
+		}
+		forkablePostGate = bstream.NewBlockNumGate(uint64(startBlockNum), bstream.GateInclusive, abiChangeHandler, bstream.GateOptionWithLogger(zlog))
+	}
+
+	irrRef := bstream.NewBlockRefFromID(irrID)
+	forkableHandler := forkable.New(forkablePostGate, forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+
+	metrics.IncListeners(msg.GetType())
+
+	source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), forkableHandler, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
+	source.OnTerminating(func(_ error) {
+		metrics.CurrentListeners.Dec(msg.GetType())
+		listenSpan.End()
+	})
+
+	err = ws.RegisterListener(ctx, msg.GetReqID(), func() error {
+		zlogger.Debug("listenTableDeltas: canceller call", zap.String("req_id", msg.GetReqID()))
+		source.Shutdown(nil)
+		return nil
+	})
 
-		emitter.EmitReply(ctx, msg, wsmsg.NewListening(startBlockNum+1))
-		go source.Run()
+	if err != nil {
+		source.Shutdown(nil) // important to ensure that OnRunFunc is run
+		emitter.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
+		return
+	}
+
+	emitter.EmitReply(ctx, msg, wsmsg.NewListening(startBlockNum+1))
+	go source.Run()
+}
+
+// tableDeltaMatcher determines which database operations are streamed as table deltas
+type tableDeltaMatcher struct {
+	code   eos.AccountName
+	tables map[string]bool
+	scopes map[string]bool // nil when all scopes match
+	json   bool
+}
+
+func newTableDeltaMatcher(code eos.AccountName, tables []eos.TableName, scopes []string, json bool) *tableDeltaMatcher {
+	matcher := &tableDeltaMatcher{
+		code:   code,
+		tables: map[string]bool{},
+		json:   json,
+	}
+
+	for _, table := range tables {
+		matcher.tables[string(table)] = true
+	}
+
+	if len(scopes) == 1 && scopes[0] == wsmsg.MultiTableRowsWildcardScope {
+		return matcher
+	}
+
+	matcher.scopes = map[string]bool{}
+	for _, scope := range scopes {
+		matcher.scopes[scope] = true
 	}
+
+	return matcher
 }
 
-func tableDeltasFromBlock(block *bstream.Block, msg *wsmsg.GetTableRows, abi *eos.ABI, step forkable.StepType, zlog *zap.Logger) []*wsmsg.TableDelta {
+func (m *tableDeltaMatcher) matches(dbOp *pbcodec.DBOp) bool {
+	if dbOp.Code != string(m.code) || !m.tables[dbOp.TableName] {
+		return false
+	}
+
+	return m.scopes == nil || m.scopes[dbOp.Scope]
+}
+
+func tableDeltasFromBlock(block *bstream.Block, matcher *tableDeltaMatcher, abi *eos.ABI, step forkable.StepType, zlog *zap.Logger) []*wsmsg.TableDelta {
 	zlog.Debug("about to stream table deltas from block", zap.Stringer("block", block), zap.Stringer("step", step))
 	var deltas []*wsmsg.TableDelta
 
@@ -189,7 +346,7 @@ func tableDeltasFromBlock(block *bstream.Block, msg *wsmsg.GetTableRows, abi *eo
 				continue
 			}
 
-			if dbOp.Code != string(msg.Data.Code) || dbOp.TableName != string(msg.Data.TableName) || dbOp.Scope != string(*msg.Data.Scope) {
+			if !matcher.matches(dbOp) {
 				continue
 			}
 
@@ -203,11 +360,11 @@ func tableDeltasFromBlock(block *bstream.Block, msg *wsmsg.GetTableRows, abi *eo
 			}
 
 			if len(dbOp.OldData) != 0 {
-				v1DBOp.Old = newDBRow(dbOp.OldData, msg.Data.TableName, abi, dbOp.OldPayer, msg.Data.JSON, zlog)
+				v1DBOp.Old = newDBRow(dbOp.OldData, eos.TableName(dbOp.TableName), abi, dbOp.OldPayer, matcher.json, zlog)
 			}
 
 			if len(dbOp.NewData) != 0 {
-				v1DBOp.New = newDBRow(dbOp.NewData, msg.Data.TableName, abi, dbOp.NewPayer, msg.Data.JSON, zlog)
+				v1DBOp.New = newDBRow(dbOp.NewData, eos.TableName(dbOp.TableName), abi, dbOp.NewPayer, matcher.json, zlog)
 			}
 
 			if step == forkable.StepUndo {
@@ -264,16 +421,37 @@ func fetchStateTableRows(ctx context.Context, stateClient pbstatedb.StateClient,
 	return
 }
 
+func fetchStateMultiScopesTableRows(ctx context.Context, stateClient pbstatedb.StateClient, request *pbstatedb.StreamMultiScopesTableRowsRequest) (ref *pbstatedb.StreamReference, out []*wsmsg.TableSnapshot, err error) {
+	snapshotsByScope := map[string]*wsmsg.TableSnapshot{}
+	ref, err = pbstatedb.ForEachMultiScopesTableRows(ctx, stateClient, request, func(scope string, row *pbstatedb.TableRowResponse) error {
+		snapshot, found := snapshotsByScope[scope]
+		if !found {
+			snapshot = new(wsmsg.TableSnapshot)
+			snapshot.Data.Table = request.Table
+			snapshot.Data.Scope = scope
+
+			snapshotsByScope[scope] = snapshot
+			out = append(out, snapshot)
+		}
+
+		snapshot.Data.Rows = append(snapshot.Data.Rows, []byte(row.Json))
+		return nil
+	})
+
+	return
+}
+
 type tableDeltaHandler struct {
-	msg        *wsmsg.GetTableRows
+	msg        wsmsg.IncomingMessager
+	matcher    *tableDeltaMatcher
 	emitter    Emitter
 	ctx        context.Context
 	zlog       *zap.Logger
 	getABIFunc func() *eos.ABI
 }
 
-func newTableDeltaHandler(ctx context.Context, msg *wsmsg.GetTableRows, emitter Emitter, zlog *zap.Logger, getABIFunc func() *eos.ABI) *tableDeltaHandler {
-	return &tableDeltaHandler{msg: msg, emitter: emitter, ctx: ctx, zlog: zlog, getABIFunc: getABIFunc}
+func newTableDeltaHandler(ctx context.Context, msg wsmsg.IncomingMessager, matcher *tableDeltaMatcher, emitter Emitter, zlog *zap.Logger, getABIFunc func() *eos.ABI) *tableDeltaHandler {
+	return &tableDeltaHandler{msg: msg, matcher: matcher, emitter: emitter, ctx: ctx, zlog: zlog, getABIFunc: getABIFunc}
 }
 
 func (h *tableDeltaHandler) ProcessBlock(block *bstream.Block, obj interface{}) error {
@@ -285,7 +463,7 @@ func (h *tableDeltaHandler) ProcessBlock(block *bstream.Block, obj interface{})
 			return fmt.Errorf("expected a none nil abi")
 		}
 
-		deltas := tableDeltasFromBlock(block, h.msg, h.getABIFunc(), fObj.Step, h.zlog)
+		deltas := tableDeltasFromBlock(block, h.matcher, h.getABIFunc(), fObj.Step, h.zlog)
 
 		for _, d := range deltas {
 			metrics.DocumentResponseCounter.Inc()
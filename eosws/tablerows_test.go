@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -107,6 +108,7 @@ func Test_onGetTableRows(t *testing.T) {
 				NewTestIrreversibleFinder("00000001a", nil),
 				0,
 				12,
+				nil,
 			)
 
 			conn, closer := newTestConnection(t, handler)
@@ -126,6 +128,85 @@ func Test_onGetTableRows(t *testing.T) {
 	}
 }
 
+func Test_onGetMultiTableRows(t *testing.T) {
+	archiveStore := dstore.NewMockStore(nil)
+	abiGetter := NewTestABIGetter()
+
+	cases := []struct {
+		name            string
+		stateDBResponse string
+		msg             string
+		expectedOutput  []string
+	}{
+		{
+			name:            "sunny path",
+			stateDBResponse: `{"last_irreversible_block_id":"00000001a","last_irreversible_block_num":1,"up_to_block_id":"00000001a","up_to_block_num":1,"scopes":[{"scope":"scope.1","rows":[{"key":"a","payer":"eosio","json":"{\"foo\":\"bar\"}"}]},{"scope":"scope.2","rows":[{"key":"b","payer":"eosio","json":"{\"foo\":\"baz\"}"}]}]}`,
+			msg:             `{"type":"get_multi_table_rows","req_id":"abc","fetch":true,"data":{"code":"account.1","scopes":["scope.1","scope.2"],"tables":["table.name.1"],"json":true}}`,
+			expectedOutput: []string{
+				`{"type":"table_snapshot","req_id":"abc","data":{"table":"table.name.1","scope":"scope.1","rows":[{"foo":"bar"}]}}`,
+				`{"type":"table_snapshot","req_id":"abc","data":{"table":"table.name.1","scope":"scope.2","rows":[{"foo":"baz"}]}}`,
+			},
+		},
+		{
+			name:           "wildcard not alone",
+			msg:            `{"type":"get_multi_table_rows","req_id":"abc","fetch":true,"data":{"code":"account.1","scopes":["*","scope.2"],"tables":["table.name.1"]}}`,
+			expectedOutput: []string{fmt.Sprintf(`{"type":"error","req_id":"abc","data":{"code":"ws_message_data_validation_error","details":{"reason":"'data.scopes' wildcard \"*\" must be the only scope"},"message":"The received message data is not valid.","trace_id":"%s"}}`, defaultTraceID)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			subscriptionHub := newTestSubscriptionHub(t, 1, archiveStore)
+			stateClient := pbstatedb.NewMockStateClient()
+
+			handler := NewWebsocketHandler(
+				abiGetter,
+				nil,
+				nil,
+				subscriptionHub,
+				stateClient,
+				nil,
+				nil,
+				nil,
+				NewTestIrreversibleFinder("00000001a", nil),
+				0,
+				12,
+				nil,
+			)
+
+			conn, closer := newTestConnection(t, handler)
+			defer closer()
+
+			if c.stateDBResponse != "" {
+				mockRows := new(pbstatedb.MockStreamMultiScopesTableRows)
+				require.NoError(t, json.Unmarshal([]byte(c.stateDBResponse), mockRows))
+				stateClient.SetStreamMultiScopesTableRows(mockRows)
+			}
+
+			err := conn.WriteMessage(1, []byte(c.msg))
+			require.NoError(t, err)
+			validateOutput(t, "", c.expectedOutput, conn, 5*time.Second)
+		})
+	}
+}
+
+func TestTableDeltaMatcher(t *testing.T) {
+	dbOp := func(code, scope, table string) *pbcodec.DBOp {
+		return &pbcodec.DBOp{Code: code, Scope: scope, TableName: table}
+	}
+
+	matcher := newTableDeltaMatcher("eosio.token", []eos.TableName{"accounts", "stat"}, []string{"alice", "bob"}, true)
+	assert.True(t, matcher.matches(dbOp("eosio.token", "alice", "accounts")))
+	assert.True(t, matcher.matches(dbOp("eosio.token", "bob", "stat")))
+	assert.False(t, matcher.matches(dbOp("eosio.token", "carol", "accounts")))
+	assert.False(t, matcher.matches(dbOp("eosio.token", "alice", "other")))
+	assert.False(t, matcher.matches(dbOp("other", "alice", "accounts")))
+
+	wildcard := newTableDeltaMatcher("eosio.token", []eos.TableName{"accounts"}, []string{"*"}, true)
+	assert.True(t, wildcard.matches(dbOp("eosio.token", "carol", "accounts")))
+	assert.False(t, wildcard.matches(dbOp("eosio.token", "carol", "stat")))
+}
+
 func TestTableDeltaHandler_ProcessBlock(t *testing.T) {
 	scope := eos.Name("eosio")
 
@@ -193,7 +274,8 @@ func TestTableDeltaHandler_ProcessBlock(t *testing.T) {
 
 			require.NoError(t, err)
 			emitter := NewTestEmitter(context.Background(), nil)
-			handler := newTableDeltaHandler(context.Background(), msg, emitter, zlog, func() *eos.ABI {
+			matcher := newTableDeltaMatcher(msg.Data.Code, []eos.TableName{msg.Data.TableName}, []string{string(*msg.Data.Scope)}, msg.Data.JSON)
+			handler := newTableDeltaHandler(context.Background(), msg, matcher, emitter, zlog, func() *eos.ABI {
 				return abi
 			})
 			err = handler.ProcessBlock(c.block, fobj)
@@ -310,7 +392,8 @@ func Test_dbopsFromBlock(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			tableDeltas := tableDeltasFromBlock(c.block, c.msg, abi, c.step, zlog)
+			matcher := newTableDeltaMatcher(c.msg.Data.Code, []eos.TableName{c.msg.Data.TableName}, []string{string(*c.msg.Data.Scope)}, c.msg.Data.JSON)
+			tableDeltas := tableDeltasFromBlock(c.block, matcher, abi, c.step, zlog)
 
 			require.Equal(t, c.expectedTableDeltaCount, len(tableDeltas))
 
@@ -16,6 +16,7 @@ package eosws
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/dfuse-io/bstream"
@@ -37,10 +38,20 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 	zlogger := logging.Logger(ctx, zlog)
 
 	var srcTx *pbcodec.TransactionLifecycle
+	var resume *streamCursor
 	var err error
 
-	srcTx, err = ws.db.GetTransaction(ctx, msg.Data.ID)
-	if err != nil {
+	if msg.Cursor != "" {
+		resume, err = decodeStreamCursor(msg.Cursor)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, WSMessageDataValidationError(ctx, fmt.Errorf("invalid cursor: %w", err)))
+			return
+		}
+	}
+
+	if resume != nil {
+		zlogger.Debug("resuming from cursor, skipping transaction lookup", zap.Stringer("cursor_block", resume.block))
+	} else if srcTx, err = ws.db.GetTransaction(ctx, msg.Data.ID); err != nil {
 		if !msg.Listen {
 			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to get transaction"))
 		}
@@ -59,12 +70,18 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 	if msg.Listen {
 		var source bstream.Source
 
-		libRef, err := ws.db.GetLastWrittenIrreversibleBlockRef(ctx)
-		if err != nil {
-			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to get lib"))
-			return
+		var libRef bstream.BlockRef
+		if resume != nil {
+			libRef = resume.lib
+		} else {
+			libRef, err = ws.db.GetLastWrittenIrreversibleBlockRef(ctx)
+			if err != nil {
+				ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to get lib"))
+				return
+			}
 		}
 
+		cursors := newCursorEmitter(ctx, ws, msg, resume)
 		wantedTrxID := msg.Data.ID
 		first := false
 		handler := bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
@@ -104,6 +121,12 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 				zap.Bool("expect_block_irreversible", expectBlockIrreversible),
 			)
 
+			// The message is emitted asynchronously, its cursor must be reserved while the block is processed
+			cursor, ok := cursors.nextCursor()
+			if !ok {
+				return nil
+			}
+
 			blkID := blk.ID()
 			go func() {
 				timeout := time.After(300 * time.Second) //this timeout is only for that particular attempt to notify the user about this block
@@ -149,8 +172,11 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 								ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to convert transaction"))
 								return
 							}
+							out := wsmsg.NewTransactionLifecycle(tx)
+							out.SetCursor(cursor)
+
 							metrics.DocumentResponseCounter.Inc()
-							ws.EmitReply(ctx, msg, wsmsg.NewTransactionLifecycle(tx))
+							ws.EmitReply(ctx, msg, out)
 						}
 						return
 					}
@@ -161,20 +187,26 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 		})
 
 		if freq := msg.WithProgress; freq != 0 {
-			handler = NewProgressHandler(handler, ws, msg, ctx).ProcessBlock
+			handler = NewProgressHandler(handler, cursors, msg, ctx).ProcessBlock
 		}
 
 		nextBlockRef := libRef
-		effectiveHandler := bstream.Handler(handler)
+		effectiveHandler := cursors.Handler(handler)
 
 		// If we have seen the transaction in the database, we know at which block we must start, it's the block right
 		// after execution trace's block id, since we have now seen this block.
 		if srcTx != nil && srcTx.ExecutionTrace != nil && srcTx.ExecutionTrace.BlockNum > libRef.Num() {
 			nextBlockRef = bstream.NewBlockRefFromID(srcTx.ExecutionTrace.ProducerBlockId)
-			effectiveHandler = bstream.NewBlockIDGate(nextBlockRef.ID(), bstream.GateExclusive, handler, bstream.GateOptionWithLogger(zlog))
+			effectiveHandler = bstream.NewBlockIDGate(nextBlockRef.ID(), bstream.GateExclusive, effectiveHandler, bstream.GateOptionWithLogger(zlog))
+		}
+
+		libOption := forkable.WithInclusiveLIB(libRef)
+		if resume != nil {
+			// The cursor's last irreversible block was already processed by the client
+			libOption = forkable.WithExclusiveLIB(libRef)
 		}
 
-		forkableHandler := forkable.New(effectiveHandler, forkable.WithLogger(zlog), forkable.WithInclusiveLIB(libRef))
+		forkableHandler := forkable.New(effectiveHandler, forkable.WithLogger(zlog), libOption)
 		firstGate := bstream.NewBlockIDGate(libRef.ID(), bstream.GateInclusive, forkableHandler, bstream.GateOptionWithLogger(zlog))
 
 		zlogger.Debug("starting listen transaction handler", zap.Stringer("lib", libRef), zap.Stringer("next_block", nextBlockRef))
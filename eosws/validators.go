@@ -27,6 +27,7 @@ import (
 
 func init() {
 	govalidator.AddCustomRule("eos.blockNum", validator.EOSBlockNumRule)
+	govalidator.AddCustomRule("eos.blockID", validator.EOSTrxIDRule)
 	govalidator.AddCustomRule("eos.name", validator.EOSNameRule)
 	govalidator.AddCustomRule("eos.trxID", validator.EOSTrxIDRule)
 
@@ -36,8 +37,9 @@ func init() {
 
 func ValidateBlocksRequest(r *http.Request) url.Values {
 	return validator.ValidateQueryParams(r, validator.Rules{
-		"skip":  []string{"numeric"},
-		"limit": []string{"required", "numeric_between:1,100"},
+		"skip":           []string{"numeric"},
+		"limit":          []string{"required", "numeric_between:1,100"},
+		"after_block_id": []string{"eos.blockID"},
 	})
 }
 
@@ -82,6 +82,7 @@ func Test_onGetVoteTally(t *testing.T) {
 				NewTestIrreversibleFinder("00000002a", nil),
 				0,
 				12,
+				nil,
 			)
 
 			conn, closer := newTestConnection(t, handler)
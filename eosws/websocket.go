@@ -25,6 +25,7 @@ import (
 	"github.com/dfuse-io/derr"
 	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/pushtrx"
 	"github.com/dfuse-io/logging"
 	"github.com/gorilla/websocket"
 	"github.com/teris-io/shortid"
@@ -40,6 +41,7 @@ type WebsocketHandler struct {
 	voteTallyHub    *VoteTallyHub
 	priceHub        *PriceHub
 	headInfoHub     *HeadInfoHub
+	pusher          *pushtrx.Pusher
 
 	connections        int
 	connectionsLock    sync.Mutex
@@ -69,6 +71,7 @@ func NewWebsocketHandler(
 	irrFinder IrreversibleFinder,
 	filesourceBlockRateLimit time.Duration,
 	maxStreamCount int,
+	pusher *pushtrx.Pusher,
 ) *WebsocketHandler {
 	originChecker := func(r *http.Request) bool {
 		if r.Header.Get("Origin") == "" {
@@ -104,6 +107,7 @@ func NewWebsocketHandler(
 		headInfoHub:        headInfoHub,
 		irreversibleFinder: irrFinder,
 		maxStreamCount:     maxStreamCount,
+		pusher:             pusher,
 	}
 
 	s.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -291,6 +291,9 @@ func (ws *WSConn) handleMessage(rawMsg []byte) {
 	case *wsmsg.GetTableRows:
 		ws.onGetTableRows(childCtx, msg)
 
+	case *wsmsg.GetMultiTableRows:
+		ws.onGetMultiTableRows(childCtx, msg)
+
 	case *wsmsg.GetActionTraces:
 		ws.onGetActionTraces(childCtx, msg)
 
@@ -303,6 +306,9 @@ func (ws *WSConn) handleMessage(rawMsg []byte) {
 	case *wsmsg.GetAccount:
 		ws.onAccount(childCtx, msg)
 
+	case *wsmsg.PushTransaction:
+		ws.onPushTransaction(childCtx, msg)
+
 	}
 }
 
@@ -18,13 +18,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/dfuse-io/bstream/forkable"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	v1 "github.com/dfuse-io/eosws-go/mdl/v1"
 	"github.com/dfuse-io/validator"
 )
 
 func init() {
 	RegisterIncomingMessage("get_account", GetAccount{})
 	RegisterOutgoingMessage("account", Account{})
+	RegisterOutgoingMessage("account_delta", AccountDelta{})
 }
 
 // INCOMING
@@ -38,12 +41,8 @@ type GetAccount struct {
 }
 
 func (m *GetAccount) Validate(ctx context.Context) error {
-	if !m.Fetch {
-		return fmt.Errorf("'fetch' is required")
-	}
-
-	if m.Listen {
-		return fmt.Errorf("'listen' is not supported")
+	if !m.Listen && !m.Fetch {
+		return fmt.Errorf("one of 'listen' or 'fetch' required (both supported)")
 	}
 
 	if m.IrreversibleOnly {
@@ -74,3 +73,31 @@ func NewAccount(account *mdl.Account) *Account {
 	out.Data.Account = account
 	return out
 }
+
+// AccountDelta is a change to the account's permissions, resource limits, resource usage or RAM
+// usage, exactly one of the changes is set. On `undo` step, the permission and RAM changes are
+// reversed, and the resource limits and usage changed by the undone block are sent once, with the
+// value they had before it. When that value cannot be retrieved, they are not sent, the account
+// must then be fetched again.
+type AccountDelta struct {
+	CommonOut
+	Data struct {
+		BlockNum     uint32              `json:"block_num"`
+		BlockID      string              `json:"block_id"`
+		Step         string              `json:"step"`
+		TrxID        string              `json:"trx_id,omitempty"`
+		PermissionOp *mdl.PermissionOp   `json:"permission_op,omitempty"`
+		RAMOp        *v1.RAMOp           `json:"ram_op,omitempty"`
+		Limits       *mdl.ResourceLimits `json:"limits,omitempty"`
+		Usage        *mdl.ResourceUsage  `json:"usage,omitempty"`
+	} `json:"data"`
+}
+
+func NewAccountDelta(blockNum uint32, blockID string, stepType forkable.StepType, trxID string) *AccountDelta {
+	out := &AccountDelta{}
+	out.Data.BlockNum = blockNum
+	out.Data.BlockID = blockID
+	out.Data.Step = stepType.String()
+	out.Data.TrxID = trxID
+	return out
+}
@@ -35,7 +35,6 @@ func init() {
 type GetActionTraces struct {
 	CommonIn
 
-	// TODO: Allow filtering by authorization accounts on DB ops
 	Data struct {
 		Receiver   eos.AccountName `json:"receiver"`    // deprecated (keep plural form)
 		Account    eos.AccountName `json:"account"`     // deprecated (keep plural form)
@@ -45,6 +44,10 @@ type GetActionTraces struct {
 		Accounts    string `json:"accounts"`
 		ActionNames string `json:"action_names"`
 
+		// Filter is a CEL expression further restricting the matching actions, it has access to the
+		// same identifiers as the block filtering expressions (`auth`, `data`, `notif`, `input`, etc.)
+		Filter string `json:"filter"`
+
 		WithInlineTraces bool `json:"with_inline_traces"`
 		WithDBOps        bool `json:"with_dbops"`
 		WithRAMOps       bool `json:"with_ramops"`
@@ -79,6 +82,7 @@ type ActionTrace struct {
 		ActionIndex   int       `json:"idx"`
 		//ActionDepth   int             `json:"depth"`
 		Trace json.RawMessage `json:"trace"`
+		Undo  bool            `json:"undo,omitempty"` // set when the block of the action was forked out
 
 		DBOps    []*v0.DBOp    `json:"dbops,omitempty"`
 		RAMOps   []*v0.RAMOp   `json:"ramops,omitempty"`
@@ -36,6 +36,7 @@ type CommonIn struct {
 	StartBlock       int64  `json:"start_block,omitempty"`
 	IrreversibleOnly bool   `json:"irreversible_only,omitempty"`
 	WithProgress     int64  `json:"with_progress,omitempty"` // send progress each X blocks
+	Cursor           string `json:"cursor,omitempty"`        // resume the stream right after this cursor
 }
 
 func (c CommonIn) GetID() string {
@@ -53,12 +54,14 @@ func (c CommonIn) GetCommon() *CommonIn   { return &c }
 func (c CommonIn) GetWithProgress() int64 { return c.WithProgress }
 
 type CommonOut struct {
-	Type  string `json:"type"`
-	ReqID string `json:"req_id,omitempty"`
+	Type   string `json:"type"`
+	ReqID  string `json:"req_id,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
 }
 
-func (c *CommonOut) SetType(v string)  { c.Type = v }
-func (c *CommonOut) SetReqID(v string) { c.ReqID = v }
+func (c *CommonOut) SetType(v string)   { c.Type = v }
+func (c *CommonOut) SetReqID(v string)  { c.ReqID = v }
+func (c *CommonOut) SetCursor(v string) { c.Cursor = v }
 
 // GetType retrieves the message `type` on a Common outgoing structure.
 func GetType(msg OutgoingMessager) (string, error) {
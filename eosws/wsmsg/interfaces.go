@@ -21,6 +21,11 @@ type OutgoingMessager interface {
 	SetReqID(v string)
 }
 
+// CursorSetter is implemented by the outgoing messages that can carry a stream cursor
+type CursorSetter interface {
+	SetCursor(v string)
+}
+
 type IncomingMessager interface {
 	GetType() string
 	GetReqID() string
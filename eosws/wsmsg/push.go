@@ -0,0 +1,83 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsmsg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eoscanada/eos-go"
+)
+
+func init() {
+	RegisterIncomingMessage("push_transaction", PushTransaction{})
+	RegisterOutgoingMessage("transaction_push_status", TransactionPushStatus{})
+}
+
+/// PushTransaction, incoming request
+
+type PushTransaction struct {
+	CommonIn
+
+	Data struct {
+		Transaction   *eos.PackedTransaction `json:"transaction"`
+		UseLegacyPush bool                   `json:"use_legacy_push"`
+	} `json:"data"`
+}
+
+func (m *PushTransaction) Validate(ctx context.Context) error {
+	if !m.Listen {
+		return fmt.Errorf("'listen' is required")
+	}
+	if m.Fetch {
+		return fmt.Errorf("'fetch' is not supported")
+	}
+	if m.IrreversibleOnly {
+		return fmt.Errorf("'irreversible_only' is not supported")
+	}
+	if m.StartBlock != 0 || m.Cursor != "" {
+		return fmt.Errorf("'start_block' and 'cursor' are not supported")
+	}
+	if m.Data.Transaction == nil || len(m.Data.Transaction.PackedTransaction) == 0 {
+		return fmt.Errorf("'data.transaction.packed_trx' is required")
+	}
+	return nil
+}
+
+// TransactionPushStatus is emitted each time a pushed transaction goes through a new stage
+// (`accepted`, `in-block`, `handoff`, `forked` or `irreversible`), the stream ends after the
+// `irreversible` one. The `trace` is only set on the `in-block` and `irreversible` stages.
+type TransactionPushStatus struct {
+	CommonOut
+	Data struct {
+		Stage    string                `json:"stage"`
+		TrxID    string                `json:"trx_id"`
+		BlockNum uint64                `json:"block_num,omitempty"`
+		BlockID  string                `json:"block_id,omitempty"`
+		Handoffs int                   `json:"handoffs,omitempty"`
+		Trace    *eos.TransactionTrace `json:"trace,omitempty"`
+	} `json:"data"`
+}
+
+func NewTransactionPushStatus(stage string, trxID string, blockNum uint64, blockID string, handoffs int, trace *eos.TransactionTrace) *TransactionPushStatus {
+	out := &TransactionPushStatus{}
+	out.Data.Stage = stage
+	out.Data.TrxID = trxID
+	out.Data.BlockNum = blockNum
+	out.Data.BlockID = blockID
+	out.Data.Handoffs = handoffs
+	out.Data.Trace = trace
+	return out
+}
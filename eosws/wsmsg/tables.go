@@ -26,6 +26,7 @@ import (
 
 func init() {
 	RegisterIncomingMessage("get_table_rows", GetTableRows{})
+	RegisterIncomingMessage("get_multi_table_rows", GetMultiTableRows{})
 	RegisterOutgoingMessage("table_snapshot", TableSnapshot{})
 	RegisterOutgoingMessage("table_delta", TableDelta{})
 }
@@ -65,6 +66,54 @@ func (m *GetTableRows) Validate(ctx context.Context) error {
 	return nil
 }
 
+// MultiTableRowsWildcardScope used as the sole element of `data.scopes` matches all the scopes of the tables
+const MultiTableRowsWildcardScope = "*"
+
+type GetMultiTableRowsData struct {
+	JSON bool `json:"json,omitempty"`
+
+	Code   eos.AccountName `json:"code"`
+	Scopes []string        `json:"scopes"`
+	Tables []eos.TableName `json:"tables"`
+}
+
+type GetMultiTableRows struct {
+	CommonIn
+	Data GetMultiTableRowsData `json:"data"`
+}
+
+func (m *GetMultiTableRows) Validate(ctx context.Context) error {
+	if !m.Listen && !m.Fetch {
+		return fmt.Errorf("one of 'listen' or 'fetch' required (both supported)")
+	}
+	if m.Data.Code == "" {
+		return fmt.Errorf("'data.code' required")
+	}
+	if len(m.Data.Tables) == 0 {
+		return fmt.Errorf("'data.tables' required")
+	}
+	for _, table := range m.Data.Tables {
+		if table == "" {
+			return fmt.Errorf("'data.tables' cannot contain an empty table")
+		}
+	}
+	if len(m.Data.Scopes) == 0 {
+		return fmt.Errorf("'data.scopes' required")
+	}
+	if len(m.Data.Scopes) > 1 {
+		for _, scope := range m.Data.Scopes {
+			if scope == MultiTableRowsWildcardScope {
+				return fmt.Errorf("'data.scopes' wildcard %q must be the only scope", MultiTableRowsWildcardScope)
+			}
+		}
+	}
+	if m.IrreversibleOnly {
+		return fmt.Errorf("'irreversible_only' is not supported")
+	}
+
+	return nil
+}
+
 // OUTGOING
 
 type TableDelta struct {
@@ -87,7 +136,9 @@ func NewTableDelta(blockNum uint32, dbop *v1.DBOp, stepType forkable.StepType) *
 type TableSnapshot struct {
 	CommonOut
 	Data struct {
-		Rows []json.RawMessage `json:"rows"`
-		// insert the schtuffs here..
+		// Table and Scope are only set in response to a `get_multi_table_rows` message
+		Table string            `json:"table,omitempty"`
+		Scope string            `json:"scope,omitempty"`
+		Rows  []json.RawMessage `json:"rows"`
 	} `json:"data"`
 }
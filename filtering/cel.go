@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dfuse-io/dfuse-eosio/codec"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
@@ -34,6 +35,9 @@ type CELFilter struct {
 	code          string
 	program       cel.Program
 	valueWhenNoop bool
+
+	// identifiers referenced by the program
+	identifiers map[string]bool
 }
 
 type blocknumBasedCELFilter map[uint64]*CELFilter
@@ -70,16 +74,37 @@ func (f *CELFilter) IsNoop() bool {
 	return f.program == nil
 }
 
+var includeNoopPrograms = []string{"", "true", "*"}
+var excludeNoopPrograms = []string{"", "false"}
+var systemActionsIncludeNoopPrograms = []string{"false", ""}
+
 func newCELFiltersInclude(codes []string) (blocknumBasedCELFilter, error) {
-	return newCELFilters("inclusion", codes, []string{"", "true", "*"}, true)
+	return newCELFilters("inclusion", codes, includeNoopPrograms, true)
 }
 
 func newCELFiltersSystemActionsInclude(codes []string) (blocknumBasedCELFilter, error) {
-	return newCELFilters("system action inclusion", codes, []string{"false", ""}, false)
+	return newCELFilters("system action inclusion", codes, systemActionsIncludeNoopPrograms, false)
 }
 
 func newCELFiltersExclude(codes []string) (blocknumBasedCELFilter, error) {
-	return newCELFilters("exclusion", codes, []string{"", "false"}, false)
+	return newCELFilters("exclusion", codes, excludeNoopPrograms, false)
+}
+
+// NewActionTraceFilter compiles a standalone CEL program against the same environment
+// used to filter blocks, an empty program (or `true`, `*`) matches every action. Programs
+// referring to `trx_signing_keys` are refused, the signing keys cannot be recovered outside
+// of the block (the transaction receipts and the chain ID are required for that).
+func NewActionTraceFilter(name string, code string) (*CELFilter, error) {
+	filter, err := newCELFilter(name, code, includeNoopPrograms, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.identifiers["trx_signing_keys"] {
+		return nil, fmt.Errorf("trx_signing_keys is not supported when filtering individual actions")
+	}
+
+	return filter, nil
 }
 
 func parseBlocknumBasedCode(code string) (out string, blocknum uint64, err error) {
@@ -148,10 +173,18 @@ func newCELFilter(name string, code string, noopPrograms []string, valueWhenNoop
 			decls.NewIdent("scheduled", decls.Bool, nil),
 			decls.NewIdent("trx_action_count", decls.Int, nil), // Amount of actions in the transaction in which this action is part of.
 			decls.NewIdent("top5_trx_actors", decls.NewListType(decls.String), nil),
-
-			// Those are not supported right now, so they are commented out for now to generate an error when using them
-			// decls.NewIdent("db", decls.NewMapType(decls.String, decls.String), nil),
-			// decls.NewIdent("ram", decls.NewMapType(decls.String, decls.String), nil),
+			decls.NewIdent("trx_cpu_usage_us", decls.Int, nil),    // CPU usage (in microseconds) billed to the transaction, from its receipt
+			decls.NewIdent("trx_net_usage_words", decls.Int, nil), // NET usage (in 8 bytes words) billed to the transaction, from its receipt
+			decls.NewIdent("trx_status", decls.String, nil),       // One of `executed`, `soft_fail`, `hard_fail`, `delayed`, `expired` or `unknown`
+			decls.NewIdent("trx_db_op_count", decls.Int, nil),     // Amount of database operations performed by the whole transaction
+			decls.NewIdent("trx_signing_keys", decls.NewListType(decls.String), nil),
+			decls.NewIdent("trx_created_deferred", decls.Bool, nil), // Whether the transaction created (or modified) a deferred transaction
+			decls.NewIdent("first_action", decls.String, nil),       // The `account:action` of the first action of the transaction
+
+			// Same values as the `db.key`, `db.table`, `ram.consumed` and `ram.released` search terms, computed from
+			// the database and RAM operations performed by the action itself
+			decls.NewIdent("db", decls.NewMapType(decls.String, decls.NewListType(decls.String)), nil),
+			decls.NewIdent("ram", decls.NewMapType(decls.String, decls.NewListType(decls.String)), nil),
 		),
 	)
 	if err != nil {
@@ -172,11 +205,24 @@ func newCELFilter(name string, code string, noopPrograms []string, valueWhenNoop
 		return nil, fmt.Errorf("program: %w", err)
 	}
 
+	checkedExpr, err := cel.AstToCheckedExpr(exprAst)
+	if err != nil {
+		return nil, fmt.Errorf("checked expression: %w", err)
+	}
+
+	identifiers := map[string]bool{}
+	for _, reference := range checkedExpr.ReferenceMap {
+		if reference.Name != "" {
+			identifiers[reference.Name] = true
+		}
+	}
+
 	return &CELFilter{
 		name:          name,
 		code:          code,
 		program:       prg,
 		valueWhenNoop: valueWhenNoop,
+		identifiers:   identifiers,
 	}, nil
 }
 
@@ -206,13 +252,49 @@ func (f *CELFilter) match(activation interpreter.Activation) (matched bool) {
 	return bool(retval)
 }
 
+// MatchActionTrace evaluates the filter against an action trace outside of its transaction,
+// `scheduled`, `db`, `ram`, `first_action` and all the `trx_*` and `top5_trx_actors` identifiers
+// resolve to their zero value (`unknown` for `trx_status`).
+func (f *CELFilter) MatchActionTrace(actTrace *pbcodec.ActionTrace) bool {
+	return f.match(&actionTraceActivation{
+		trace:                actTrace,
+		trxTop5ActorsGetter:  func() []string { return nil },
+		trxSigningKeysGetter: func() []string { return nil },
+	})
+}
+
+// MatchTransactionActionTrace evaluates the filter against an action trace of the given transaction,
+// `trx_signing_keys` is never used, `NewActionTraceFilter` refuses the programs referring to it.
+func (f *CELFilter) MatchTransactionActionTrace(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace) bool {
+	var trxTop5Actors []string
+	return f.match(&actionTraceActivation{
+		trace:          actTrace,
+		trxTrace:       trxTrace,
+		trxScheduled:   trxTrace.Scheduled,
+		trxActionCount: len(trxTrace.ActionTraces),
+		trxTop5ActorsGetter: func() []string {
+			if trxTop5Actors == nil {
+				trxTop5Actors = getTop5ActorsForTrx(trxTrace)
+			}
+			return trxTop5Actors
+		},
+		trxSigningKeysGetter: func() []string { return []string{} },
+	})
+}
+
 type actionTraceActivation struct {
 	trace      *pbcodec.ActionTrace
 	cachedData map[string]interface{}
+	cachedDB   map[string][]string
+	cachedRAM  map[string][]string
 
-	trxTop5ActorsGetter func() []string
-	trxScheduled        bool
-	trxActionCount      int
+	// Can be `nil` when the action is evaluated outside of its transaction
+	trxTrace *pbcodec.TransactionTrace
+
+	trxTop5ActorsGetter  func() []string
+	trxSigningKeysGetter func() []string
+	trxScheduled         bool
+	trxActionCount       int
 }
 
 func (a *actionTraceActivation) Parent() interpreter.Activation {
@@ -231,6 +313,43 @@ func (a *actionTraceActivation) ResolveName(name string) (interface{}, bool) {
 		return a.trxTop5ActorsGetter(), true
 	case "trx_action_count":
 		return a.trxActionCount, true
+	case "trx_cpu_usage_us":
+		if a.trxTrace == nil || a.trxTrace.Receipt == nil {
+			return 0, true
+		}
+		return int(a.trxTrace.Receipt.CpuUsageMicroSeconds), true
+	case "trx_net_usage_words":
+		if a.trxTrace == nil || a.trxTrace.Receipt == nil {
+			return 0, true
+		}
+		return int(a.trxTrace.Receipt.NetUsageWords), true
+	case "trx_status":
+		if a.trxTrace == nil || a.trxTrace.Receipt == nil {
+			return "unknown", true
+		}
+		return codec.TransactionStatusToEOS(a.trxTrace.Receipt.Status).String(), true
+	case "trx_db_op_count":
+		if a.trxTrace == nil {
+			return 0, true
+		}
+		return len(a.trxTrace.DbOps), true
+	case "trx_signing_keys":
+		return a.trxSigningKeysGetter(), true
+	case "trx_created_deferred":
+		if a.trxTrace == nil {
+			return false, true
+		}
+		for _, dtrxOp := range a.trxTrace.DtrxOps {
+			if dtrxOp.IsCreateOperation() {
+				return true, true
+			}
+		}
+		return false, true
+	case "first_action":
+		if a.trxTrace == nil || len(a.trxTrace.ActionTraces) == 0 {
+			return "", true
+		}
+		return a.trxTrace.ActionTraces[0].SimpleName(), true
 	case "receiver":
 		if a.trace.Receipt != nil {
 			return a.trace.Receipt.Receiver, true
@@ -278,12 +397,26 @@ func (a *actionTraceActivation) ResolveName(name string) (interface{}, bool) {
 	case "input":
 		return a.trace.IsInput(), true
 
-	// Those are actually commented out from the valid list of names that is possible to use in our CEL program,
-	// so it's not a big deal to have them currently panicking here as it no code path can reach this point.
-	case "ram":
-		panic("CEL filtering does not yet support ram.consumed nor ram.released")
 	case "db":
-		panic("CEL filtering does not yet support db.table, db.key, etc..")
+		if a.cachedDB == nil {
+			var dbOps []*pbcodec.DBOp
+			if a.trxTrace != nil {
+				dbOps = a.trxTrace.DBOpsForAction(a.trace.ExecutionIndex)
+			}
+
+			a.cachedDB = tokenizeDBOps(dbOps)
+		}
+		return a.cachedDB, true
+	case "ram":
+		if a.cachedRAM == nil {
+			var ramOps []*pbcodec.RAMOp
+			if a.trxTrace != nil {
+				ramOps = a.trxTrace.RAMOpsForAction(a.trace.ExecutionIndex)
+			}
+
+			a.cachedRAM = tokenizeRAMOps(ramOps)
+		}
+		return a.cachedRAM, true
 	}
 
 	return nil, false
@@ -300,3 +433,49 @@ func tokenizeEOSAuthority(authorizations []*pbcodec.PermissionLevel) (out []stri
 
 	return
 }
+
+// This must follow rules taken in `search/mapper.go` (`processDBOps`), both keys are always present
+// so that `'accounts' in db.table` evaluates to `false` instead of failing when the action has no
+// database operation.
+func tokenizeDBOps(dbOps []*pbcodec.DBOp) map[string][]string {
+	keys := map[string]bool{}
+	tables := map[string]bool{}
+	for _, op := range dbOps {
+		keys[fmt.Sprintf("%s/%s/%s", op.TableName, op.Scope, op.PrimaryKey)] = true
+		tables[fmt.Sprintf("%s/%s", op.TableName, op.Scope)] = true
+		tables[op.TableName] = true
+	}
+
+	return map[string][]string{
+		"key":   sortedKeys(keys),
+		"table": sortedKeys(tables),
+	}
+}
+
+// This must follow rules taken in `search/mapper.go` (`processRAMOps`), both keys are always present.
+func tokenizeRAMOps(ramOps []*pbcodec.RAMOp) map[string][]string {
+	consumed := map[string]bool{}
+	released := map[string]bool{}
+	for _, op := range ramOps {
+		if op.Delta > 0 {
+			consumed[op.Payer] = true
+		} else if op.Delta < 0 {
+			released[op.Payer] = true
+		}
+	}
+
+	return map[string][]string{
+		"consumed": sortedKeys(consumed),
+		"released": sortedKeys(released),
+	}
+}
+
+func sortedKeys(set map[string]bool) (out []string) {
+	out = make([]string, 0, len(set))
+	for key := range set {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+
+	return
+}
@@ -1,11 +1,14 @@
 package filtering
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -326,3 +329,156 @@ func TestCELActivation(t *testing.T) {
 		})
 	}
 }
+
+func TestCELActivation_DBAndRAM(t *testing.T) {
+	dbOp := ct.DBOp(t, "INS", "spamcontract/accounts/spamcontract/alice", "/alice", "/data")
+	dbOp.ActionIndex = 1
+
+	ramConsumed := ct.RAMOp(t, "alice", 128, 2048)
+	ramConsumed.ActionIndex = 1
+
+	ramReleased := ct.RAMOp(t, "bob", -128, 1024)
+	ramReleased.ActionIndex = 0
+
+	trxTrace := ct.TrxTrace(t,
+		ct.ActionTrace(t, "eosio.token:eosio.token:transfer"),
+		ct.ActionTrace(t, "spamcontract:spamcontract:spam"),
+		dbOp,
+		ramConsumed,
+		ramReleased,
+	)
+
+	tests := []struct {
+		name          string
+		code          string
+		actTrace      *pbcodec.ActionTrace
+		expectedMatch bool
+	}{
+		{"db table match", `'accounts' in db.table`, trxTrace.ActionTraces[1], true},
+		{"db table with scope match", `'accounts/spamcontract' in db.table`, trxTrace.ActionTraces[1], true},
+		{"db key match", `'accounts/spamcontract/alice' in db.key`, trxTrace.ActionTraces[1], true},
+		{"db table no op on action", `'accounts' in db.table`, trxTrace.ActionTraces[0], false},
+		{"db table negated no op on action", `!('accounts' in db.table)`, trxTrace.ActionTraces[0], true},
+		{"ram consumed match", `'alice' in ram.consumed`, trxTrace.ActionTraces[1], true},
+		{"ram released match", `'bob' in ram.released`, trxTrace.ActionTraces[0], true},
+		{"ram released not on action", `'bob' in ram.released`, trxTrace.ActionTraces[1], false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			celFilter, err := newCELFilter("test", test.code, []string{"false", ""}, false)
+			require.NoError(t, err)
+
+			matched := celFilter.match(&actionTraceActivation{
+				trace:               test.actTrace,
+				trxTrace:            trxTrace,
+				trxTop5ActorsGetter: func() []string { return nil },
+			})
+
+			assert.Equal(t, test.expectedMatch, matched)
+		})
+	}
+}
+
+func TestCELActivation_TransactionLevel(t *testing.T) {
+	trxTrace := ct.TrxTrace(t,
+		ct.ActionTrace(t, "eosio.token:eosio.token:transfer"),
+		ct.ActionTrace(t, "spamcontract:spamcontract:spam"),
+		ct.DBOp(t, "INS", "spamcontract/accounts/spamcontract/alice", "/alice", "/data"),
+		ct.DBOp(t, "UPD", "spamcontract/accounts/spamcontract/bob", "bob/bob", "old/new"),
+		&pbcodec.DTrxOp{Operation: pbcodec.DTrxOp_OPERATION_CREATE},
+		pbcodec.TransactionStatus_TRANSACTIONSTATUS_SOFTFAIL,
+	)
+	trxTrace.Receipt.CpuUsageMicroSeconds = 60000
+	trxTrace.Receipt.NetUsageWords = 16
+
+	tests := []struct {
+		name          string
+		code          string
+		trxTrace      *pbcodec.TransactionTrace
+		expectedMatch bool
+	}{
+		{"cpu usage", `trx_cpu_usage_us > 50000`, trxTrace, true},
+		{"net usage", `trx_net_usage_words == 16`, trxTrace, true},
+		{"status", `trx_status == 'soft_fail'`, trxTrace, true},
+		{"db op count", `trx_db_op_count == 2`, trxTrace, true},
+		{"created deferred", `trx_created_deferred`, trxTrace, true},
+		{"first action", `first_action == 'eosio.token:transfer'`, trxTrace, true},
+		{"signing keys", `'EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV' in trx_signing_keys`, trxTrace, true},
+		{"spam pattern", `trx_cpu_usage_us > 50000 && first_action == 'eosio.token:transfer'`, trxTrace, true},
+
+		{"cpu usage no receipt", `trx_cpu_usage_us > 0`, ct.TrxTrace(t, ct.ActionTrace(t, "eosio.token:eosio.token:transfer")), false},
+		{"no deferred created", `trx_created_deferred`, ct.TrxTrace(t, ct.ActionTrace(t, "eosio.token:eosio.token:transfer")), false},
+		{"outside of transaction", `first_action == '' && trx_status == 'unknown' && trx_db_op_count == 0`, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			celFilter, err := newCELFilter("test", test.code, []string{"false", ""}, false)
+			require.NoError(t, err)
+
+			matched := celFilter.match(&actionTraceActivation{
+				trace:                trxTrace.ActionTraces[0],
+				trxTrace:             test.trxTrace,
+				trxTop5ActorsGetter:  func() []string { return nil },
+				trxSigningKeysGetter: func() []string { return []string{"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"} },
+			})
+
+			assert.Equal(t, test.expectedMatch, matched)
+		})
+	}
+}
+
+func TestNewActionTraceFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		expectedError string
+	}{
+		{"noop", "*", ""},
+		{"transaction level", `trx_status == 'executed' && first_action == 'eosio.token:transfer'`, ""},
+		{"signing keys", `'EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV' in trx_signing_keys`, "trx_signing_keys is not supported when filtering individual actions"},
+		{"signing keys in macro", `action == 'transfer' || trx_signing_keys.exists(x, x != '')`, "trx_signing_keys is not supported when filtering individual actions"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewActionTraceFilter("test", test.code)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestSigningKeysResolver(t *testing.T) {
+	chainID := make(eos.Checksum256, 32)
+
+	keyBag := eos.NewKeyBag()
+	require.NoError(t, keyBag.Add("5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3"))
+
+	publicKey, err := ecc.NewPublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	require.NoError(t, err)
+
+	signedTrx, err := keyBag.Sign(context.Background(), eos.NewSignedTransaction(&eos.Transaction{}), chainID, publicKey)
+	require.NoError(t, err)
+
+	packedTrx, err := signedTrx.Pack(eos.CompressionNone)
+	require.NoError(t, err)
+
+	receipt := &pbcodec.TransactionReceipt{
+		Id: "trx1",
+		PackedTransaction: &pbcodec.PackedTransaction{
+			Signatures:        []string{packedTrx.Signatures[0].String()},
+			PackedTransaction: packedTrx.PackedTransaction,
+		},
+	}
+
+	resolver := newSigningKeysResolver(chainID, []*pbcodec.TransactionReceipt{receipt})
+	assert.Equal(t, []string{publicKey.String()}, resolver.getter("trx1")())
+	assert.Equal(t, []string{}, resolver.getter("deferred")())
+
+	assert.Equal(t, []string{}, newSigningKeysResolver(nil, []*pbcodec.TransactionReceipt{receipt}).getter("trx1")())
+}
@@ -0,0 +1,80 @@
+package filtering
+
+import (
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+)
+
+// ActionDecision is the outcome of a block filter for a single action trace
+type ActionDecision int
+
+const (
+	ActionDropped ActionDecision = iota
+	ActionKept
+	// ActionSystemForced is an action that was dropped by the include/exclude programs
+	// but kept anyway because it matched the system actions include program.
+	ActionSystemForced
+)
+
+func (d ActionDecision) String() string {
+	switch d {
+	case ActionDropped:
+		return "dropped"
+	case ActionKept:
+		return "kept"
+	case ActionSystemForced:
+		return "system-forced"
+	}
+
+	return "unknown"
+}
+
+// Evaluate runs the filter programs active at the block's height against each action trace of the
+// block (including the ones of failed deferred transactions), calling `onAction` with the decision
+// taken. Contrary to `TransformInPlace`, the block is left untouched and the programs are evaluated
+// even if the block was already filtered with them, only previously filtered out actions are skipped.
+func (f *BlockFilter) Evaluate(block *pbcodec.Block, onAction func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, decision ActionDecision)) {
+	include, exclude, systemActions := f.choosePrograms(block.Num())
+
+	trxTraces := block.UnfilteredTransactionTraces
+	trxs := block.UnfilteredTransactions
+	if block.FilteringApplied {
+		trxTraces = block.FilteredTransactionTraces
+		trxs = block.FilteredTransactions
+	}
+
+	signingKeys := newSigningKeysResolver(f.ChainID, trxs)
+
+	evaluateTrxTrace := func(trxTrace *pbcodec.TransactionTrace) {
+		var trxTop5Actors []string
+		getTrxTop5Actors := func() []string {
+			if trxTop5Actors == nil {
+				trxTop5Actors = getTop5ActorsForTrx(trxTrace)
+			}
+			return trxTop5Actors
+		}
+
+		for _, actTrace := range trxTrace.ActionTraces {
+			if block.FilteringApplied && !actTrace.FilteringMatched {
+				continue
+			}
+
+			decision := ActionDropped
+			if passes, isSystem := shouldProcess(trxTrace, actTrace, getTrxTop5Actors, signingKeys.getter(trxTrace.Id), include, exclude, systemActions); passes {
+				decision = ActionKept
+				if isSystem {
+					decision = ActionSystemForced
+				}
+			}
+
+			onAction(trxTrace, actTrace, decision)
+		}
+	}
+
+	for _, trxTrace := range trxTraces {
+		evaluateTrxTrace(trxTrace)
+
+		if trxTrace.FailedDtrxTrace != nil {
+			evaluateTrxTrace(trxTrace.FailedDtrxTrace)
+		}
+	}
+}
@@ -4,16 +4,26 @@ import (
 	"container/heap"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/codec"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 )
 
 type BlockFilter struct {
+	// Protects the programs, which can be swapped while blocks are being filtered, see `Reload`
+	lock sync.RWMutex
+
 	IncludeProgram              blocknumBasedCELFilter
 	ExcludeProgram              blocknumBasedCELFilter
 	SystemActionsIncludeProgram blocknumBasedCELFilter
+
+	// ChainID is required to recover the `trx_signing_keys` from the transactions signatures,
+	// when empty, `trx_signing_keys` is always an empty list.
+	ChainID eos.Checksum256
 }
 
 func NewBlockFilter(includeProgramCode, excludeProgramCode, systemActionsIncludeProgramCode []string) (*BlockFilter, error) {
@@ -39,6 +49,25 @@ func NewBlockFilter(includeProgramCode, excludeProgramCode, systemActionsInclude
 	}, nil
 }
 
+// Reload replaces the programs of the filter by the ones of `other`, the blocks filtered after this
+// call use the new programs. This is how a filter can be changed without restarting the services
+// that share it.
+func (f *BlockFilter) Reload(other *BlockFilter) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.IncludeProgram = other.IncludeProgram
+	f.ExcludeProgram = other.ExcludeProgram
+	f.SystemActionsIncludeProgram = other.SystemActionsIncludeProgram
+}
+
+func (f *BlockFilter) choosePrograms(blockNum uint64) (include, exclude, systemActions *CELFilter) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.IncludeProgram.choose(blockNum), f.ExcludeProgram.choose(blockNum), f.SystemActionsIncludeProgram.choose(blockNum)
+}
+
 var includeNOOP = &CELFilter{
 	code:          "",
 	program:       nil,
@@ -67,17 +96,14 @@ var systemIncludeNOOP = &CELFilter{
 // *Important* This method expect that the caller will peform the transformation in lock step, there is no lock
 //             performed by this method. It's the caller responsibility to deal with concurrency issues.
 func (f *BlockFilter) TransformInPlace(blk *bstream.Block) error {
+	include, exclude, systemActions := f.choosePrograms(blk.Number)
 
-	include := f.IncludeProgram.choose(blk.Number)
-	exclude := f.ExcludeProgram.choose(blk.Number)
-
-	// Don't decode the bstream block at all so we save a costly unpacking when both filters are no-op filters
-	if include.IsNoop() && exclude.IsNoop() {
+	// Don't decode the bstream block at all so we save a costly unpacking when both filters are no-op filters,
+	// unless the include comes from a filter set, its version must be recorded in the block even when noop
+	if include.IsNoop() && exclude.IsNoop() && !include.isFromFilterSet() {
 		return nil
 	}
 
-	systemActions := f.SystemActionsIncludeProgram.choose(blk.Number)
-
 	block := blk.ToNative().(*pbcodec.Block)
 
 	if filterExprContains(block.FilteringIncludeFilterExpr, include.code) {
@@ -86,7 +112,7 @@ func (f *BlockFilter) TransformInPlace(blk *bstream.Block) error {
 	if filterExprContains(block.FilteringExcludeFilterExpr, exclude.code) {
 		exclude = excludeNOOP
 	}
-	if include.IsNoop() && exclude.IsNoop() {
+	if include.IsNoop() && exclude.IsNoop() && !include.isFromFilterSet() {
 		return nil
 	}
 
@@ -94,7 +120,7 @@ func (f *BlockFilter) TransformInPlace(blk *bstream.Block) error {
 		systemActions = systemIncludeNOOP
 	}
 
-	transformInPlaceV2(block, include, exclude, systemActions)
+	transformInPlaceV2(block, include, exclude, systemActions, f.ChainID)
 	return nil
 }
 
@@ -157,7 +183,66 @@ func getTop5ActorsForTrx(trx *pbcodec.TransactionTrace) (topActors []string) {
 	return
 }
 
-func transformInPlaceV2(block *pbcodec.Block, include, exclude, systemActions *CELFilter) {
+// signingKeysResolver recovers the public keys that signed a transaction of the block from the
+// signatures of its receipt. Recovering is costly, so it's done lazily, only when a program
+// actually refers to `trx_signing_keys`.
+type signingKeysResolver struct {
+	chainID  eos.Checksum256
+	receipts []*pbcodec.TransactionReceipt
+
+	receiptByID map[string]*pbcodec.TransactionReceipt
+}
+
+func newSigningKeysResolver(chainID eos.Checksum256, receipts []*pbcodec.TransactionReceipt) *signingKeysResolver {
+	return &signingKeysResolver{chainID: chainID, receipts: receipts}
+}
+
+func (r *signingKeysResolver) getter(trxID string) func() []string {
+	var keys []string
+	return func() []string {
+		if keys == nil {
+			keys = r.resolve(trxID)
+		}
+		return keys
+	}
+}
+
+// resolve returns an empty list when the keys cannot be recovered, like for deferred transactions
+// which have no signatures or when the chain ID is not known.
+func (r *signingKeysResolver) resolve(trxID string) []string {
+	if len(r.chainID) == 0 {
+		return []string{}
+	}
+
+	if r.receiptByID == nil {
+		r.receiptByID = make(map[string]*pbcodec.TransactionReceipt, len(r.receipts))
+		for _, receipt := range r.receipts {
+			r.receiptByID[receipt.Id] = receipt
+		}
+	}
+
+	receipt := r.receiptByID[trxID]
+	if receipt == nil || receipt.PackedTransaction == nil {
+		return []string{}
+	}
+
+	signedTrx, err := codec.ExtractEOSSignedTransactionFromReceipt(receipt)
+	if err != nil {
+		if traceEnabled {
+			zlog.Debug("unable to extract signed transaction from receipt", zap.String("trx_id", trxID), zap.Error(err))
+		}
+		return []string{}
+	}
+
+	keys := codec.GetPublicKeysFromSignedTransaction(r.chainID, signedTrx)
+	if keys == nil {
+		return []string{}
+	}
+
+	return keys
+}
+
+func transformInPlaceV2(block *pbcodec.Block, include, exclude, systemActions *CELFilter, chainID eos.Checksum256) {
 	wasFiltered := block.FilteringApplied
 
 	block.FilteringApplied = true
@@ -182,6 +267,8 @@ func transformInPlaceV2(block *pbcodec.Block, include, exclude, systemActions *C
 		implicitTrxs = block.FilteredImplicitTransactionOps
 	}
 
+	signingKeys := newSigningKeysResolver(chainID, trxs)
+
 	for _, trxTrace := range trxTraces {
 		trxTraceAddedToFiltered := false
 		trxTraceExcluded := true
@@ -198,7 +285,7 @@ func transformInPlaceV2(block *pbcodec.Block, include, exclude, systemActions *C
 				continue
 			}
 
-			passes, isSystem := shouldProcess(trxTrace, actTrace, getTrxTop5Actors, include, exclude, systemActions)
+			passes, isSystem := shouldProcess(trxTrace, actTrace, getTrxTop5Actors, signingKeys.getter(trxTrace.Id), include, exclude, systemActions)
 			if !passes {
 				continue
 			}
@@ -230,7 +317,7 @@ func transformInPlaceV2(block *pbcodec.Block, include, exclude, systemActions *C
 					continue
 				}
 
-				passes, isSystem := shouldProcess(trxTrace.FailedDtrxTrace, actTrace, getTrxTop5Actors, include, exclude, systemActions)
+				passes, isSystem := shouldProcess(trxTrace.FailedDtrxTrace, actTrace, getTrxTop5Actors, signingKeys.getter(trxTrace.FailedDtrxTrace.Id), include, exclude, systemActions)
 				if !passes {
 					continue
 				}
@@ -316,14 +403,15 @@ func combineFilters(prev string, next *CELFilter) string {
 	if prev == "" {
 		return next.code
 	}
-	if next.IsNoop() {
+	// A noop include program created from a filter set still records the filter set version
+	if next.IsNoop() && !next.isFromFilterSet() {
 		return prev
 	}
 	return fmt.Sprintf("%s;;;%s", prev, next.code)
 }
 
-func shouldProcess(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, trxTop5ActorsGetter func() []string, include, exclude, systemActions *CELFilter) (pass bool, isSystem bool) {
-	activation := actionTraceActivation{trace: actTrace, trxScheduled: trxTrace.Scheduled, trxActionCount: len(trxTrace.ActionTraces), trxTop5ActorsGetter: trxTop5ActorsGetter}
+func shouldProcess(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, trxTop5ActorsGetter, trxSigningKeysGetter func() []string, include, exclude, systemActions *CELFilter) (pass bool, isSystem bool) {
+	activation := actionTraceActivation{trace: actTrace, trxTrace: trxTrace, trxScheduled: trxTrace.Scheduled, trxActionCount: len(trxTrace.ActionTraces), trxTop5ActorsGetter: trxTop5ActorsGetter, trxSigningKeysGetter: trxSigningKeysGetter}
 	// If the include program does not match, there is nothing more to do here
 	if !include.match(&activation) {
 		if systemActions.match(&activation) {
@@ -345,5 +433,8 @@ func shouldProcess(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionT
 }
 
 func (f *BlockFilter) String() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
 	return fmt.Sprintf("[include: %s, exclude: %s, system: %s]", f.IncludeProgram.String(), f.ExcludeProgram.String(), f.SystemActionsIncludeProgram.String())
 }
@@ -0,0 +1,242 @@
+package filtering
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/dfuse-io/dstore"
+	"gopkg.in/yaml.v2"
+)
+
+// FilterSet is a versioned and declarative definition of a block filter, usually loaded from
+// a YAML (or JSON) file. Each rule is active over a block range, at any given height, all the
+// active rules of the same kind are combined together (`||`).
+//
+// For example:
+//
+//	version: "2020-10-18.1"
+//	rules:
+//	- name: spam
+//	  kind: exclude
+//	  expr: "account == 'eidosonecoin'"
+//	  start_block: 100000000
+//	- name: system
+//	  kind: system_actions_include
+//	  expr: "receiver == 'eosio' && action in ['updateauth', 'deleteauth', 'linkauth', 'unlinkauth', 'newaccount', 'setabi', 'setcode']"
+type FilterSet struct {
+	Version string        `yaml:"version"`
+	Rules   []*FilterRule `yaml:"rules"`
+}
+
+type FilterRuleKind string
+
+const (
+	FilterRuleInclude              FilterRuleKind = "include"
+	FilterRuleExclude              FilterRuleKind = "exclude"
+	FilterRuleSystemActionsInclude FilterRuleKind = "system_actions_include"
+)
+
+type FilterRule struct {
+	Name string         `yaml:"name"`
+	Kind FilterRuleKind `yaml:"kind"`
+	Expr string         `yaml:"expr"`
+
+	// StartBlock is inclusive while StopBlock is exclusive, a StopBlock of 0 means the rule is active forever
+	StartBlock uint64 `yaml:"start_block"`
+	StopBlock  uint64 `yaml:"stop_block"`
+}
+
+func (r *FilterRule) activeAt(blockNum uint64) bool {
+	return blockNum >= r.StartBlock && (r.StopBlock == 0 || blockNum < r.StopBlock)
+}
+
+// LoadFilterSet reads and parses the filter set file at `fileURL`, which can be any URL supported
+// by `dstore` (i.e. a local path, `gs://`, `s3://`).
+func LoadFilterSet(ctx context.Context, fileURL string) (*FilterSet, error) {
+	reader, _, _, err := dstore.OpenObject(ctx, fileURL)
+	if err != nil {
+		return nil, fmt.Errorf("open filter set %q: %w", fileURL, err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read filter set %q: %w", fileURL, err)
+	}
+
+	return ParseFilterSet(content)
+}
+
+// ParseFilterSet parses and validates a filter set, JSON being valid YAML, both formats are accepted.
+func ParseFilterSet(content []byte) (*FilterSet, error) {
+	set := &FilterSet{}
+	if err := yaml.UnmarshalStrict(content, set); err != nil {
+		return nil, fmt.Errorf("unmarshal filter set: %w", err)
+	}
+
+	if err := set.validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter set: %w", err)
+	}
+
+	return set, nil
+}
+
+func (s *FilterSet) validate() error {
+	if s.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+
+	if strings.Contains(s.Version, ";") {
+		return fmt.Errorf("version %q cannot contain ';'", s.Version)
+	}
+
+	seenNames := map[string]bool{}
+	for i, rule := range s.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("rule #%d: name is required", i)
+		}
+
+		if seenNames[rule.Name] {
+			return fmt.Errorf("rule %q: declared twice", rule.Name)
+		}
+		seenNames[rule.Name] = true
+
+		if rule.StopBlock != 0 && rule.StopBlock <= rule.StartBlock {
+			return fmt.Errorf("rule %q: stop block %d must be greater than start block %d", rule.Name, rule.StopBlock, rule.StartBlock)
+		}
+
+		var err error
+		switch rule.Kind {
+		case FilterRuleInclude:
+			_, err = newCELFilter("inclusion", rule.Expr, includeNoopPrograms, true)
+		case FilterRuleExclude:
+			_, err = newCELFilter("exclusion", rule.Expr, excludeNoopPrograms, false)
+		case FilterRuleSystemActionsInclude:
+			_, err = newCELFilter("system action inclusion", rule.Expr, systemActionsIncludeNoopPrograms, false)
+		default:
+			return fmt.Errorf("rule %q: invalid kind %q, valid kinds are %q, %q and %q", rule.Name, rule.Kind, FilterRuleInclude, FilterRuleExclude, FilterRuleSystemActionsInclude)
+		}
+
+		if err != nil {
+			return fmt.Errorf("rule %q: %w", rule.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *FilterSet) rules(kind FilterRuleKind) (out []*FilterRule) {
+	for _, rule := range s.Rules {
+		if rule.Kind == kind {
+			out = append(out, rule)
+		}
+	}
+	return
+}
+
+// NewBlockFilterFromSet creates a block filter out of the filter set rules. The include programs
+// are recorded prefixed with the filter set version (i.e. `@<version>;<expr>`) in the block's
+// `FilteringIncludeFilterExpr`, so it's possible to determine which filter set version applied.
+func NewBlockFilterFromSet(set *FilterSet) (*BlockFilter, error) {
+	includeFilter, err := newCELFiltersFromRules("inclusion", set.rules(FilterRuleInclude), includeNoopPrograms, true)
+	if err != nil {
+		return nil, fmt.Errorf("include filter: %w", err)
+	}
+
+	for _, filter := range includeFilter {
+		filter.code = fmt.Sprintf("%s%s;%s", filterSetVersionPrefix, set.Version, filter.code)
+	}
+
+	excludeFilter, err := newCELFiltersFromRules("exclusion", set.rules(FilterRuleExclude), excludeNoopPrograms, false)
+	if err != nil {
+		return nil, fmt.Errorf("exclude filter: %w", err)
+	}
+
+	saIncludeFilter, err := newCELFiltersFromRules("system action inclusion", set.rules(FilterRuleSystemActionsInclude), systemActionsIncludeNoopPrograms, false)
+	if err != nil {
+		return nil, fmt.Errorf("system actions include filter: %w", err)
+	}
+
+	return &BlockFilter{
+		IncludeProgram:              includeFilter,
+		ExcludeProgram:              excludeFilter,
+		SystemActionsIncludeProgram: saIncludeFilter,
+	}, nil
+}
+
+// filterSetVersionPrefix starts the code of the include programs created from a filter set, a CEL
+// expression cannot start with `@`, so there is no ambiguity with a plain expression.
+const filterSetVersionPrefix = "@"
+
+func (f *CELFilter) isFromFilterSet() bool {
+	return strings.HasPrefix(f.code, filterSetVersionPrefix)
+}
+
+// newCELFiltersFromRules turns the rules into their block num based equivalent, a program being
+// compiled at each height where the set of active rules changes.
+func newCELFiltersFromRules(name string, rules []*FilterRule, noopPrograms []string, valueWhenNoop bool) (blocknumBasedCELFilter, error) {
+	heights := map[uint64]bool{0: true}
+	for _, rule := range rules {
+		heights[rule.StartBlock] = true
+		if rule.StopBlock != 0 {
+			heights[rule.StopBlock] = true
+		}
+	}
+
+	filtersMap := make(map[uint64]*CELFilter)
+	for height := range heights {
+		code := combineRulesAt(height, rules, noopPrograms, valueWhenNoop)
+
+		filter, err := newCELFilter(name, code, noopPrograms, valueWhenNoop)
+		if err != nil {
+			return nil, fmt.Errorf("rules active at block #%d: %w", height, err)
+		}
+
+		filtersMap[height] = filter
+	}
+
+	return filtersMap, nil
+}
+
+// combineRulesAt returns the `||` combination of the rules active at the given height, a noop
+// rule makes the whole combination noop when it matches by default (i.e. an include of `*`),
+// otherwise it is simply ignored (i.e. an exclude of `false`).
+func combineRulesAt(height uint64, rules []*FilterRule, noopPrograms []string, valueWhenNoop bool) string {
+	var exprs []string
+	for _, rule := range rules {
+		if !rule.activeAt(height) {
+			continue
+		}
+
+		if isNoopProgram(rule.Expr, noopPrograms) {
+			if valueWhenNoop {
+				return ""
+			}
+			continue
+		}
+
+		exprs = append(exprs, strings.TrimSpace(rule.Expr))
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+
+	for i, expr := range exprs {
+		exprs[i] = "(" + expr + ")"
+	}
+
+	return strings.Join(exprs, " || ")
+}
+
+func isNoopProgram(code string, noopPrograms []string) bool {
+	stripped := strings.TrimSpace(code)
+	for _, noopProgram := range noopPrograms {
+		if stripped == noopProgram {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package filtering
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilterSet(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{"yaml", "version: v1\nrules:\n- name: spam\n  kind: exclude\n  expr: account == 'spam'\n  start_block: 10\n  stop_block: 20\n", ""},
+		{"json", `{"version": "v1", "rules": [{"name": "spam", "kind": "exclude", "expr": "account == 'spam'"}]}`, ""},
+		{"missing version", `{"rules": []}`, "invalid filter set: version is required"},
+		{"version with separator", `{"version": "v1;v2"}`, `invalid filter set: version "v1;v2" cannot contain ';'`},
+		{"unknown field", `{"version": "v1", "filters": []}`, "unmarshal filter set: yaml: unmarshal errors:\n  line 1: field filters not found in type filtering.FilterSet"},
+		{"missing rule name", `{"version": "v1", "rules": [{"kind": "exclude", "expr": "true"}]}`, "invalid filter set: rule #0: name is required"},
+		{"duplicated rule name", `{"version": "v1", "rules": [{"name": "a", "kind": "exclude", "expr": "true"}, {"name": "a", "kind": "include", "expr": "true"}]}`, `invalid filter set: rule "a": declared twice`},
+		{"invalid kind", `{"version": "v1", "rules": [{"name": "a", "kind": "other", "expr": "true"}]}`, `invalid filter set: rule "a": invalid kind "other", valid kinds are "include", "exclude" and "system_actions_include"`},
+		{"invalid range", `{"version": "v1", "rules": [{"name": "a", "kind": "exclude", "expr": "true", "start_block": 20, "stop_block": 10}]}`, `invalid filter set: rule "a": stop block 10 must be greater than start block 20`},
+		{"invalid expr", `{"version": "v1", "rules": [{"name": "a", "kind": "exclude", "expr": "account"}]}`, `invalid filter set: rule "a": invalid return type "primitive:STRING"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set, err := ParseFilterSet([]byte(test.content))
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, &FilterSet{Version: "v1", Rules: set.Rules}, set)
+				assert.Len(t, set.Rules, 1)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestNewBlockFilterFromSet(t *testing.T) {
+	set := &FilterSet{
+		Version: "v1",
+		Rules: []*FilterRule{
+			{Name: "all", Kind: FilterRuleInclude, Expr: "*"},
+			{Name: "only-token", Kind: FilterRuleInclude, Expr: "receiver == 'eosio.token'", StartBlock: 10},
+			{Name: "spam", Kind: FilterRuleExclude, Expr: "account == 'spam'", StartBlock: 5, StopBlock: 20},
+			{Name: "spam2", Kind: FilterRuleExclude, Expr: "account == 'spam2'", StartBlock: 15},
+			{Name: "system", Kind: FilterRuleSystemActionsInclude, Expr: "action == 'setabi'"},
+		},
+	}
+
+	filter, err := NewBlockFilterFromSet(set)
+	require.NoError(t, err)
+
+	tests := []struct {
+		blockNum        uint64
+		expectedInclude string
+		expectedExclude string
+		expectedSystem  string
+	}{
+		{0, "@v1;", "", "action == 'setabi'"},
+		{5, "@v1;", "account == 'spam'", "action == 'setabi'"},
+		// The `*` include rule is still active, making the combination a noop
+		{10, "@v1;", "account == 'spam'", "action == 'setabi'"},
+		{15, "@v1;", "(account == 'spam') || (account == 'spam2')", "action == 'setabi'"},
+		{20, "@v1;", "account == 'spam2'", "action == 'setabi'"},
+	}
+
+	for _, test := range tests {
+		include, exclude, system := filter.choosePrograms(test.blockNum)
+		assert.Equal(t, test.expectedInclude, include.code, "include at #%d", test.blockNum)
+		assert.Equal(t, test.expectedExclude, exclude.code, "exclude at #%d", test.blockNum)
+		assert.Equal(t, test.expectedSystem, system.code, "system at #%d", test.blockNum)
+	}
+
+	set.Rules[0].StopBlock = 10
+	filter, err = NewBlockFilterFromSet(set)
+	require.NoError(t, err)
+
+	include, _, _ := filter.choosePrograms(12)
+	assert.Equal(t, "@v1;receiver == 'eosio.token'", include.code)
+	assert.False(t, include.IsNoop())
+}
+
+func TestBlockFilter_FromSetRecordsVersion(t *testing.T) {
+	filter, err := NewBlockFilterFromSet(&FilterSet{
+		Version: "v1",
+		Rules: []*FilterRule{
+			{Name: "spam", Kind: FilterRuleExclude, Expr: "receiver == 'spamcoint'"},
+		},
+	})
+	require.NoError(t, err)
+
+	newBlock := func() *pbcodec.Block {
+		return ct.Block(t, "00000001aa",
+			ct.TrxTrace(t, ct.ActionTrace(t, "eosio:eosio:newaccount")),
+			ct.TrxTrace(t, ct.ActionTrace(t, "spamcoint:spamcoint:transfer")),
+		)
+	}
+
+	blk := ct.ToBstreamBlock(t, newBlock())
+	require.NoError(t, filter.TransformInPlace(blk))
+
+	block := blk.ToNative().(*pbcodec.Block)
+	assert.Equal(t, "@v1;", block.FilteringIncludeFilterExpr)
+	assert.Equal(t, "receiver == 'spamcoint'", block.FilteringExcludeFilterExpr)
+	assert.Len(t, block.FilteredTransactionTraces, 1)
+
+	// Applying the same version again is a no-op
+	require.NoError(t, filter.TransformInPlace(blk))
+	assert.Equal(t, "@v1;", blk.ToNative().(*pbcodec.Block).FilteringIncludeFilterExpr)
+
+	newFilter, err := NewBlockFilterFromSet(&FilterSet{
+		Version: "v2",
+		Rules: []*FilterRule{
+			{Name: "spam", Kind: FilterRuleExclude, Expr: "receiver == 'spamcoint'"},
+			{Name: "newaccount", Kind: FilterRuleExclude, Expr: "action == 'newaccount'"},
+		},
+	})
+	require.NoError(t, err)
+
+	filter.Reload(newFilter)
+
+	blk = ct.ToBstreamBlock(t, newBlock())
+	require.NoError(t, filter.TransformInPlace(blk))
+
+	block = blk.ToNative().(*pbcodec.Block)
+	assert.Equal(t, "@v2;", block.FilteringIncludeFilterExpr)
+	assert.Equal(t, "(receiver == 'spamcoint') || (action == 'newaccount')", block.FilteringExcludeFilterExpr)
+	assert.Len(t, block.FilteredTransactionTraces, 0)
+}
+
+func TestBlockFilter_FromSetRecordsVersionOfNoopInclude(t *testing.T) {
+	filter, err := NewBlockFilterFromSet(&FilterSet{
+		Version: "v1",
+		Rules: []*FilterRule{
+			{Name: "all", Kind: FilterRuleInclude, Expr: "true"},
+		},
+	})
+	require.NoError(t, err)
+
+	blk := ct.ToBstreamBlock(t, ct.Block(t, "00000001aa",
+		ct.TrxTrace(t, ct.ActionTrace(t, "eosio:eosio:newaccount")),
+		ct.TrxTrace(t, ct.ActionTrace(t, "spamcoint:spamcoint:transfer")),
+	))
+	require.NoError(t, filter.TransformInPlace(blk))
+
+	block := blk.ToNative().(*pbcodec.Block)
+	assert.True(t, block.FilteringApplied)
+	assert.Equal(t, "@v1;", block.FilteringIncludeFilterExpr)
+	assert.Equal(t, "", block.FilteringExcludeFilterExpr)
+	assert.Len(t, block.FilteredTransactionTraces, 2)
+
+	// Applying the same version again is a no-op
+	require.NoError(t, filter.TransformInPlace(blk))
+	assert.Equal(t, "@v1;", blk.ToNative().(*pbcodec.Block).FilteringIncludeFilterExpr)
+}
+
+func TestFilterSetWatcher_Reload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filter-set")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "filters.yaml")
+	writeSet := func(content string) {
+		require.NoError(t, ioutil.WriteFile(file, []byte(content), 0644))
+	}
+
+	writeSet("version: v1\nrules:\n- name: spam\n  kind: exclude\n  expr: account == 'spam'\n")
+	set, err := LoadFilterSet(context.Background(), file)
+	require.NoError(t, err)
+
+	filter, err := NewBlockFilterFromSet(set)
+	require.NoError(t, err)
+
+	watcher := NewFilterSetWatcher(file, time.Minute, filter, set.Version)
+
+	writeSet("version: v2\nrules:\n- name: spam\n  kind: exclude\n  expr: account == 'spam2'\n")
+	require.NoError(t, watcher.reload())
+
+	_, exclude, _ := filter.choosePrograms(0)
+	assert.Equal(t, "account == 'spam2'", exclude.code)
+	assert.Equal(t, "v2", watcher.version)
+
+	writeSet("version: v3\nrules:\n- name: spam\n  kind: exclude\n  expr: account\n")
+	require.Error(t, watcher.reload())
+
+	_, exclude, _ = filter.choosePrograms(0)
+	assert.Equal(t, "account == 'spam2'", exclude.code, "invalid filter set should keep the current filter")
+	assert.Equal(t, "v2", watcher.version)
+}
@@ -0,0 +1,74 @@
+package filtering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dfuse-io/shutter"
+	"go.uber.org/zap"
+)
+
+// FilterSetWatcher periodically reads the filter set file and reloads the block filter each
+// time the file declares a different version.
+type FilterSetWatcher struct {
+	*shutter.Shutter
+
+	fileURL  string
+	interval time.Duration
+	filter   *BlockFilter
+	version  string
+}
+
+// NewFilterSetWatcher creates a watcher of the filter set at `fileURL`, `version` being the
+// filter set version currently loaded in `filter`.
+func NewFilterSetWatcher(fileURL string, interval time.Duration, filter *BlockFilter, version string) *FilterSetWatcher {
+	return &FilterSetWatcher{
+		Shutter:  shutter.New(),
+		fileURL:  fileURL,
+		interval: interval,
+		filter:   filter,
+		version:  version,
+	}
+}
+
+func (w *FilterSetWatcher) Launch() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.Terminating():
+			return
+		case <-ticker.C:
+			if err := w.reload(); err != nil {
+				zlog.Warn("unable to reload filter set, keeping current one", zap.String("file_url", w.fileURL), zap.String("version", w.version), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (w *FilterSetWatcher) reload() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	set, err := LoadFilterSet(ctx, w.fileURL)
+	if err != nil {
+		return err
+	}
+
+	if set.Version == w.version {
+		return nil
+	}
+
+	newFilter, err := NewBlockFilterFromSet(set)
+	if err != nil {
+		return fmt.Errorf("filter set version %q: %w", set.Version, err)
+	}
+
+	w.filter.Reload(newFilter)
+	zlog.Info("reloaded filter set", zap.String("previous_version", w.version), zap.String("version", set.Version), zap.Stringer("block_filter", w.filter))
+	w.version = set.Version
+
+	return nil
+}
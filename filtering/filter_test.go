@@ -135,6 +135,7 @@ func TestBlockFilter(t *testing.T) {
 				test.trace,
 				test.trace.ActionTraces[0],
 				func() []string { return nil },
+				func() []string { return nil },
 				filter.IncludeProgram.choose(0),
 				filter.ExcludeProgram.choose(0),
 				filter.SystemActionsIncludeProgram.choose(0),
@@ -167,3 +168,47 @@ type filters struct {
 	exclude []string
 	system  []string
 }
+
+func TestBlockFilter_Evaluate(t *testing.T) {
+	filter, err := NewBlockFilter(
+		[]string{"", "#3;receiver == 'eosio.token'"},
+		[]string{"data.to == 'badguy'"},
+		[]string{"action == 'setabi'"},
+	)
+	require.NoError(t, err)
+
+	newBlock := func(id string) *pbcodec.Block {
+		return ct.Block(t, id,
+			ct.TrxTrace(t,
+				ct.ActionTrace(t, "eosio.token:transfer", ct.ActionData(`{"to":"goodguy"}`)),
+				ct.ActionTrace(t, "eosio.token:transfer", ct.ActionData(`{"to":"badguy"}`)),
+				ct.ActionTrace(t, "eosio:setabi", ct.ActionData(`{"to":"badguy"}`)),
+				ct.ActionTrace(t, "other:action"),
+			),
+		)
+	}
+
+	evaluate := func(block *pbcodec.Block) (out []string) {
+		filter.Evaluate(block, func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, decision ActionDecision) {
+			out = append(out, actTrace.SimpleName()+" "+decision.String())
+		})
+		return
+	}
+
+	assert.Equal(t, []string{
+		"eosio.token:transfer kept",
+		"eosio.token:transfer dropped",
+		"eosio:setabi system-forced",
+		"other:action kept",
+	}, evaluate(newBlock("00000002aa")))
+
+	block := newBlock("00000003aa")
+	assert.Equal(t, []string{
+		"eosio.token:transfer kept",
+		"eosio.token:transfer dropped",
+		"eosio:setabi system-forced",
+		"other:action dropped",
+	}, evaluate(block))
+
+	assert.False(t, block.FilteringApplied, "evaluate must not modify the block")
+}
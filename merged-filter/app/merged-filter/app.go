@@ -1,6 +1,7 @@
 package merged_filter
 
 import (
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -33,6 +34,7 @@ type Config struct {
 	IncludeFilterExpr              []string
 	ExcludeFilterExpr              []string
 	SystemActionsIncludeFilterExpr []string
+	ChainID                        string // Chain ID in hex, used to recover the `trx_signing_keys` of the filter expressions
 }
 
 func New(config *Config) *App {
@@ -62,6 +64,11 @@ func (a *App) Run() error {
 		return err
 	}
 
+	blockFilter.ChainID, err = hex.DecodeString(a.config.ChainID)
+	if err != nil {
+		return fmt.Errorf("decoding chain_id from command line argument: %w", err)
+	}
+
 	var filter *mergedFilter.MergedFilter
 	if a.config.BatchMode {
 		filter = mergedFilter.NewBatchMergedFilter(blockFilter, srcBlocksStore, destBlocksStore, a.config.BatchStartBlock, a.config.BatchStopBlock)
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	v1 "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,13 +26,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ActionStep int32
+
+const (
+	ActionStep_ACTION_STEP_NEW          ActionStep = 0
+	ActionStep_ACTION_STEP_UNDO         ActionStep = 1
+	ActionStep_ACTION_STEP_REDO         ActionStep = 2
+	ActionStep_ACTION_STEP_IRREVERSIBLE ActionStep = 3
+)
+
+var ActionStep_name = map[int32]string{
+	0: "ACTION_STEP_NEW",
+	1: "ACTION_STEP_UNDO",
+	2: "ACTION_STEP_REDO",
+	3: "ACTION_STEP_IRREVERSIBLE",
+}
+
+var ActionStep_value = map[string]int32{
+	"ACTION_STEP_NEW":          0,
+	"ACTION_STEP_UNDO":         1,
+	"ACTION_STEP_REDO":         2,
+	"ACTION_STEP_IRREVERSIBLE": 3,
+}
+
+func (x ActionStep) String() string {
+	return proto.EnumName(ActionStep_name, int32(x))
+}
+
+func (ActionStep) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c22ddb60199ece6, []int{0}
+}
+
 type GetActionsRequest struct {
-	Account              uint64   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Cursor               *Cursor  `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Account uint64  `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Limit   uint32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor  *Cursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Returns actions from the oldest to the most recent one instead of the default most recent first
+	Ascending bool `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	// Inclusive block num boundaries, 0 means unbounded
+	LowBlockNum  uint64 `protobuf:"varint,5,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum uint64 `protobuf:"varint,6,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	// Inclusive block time boundaries, resolved to block nums through blockmeta
+	LowBlockTime         *timestamp.Timestamp `protobuf:"bytes,7,opt,name=low_block_time,json=lowBlockTime,proto3" json:"low_block_time,omitempty"`
+	HighBlockTime        *timestamp.Timestamp `protobuf:"bytes,8,opt,name=high_block_time,json=highBlockTime,proto3" json:"high_block_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetActionsRequest) Reset()         { *m = GetActionsRequest{} }
@@ -80,14 +120,57 @@ func (m *GetActionsRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (m *GetActionsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+func (m *GetActionsRequest) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *GetActionsRequest) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *GetActionsRequest) GetLowBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LowBlockTime
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetHighBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.HighBlockTime
+	}
+	return nil
+}
+
 type GetTokenActionsRequest struct {
-	Account              uint64   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	Contract             uint64   `protobuf:"varint,2,opt,name=contract,proto3" json:"contract,omitempty"`
-	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Cursor               *Cursor  `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Account  uint64  `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Contract uint64  `protobuf:"varint,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Limit    uint32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor   *Cursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Returns actions from the oldest to the most recent one instead of the default most recent first
+	Ascending bool `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	// Inclusive block num boundaries, 0 means unbounded
+	LowBlockNum  uint64 `protobuf:"varint,6,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum uint64 `protobuf:"varint,7,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	// Inclusive block time boundaries, resolved to block nums through blockmeta
+	LowBlockTime         *timestamp.Timestamp `protobuf:"bytes,8,opt,name=low_block_time,json=lowBlockTime,proto3" json:"low_block_time,omitempty"`
+	HighBlockTime        *timestamp.Timestamp `protobuf:"bytes,9,opt,name=high_block_time,json=highBlockTime,proto3" json:"high_block_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetTokenActionsRequest) Reset()         { *m = GetTokenActionsRequest{} }
@@ -143,6 +226,146 @@ func (m *GetTokenActionsRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (m *GetTokenActionsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+func (m *GetTokenActionsRequest) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *GetTokenActionsRequest) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *GetTokenActionsRequest) GetLowBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LowBlockTime
+	}
+	return nil
+}
+
+func (m *GetTokenActionsRequest) GetHighBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.HighBlockTime
+	}
+	return nil
+}
+
+type StreamAccountActionsRequest struct {
+	Account uint64 `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Cursor of the last action received, the stored history after it is replayed before
+	// switching to live actions. When absent, the whole stored history is replayed.
+	Cursor               *Cursor  `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamAccountActionsRequest) Reset()         { *m = StreamAccountActionsRequest{} }
+func (m *StreamAccountActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamAccountActionsRequest) ProtoMessage()    {}
+func (*StreamAccountActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c22ddb60199ece6, []int{2}
+}
+
+func (m *StreamAccountActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamAccountActionsRequest.Unmarshal(m, b)
+}
+func (m *StreamAccountActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamAccountActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamAccountActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAccountActionsRequest.Merge(m, src)
+}
+func (m *StreamAccountActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamAccountActionsRequest.Size(m)
+}
+func (m *StreamAccountActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAccountActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamAccountActionsRequest proto.InternalMessageInfo
+
+func (m *StreamAccountActionsRequest) GetAccount() uint64 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *StreamAccountActionsRequest) GetCursor() *Cursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type StreamActionResponse struct {
+	Step ActionStep `protobuf:"varint,1,opt,name=step,proto3,enum=dfuse.eosio.accounthist.v1.ActionStep" json:"step,omitempty"`
+	// Only set on irreversible actions, pass it back to resume the stream
+	Cursor               *Cursor         `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	ActionTrace          *v1.ActionTrace `protobuf:"bytes,3,opt,name=action_trace,json=actionTrace,proto3" json:"action_trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StreamActionResponse) Reset()         { *m = StreamActionResponse{} }
+func (m *StreamActionResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamActionResponse) ProtoMessage()    {}
+func (*StreamActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c22ddb60199ece6, []int{3}
+}
+
+func (m *StreamActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamActionResponse.Unmarshal(m, b)
+}
+func (m *StreamActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamActionResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamActionResponse.Merge(m, src)
+}
+func (m *StreamActionResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamActionResponse.Size(m)
+}
+func (m *StreamActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamActionResponse proto.InternalMessageInfo
+
+func (m *StreamActionResponse) GetStep() ActionStep {
+	if m != nil {
+		return m.Step
+	}
+	return ActionStep_ACTION_STEP_NEW
+}
+
+func (m *StreamActionResponse) GetCursor() *Cursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *StreamActionResponse) GetActionTrace() *v1.ActionTrace {
+	if m != nil {
+		return m.ActionTrace
+	}
+	return nil
+}
+
 type ActionResponse struct {
 	Cursor               *Cursor         `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	ActionTrace          *v1.ActionTrace `protobuf:"bytes,2,opt,name=action_trace,json=actionTrace,proto3" json:"action_trace,omitempty"`
@@ -155,7 +378,7 @@ func (m *ActionResponse) Reset()         { *m = ActionResponse{} }
 func (m *ActionResponse) String() string { return proto.CompactTextString(m) }
 func (*ActionResponse) ProtoMessage()    {}
 func (*ActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c22ddb60199ece6, []int{2}
+	return fileDescriptor_4c22ddb60199ece6, []int{4}
 }
 
 func (m *ActionResponse) XXX_Unmarshal(b []byte) error {
@@ -203,7 +426,7 @@ func (m *ActionRow) Reset()         { *m = ActionRow{} }
 func (m *ActionRow) String() string { return proto.CompactTextString(m) }
 func (*ActionRow) ProtoMessage()    {}
 func (*ActionRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c22ddb60199ece6, []int{3}
+	return fileDescriptor_4c22ddb60199ece6, []int{5}
 }
 
 func (m *ActionRow) XXX_Unmarshal(b []byte) error {
@@ -259,7 +482,7 @@ func (m *ShardCheckpoint) Reset()         { *m = ShardCheckpoint{} }
 func (m *ShardCheckpoint) String() string { return proto.CompactTextString(m) }
 func (*ShardCheckpoint) ProtoMessage()    {}
 func (*ShardCheckpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c22ddb60199ece6, []int{4}
+	return fileDescriptor_4c22ddb60199ece6, []int{6}
 }
 
 func (m *ShardCheckpoint) XXX_Unmarshal(b []byte) error {
@@ -319,7 +542,7 @@ func (m *ActionRowAppend) Reset()         { *m = ActionRowAppend{} }
 func (m *ActionRowAppend) String() string { return proto.CompactTextString(m) }
 func (*ActionRowAppend) ProtoMessage()    {}
 func (*ActionRowAppend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c22ddb60199ece6, []int{5}
+	return fileDescriptor_4c22ddb60199ece6, []int{7}
 }
 
 func (m *ActionRowAppend) XXX_Unmarshal(b []byte) error {
@@ -362,7 +585,7 @@ func (m *Cursor) Reset()         { *m = Cursor{} }
 func (m *Cursor) String() string { return proto.CompactTextString(m) }
 func (*Cursor) ProtoMessage()    {}
 func (*Cursor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c22ddb60199ece6, []int{6}
+	return fileDescriptor_4c22ddb60199ece6, []int{8}
 }
 
 func (m *Cursor) XXX_Unmarshal(b []byte) error {
@@ -419,8 +642,11 @@ func (m *Cursor) GetSequenceNumber() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("dfuse.eosio.accounthist.v1.ActionStep", ActionStep_name, ActionStep_value)
 	proto.RegisterType((*GetActionsRequest)(nil), "dfuse.eosio.accounthist.v1.GetActionsRequest")
 	proto.RegisterType((*GetTokenActionsRequest)(nil), "dfuse.eosio.accounthist.v1.GetTokenActionsRequest")
+	proto.RegisterType((*StreamAccountActionsRequest)(nil), "dfuse.eosio.accounthist.v1.StreamAccountActionsRequest")
+	proto.RegisterType((*StreamActionResponse)(nil), "dfuse.eosio.accounthist.v1.StreamActionResponse")
 	proto.RegisterType((*ActionResponse)(nil), "dfuse.eosio.accounthist.v1.ActionResponse")
 	proto.RegisterType((*ActionRow)(nil), "dfuse.eosio.accounthist.v1.ActionRow")
 	proto.RegisterType((*ShardCheckpoint)(nil), "dfuse.eosio.accounthist.v1.ShardCheckpoint")
@@ -428,50 +654,65 @@ func init() {
 	proto.RegisterType((*Cursor)(nil), "dfuse.eosio.accounthist.v1.Cursor")
 }
 
-func init() {
-	proto.RegisterFile("dfuse/eosio/accounthist/v1/accounthist.proto", fileDescriptor_4c22ddb60199ece6)
-}
+func init() { proto.RegisterFile("dfuse/eosio/accounthist/v1/accounthist.proto", fileDescriptor_4c22ddb60199ece6) }
 
 var fileDescriptor_4c22ddb60199ece6 = []byte{
-	// 604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0x95, 0x69, 0x1a, 0xda, 0xe9, 0x47, 0x5a, 0xb7, 0x54, 0x21, 0x5c, 0xc2, 0x5e, 0x88, 0x2a,
-	0xba, 0x21, 0xe9, 0x8d, 0x9e, 0xfa, 0x21, 0x15, 0x84, 0xe8, 0x61, 0x53, 0x09, 0x89, 0xcb, 0x6a,
-	0xd7, 0x3b, 0x34, 0x56, 0x12, 0x7b, 0xbb, 0xf6, 0xa6, 0xaa, 0x10, 0xe2, 0x2f, 0x20, 0x24, 0xc4,
-	0x95, 0x3f, 0xc5, 0xff, 0x41, 0xb6, 0x77, 0xdb, 0x14, 0xd2, 0x40, 0x24, 0x6e, 0x9e, 0x79, 0x6f,
-	0x9e, 0x9f, 0xed, 0xf1, 0xc0, 0xf3, 0xe4, 0x43, 0xae, 0xb0, 0x8d, 0x52, 0x71, 0xd9, 0x8e, 0x18,
-	0x93, 0xb9, 0xd0, 0x7d, 0xae, 0x74, 0x7b, 0xdc, 0x99, 0x0c, 0xfd, 0x34, 0x93, 0x5a, 0xd2, 0x86,
-	0x65, 0xfb, 0x96, 0xed, 0x4f, 0xc2, 0xe3, 0x4e, 0xa3, 0x39, 0xa9, 0xc4, 0x64, 0x82, 0xcc, 0x68,
-	0xd8, 0x85, 0xab, 0xf6, 0x3e, 0xc3, 0xe6, 0x29, 0xea, 0x43, 0xa6, 0xb9, 0x14, 0x2a, 0xc0, 0xcb,
-	0x1c, 0x95, 0xa6, 0x75, 0x78, 0x58, 0x08, 0xd5, 0x49, 0x93, 0xb4, 0x2a, 0x41, 0x19, 0xd2, 0x6d,
-	0x58, 0x1c, 0xf2, 0x11, 0xd7, 0xf5, 0x07, 0x4d, 0xd2, 0x5a, 0x0b, 0x5c, 0x40, 0x5f, 0x42, 0x95,
-	0xe5, 0x99, 0x92, 0x59, 0x7d, 0xa1, 0x49, 0x5a, 0x2b, 0x5d, 0xcf, 0xbf, 0xdf, 0x93, 0x7f, 0x6c,
-	0x99, 0x41, 0x51, 0xe1, 0xfd, 0x20, 0xb0, 0x73, 0x8a, 0xfa, 0x5c, 0x0e, 0x50, 0xfc, 0xb3, 0x8d,
-	0x06, 0x2c, 0x31, 0x29, 0x74, 0x16, 0x31, 0xe7, 0xa4, 0x12, 0xdc, 0xc4, 0xb7, 0x16, 0x17, 0xa6,
-	0x5b, 0xac, 0xcc, 0x6d, 0xf1, 0x2b, 0x81, 0x75, 0x67, 0x2d, 0x40, 0x95, 0x4a, 0xa1, 0x70, 0x42,
-	0x8e, 0xcc, 0x2b, 0x47, 0x4f, 0x60, 0x35, 0xb2, 0x6a, 0xa1, 0x31, 0x8c, 0xf6, 0x00, 0x2b, 0xdd,
-	0xa7, 0x77, 0x14, 0xdc, 0x13, 0x8d, 0x3b, 0xbe, 0xdb, 0xf7, 0xdc, 0x10, 0x83, 0x95, 0xe8, 0x36,
-	0xf0, 0xbe, 0x11, 0x58, 0x2e, 0x4c, 0xc9, 0x2b, 0x73, 0x55, 0x63, 0xcc, 0x14, 0x97, 0xc2, 0x1a,
-	0x5a, 0x0b, 0xca, 0xf0, 0xff, 0xec, 0x46, 0x5b, 0xb0, 0x31, 0x8c, 0x94, 0x0e, 0x13, 0x1c, 0xa2,
-	0xc6, 0x24, 0x54, 0x78, 0x69, 0xef, 0xb7, 0x12, 0xac, 0x9b, 0xfc, 0x89, 0x4b, 0xf7, 0xf0, 0xd2,
-	0xfb, 0x49, 0xa0, 0xd6, 0xeb, 0x47, 0x59, 0x72, 0xdc, 0x47, 0x36, 0x48, 0x25, 0x17, 0x9a, 0xfa,
-	0xb0, 0xc5, 0x05, 0xd7, 0x3c, 0x1a, 0x86, 0x4a, 0x47, 0x99, 0x0e, 0xe3, 0xa1, 0x64, 0x83, 0xe2,
-	0x51, 0x37, 0x0b, 0xa8, 0x67, 0x90, 0x23, 0x03, 0xd0, 0x5d, 0xd8, 0xd4, 0x51, 0x76, 0x81, 0x3a,
-	0x54, 0x5a, 0xa6, 0x05, 0xdb, 0xbd, 0x73, 0xcd, 0x01, 0x3d, 0x2d, 0x53, 0xc7, 0xdd, 0x87, 0x1d,
-	0xeb, 0xec, 0x2a, 0xe3, 0x5a, 0xa3, 0x70, 0xe4, 0x50, 0xe4, 0xa3, 0xc2, 0xdf, 0x96, 0x41, 0xdf,
-	0x39, 0xd0, 0x56, 0x9c, 0xe5, 0x23, 0xda, 0x81, 0x47, 0x53, 0x8a, 0x78, 0x62, 0x9b, 0x63, 0x39,
-	0xa0, 0xbf, 0xd7, 0xbc, 0x4e, 0xbc, 0x03, 0xa8, 0xdd, 0x5c, 0xf7, 0x61, 0x9a, 0xa2, 0x48, 0xe6,
-	0xb8, 0x94, 0x2f, 0x04, 0xaa, 0xae, 0x0b, 0x66, 0xbc, 0xd4, 0x36, 0x2c, 0x8e, 0xa2, 0x0b, 0xce,
-	0xca, 0xbf, 0x65, 0x03, 0xba, 0x01, 0x0b, 0x03, 0xbc, 0xb6, 0xba, 0xab, 0x81, 0x59, 0xd2, 0x27,
-	0xb0, 0xac, 0xcc, 0x05, 0xdb, 0x43, 0x2e, 0x5a, 0xee, 0x92, 0x4d, 0x98, 0x93, 0x3d, 0x83, 0x9a,
-	0x32, 0xdf, 0x47, 0x30, 0x34, 0x78, 0x8c, 0x59, 0xbd, 0xea, 0x2c, 0x95, 0xe9, 0x33, 0x9b, 0xed,
-	0x7e, 0x34, 0x3d, 0x6d, 0xdb, 0xf4, 0x15, 0x57, 0x5a, 0x66, 0xd7, 0x94, 0x03, 0xdc, 0x8e, 0x02,
-	0xba, 0x37, 0xab, 0xa3, 0xff, 0x18, 0x19, 0x8d, 0xdd, 0x59, 0xf4, 0xbb, 0x9f, 0xe7, 0x05, 0xe9,
-	0x7e, 0x27, 0xb0, 0x53, 0xec, 0x7e, 0x5c, 0xfc, 0xdb, 0xd2, 0xc5, 0x27, 0x78, 0x6c, 0xd5, 0xef,
-	0x80, 0xa5, 0xa9, 0xee, 0x5f, 0x4c, 0x4d, 0x99, 0x22, 0xf3, 0x39, 0x3b, 0x7a, 0xfb, 0xfe, 0xcd,
-	0x05, 0xd7, 0xfd, 0x3c, 0xf6, 0x99, 0x1c, 0xb5, 0x6d, 0xe5, 0x1e, 0x97, 0xc5, 0xc2, 0xcd, 0xd1,
-	0x34, 0x6e, 0xdf, 0x3f, 0xa0, 0x0f, 0xd2, 0x78, 0x22, 0x11, 0x57, 0xed, 0x94, 0xdd, 0xff, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x43, 0xa1, 0x53, 0xf9, 0xd3, 0x05, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x49, 0x9a, 0x26, 0xa7, 0xcd, 0x4f, 0xa7, 0xa5, 0x0a, 0xd9, 0x95, 0x08, 0x11, 0x82,
+	0xa8, 0x62, 0x9d, 0x6d, 0xf6, 0x02, 0x69, 0xf7, 0x86, 0xfe, 0x44, 0x4b, 0x05, 0xa4, 0x68, 0x1c,
+	0x58, 0x89, 0x1b, 0xcb, 0xb1, 0x67, 0x93, 0x51, 0x6c, 0x8f, 0xeb, 0x19, 0xb7, 0xda, 0x0b, 0x2e,
+	0x10, 0xf7, 0x08, 0x21, 0x21, 0x5e, 0x0c, 0x1e, 0x83, 0x77, 0x40, 0x33, 0x63, 0x27, 0xee, 0x6e,
+	0x9b, 0xa6, 0x74, 0xef, 0x7c, 0xfe, 0xbf, 0xf9, 0xce, 0x9c, 0x33, 0x86, 0x2f, 0xbc, 0xd7, 0x09,
+	0x27, 0x7d, 0xc2, 0x38, 0x65, 0x7d, 0xc7, 0x75, 0x59, 0x12, 0x8a, 0x19, 0xe5, 0xa2, 0x7f, 0x79,
+	0x98, 0x17, 0xcd, 0x28, 0x66, 0x82, 0xa1, 0xb6, 0xf2, 0x36, 0x95, 0xb7, 0x99, 0x37, 0x5f, 0x1e,
+	0xb6, 0x3b, 0xf9, 0x4c, 0x2e, 0xf3, 0x88, 0x2b, 0x73, 0xa8, 0x0f, 0x1d, 0xdd, 0xfe, 0x78, 0xca,
+	0xd8, 0xd4, 0x27, 0x7d, 0x25, 0x4d, 0x92, 0xd7, 0x7d, 0x41, 0x03, 0xc2, 0x85, 0x13, 0x44, 0xda,
+	0xa1, 0xfb, 0x6f, 0x01, 0x76, 0x5e, 0x12, 0x71, 0xe4, 0x0a, 0xca, 0x42, 0x8e, 0xc9, 0x45, 0x42,
+	0xb8, 0x40, 0x2d, 0xd8, 0x4c, 0x4b, 0xb5, 0x8c, 0x8e, 0xd1, 0x2b, 0xe1, 0x4c, 0x44, 0x7b, 0xb0,
+	0xe1, 0xd3, 0x80, 0x8a, 0x56, 0xa1, 0x63, 0xf4, 0x6a, 0x58, 0x0b, 0xe8, 0x39, 0x94, 0xdd, 0x24,
+	0xe6, 0x2c, 0x6e, 0x15, 0x3b, 0x46, 0x6f, 0x6b, 0xd0, 0x35, 0x6f, 0x47, 0x6d, 0x9e, 0x28, 0x4f,
+	0x9c, 0x46, 0xa0, 0xc7, 0x50, 0x75, 0xb8, 0x4b, 0x42, 0x8f, 0x86, 0xd3, 0x56, 0xa9, 0x63, 0xf4,
+	0x2a, 0x78, 0xa9, 0x40, 0x5d, 0xa8, 0xf9, 0xec, 0xca, 0x9e, 0xf8, 0xcc, 0x9d, 0xdb, 0x61, 0x12,
+	0xb4, 0x36, 0x14, 0x9e, 0x2d, 0x9f, 0x5d, 0x1d, 0x4b, 0xdd, 0x28, 0x09, 0xd0, 0xa7, 0x50, 0x9f,
+	0xd1, 0xe9, 0x2c, 0xe7, 0x54, 0x56, 0x4e, 0xdb, 0x52, 0xbb, 0xf0, 0xfa, 0x0a, 0xea, 0xcb, 0x4c,
+	0x92, 0x86, 0xd6, 0xa6, 0xc2, 0xda, 0x36, 0x35, 0x47, 0x66, 0xc6, 0x91, 0x39, 0xce, 0x38, 0xc2,
+	0xdb, 0x59, 0x19, 0xa9, 0x42, 0xc7, 0xd0, 0xc8, 0xd5, 0x51, 0x29, 0x2a, 0x77, 0xa6, 0xa8, 0x2d,
+	0x40, 0x48, 0x5d, 0xf7, 0xb7, 0x22, 0xec, 0xbf, 0x24, 0x62, 0xcc, 0xe6, 0x24, 0x5c, 0x9b, 0xf4,
+	0x36, 0x54, 0x5c, 0x16, 0x8a, 0xd8, 0x71, 0x35, 0xef, 0x25, 0xbc, 0x90, 0x97, 0x0d, 0x29, 0xde,
+	0xdc, 0x90, 0xd2, 0xc3, 0x1a, 0xb2, 0x71, 0x67, 0x43, 0xca, 0xeb, 0x34, 0x64, 0x73, 0xad, 0x86,
+	0x54, 0x1e, 0xde, 0x90, 0xea, 0x7d, 0x1b, 0xc2, 0xe1, 0x91, 0x25, 0x62, 0xe2, 0x04, 0x47, 0x9a,
+	0x94, 0xb5, 0x9b, 0xb2, 0xa4, 0xb8, 0x70, 0x5f, 0x8a, 0xbb, 0x7f, 0x1b, 0xb0, 0x97, 0x55, 0x95,
+	0xe5, 0x30, 0xe1, 0x11, 0x0b, 0x39, 0x41, 0xcf, 0xa1, 0xc4, 0x05, 0x89, 0x54, 0xad, 0xfa, 0xe0,
+	0xb3, 0x55, 0x29, 0x75, 0xa4, 0x25, 0x48, 0x84, 0x55, 0xcc, 0x43, 0x00, 0xa1, 0x53, 0xd8, 0x76,
+	0x54, 0x3e, 0x5b, 0xde, 0x2a, 0x92, 0x8e, 0xf1, 0x27, 0xd7, 0x32, 0xe8, 0xbd, 0xb2, 0xa8, 0x3c,
+	0x96, 0x8e, 0x78, 0xcb, 0x59, 0x0a, 0xdd, 0x3f, 0x0c, 0xa8, 0xbf, 0x73, 0xa0, 0x0c, 0x94, 0xf1,
+	0x60, 0x50, 0x85, 0xff, 0x05, 0xea, 0x4f, 0x03, 0xaa, 0x29, 0x28, 0x76, 0x25, 0xfb, 0x79, 0x49,
+	0x62, 0x4e, 0x59, 0xa8, 0x00, 0xd5, 0x70, 0x26, 0xbe, 0x9f, 0x6a, 0xa8, 0x07, 0x4d, 0xdf, 0xe1,
+	0xc2, 0xf6, 0x88, 0x4f, 0x04, 0xf1, 0x6c, 0x4e, 0x2e, 0x14, 0x99, 0x25, 0x5c, 0x97, 0xfa, 0x53,
+	0xad, 0xb6, 0xc8, 0x45, 0xf7, 0x1f, 0x03, 0x1a, 0xd6, 0xcc, 0x89, 0xbd, 0x93, 0x19, 0x71, 0xe7,
+	0x11, 0xa3, 0xa1, 0x40, 0x26, 0xec, 0xd2, 0x90, 0x0a, 0xea, 0xf8, 0x36, 0x17, 0x4e, 0x2c, 0xf4,
+	0xcd, 0x4e, 0x6f, 0xde, 0x4e, 0x6a, 0xb2, 0xa4, 0x45, 0x5d, 0x60, 0x74, 0x00, 0x3b, 0xc2, 0x89,
+	0xa7, 0x44, 0xd8, 0x5c, 0xb0, 0x28, 0xf5, 0xd6, 0x1b, 0xa2, 0xa1, 0x0d, 0x96, 0x60, 0x91, 0xf6,
+	0x7d, 0x06, 0xfb, 0x0a, 0xd9, 0x55, 0x4c, 0x85, 0x20, 0x61, 0x6e, 0x38, 0x35, 0xbe, 0x5d, 0x69,
+	0x7d, 0xa5, 0x8d, 0x8b, 0x19, 0x3d, 0x84, 0x0f, 0x6f, 0x08, 0xa2, 0x9e, 0x5a, 0x2b, 0x55, 0x8c,
+	0xde, 0x8e, 0x39, 0xf3, 0xba, 0x2f, 0xa0, 0xb1, 0xa0, 0xfb, 0x28, 0x8a, 0x48, 0xe8, 0xdd, 0x83,
+	0x94, 0xdf, 0x0d, 0x28, 0xeb, 0x5b, 0xb0, 0xa2, 0x53, 0x7b, 0xb0, 0x11, 0x38, 0x53, 0xea, 0x66,
+	0x6f, 0x90, 0x12, 0x50, 0x13, 0x8a, 0x73, 0xf2, 0x46, 0xe5, 0xdd, 0xc6, 0xf2, 0x13, 0x3d, 0x82,
+	0x2a, 0x97, 0x04, 0x2f, 0xde, 0x8d, 0x1a, 0xae, 0x28, 0x85, 0x3c, 0xd9, 0xe7, 0xd0, 0xe0, 0x72,
+	0xc6, 0x43, 0x97, 0x48, 0xfb, 0x84, 0xc4, 0xe9, 0x26, 0xab, 0x67, 0xea, 0x91, 0xd2, 0x1e, 0xcc,
+	0x01, 0x96, 0xa3, 0x86, 0x76, 0xa1, 0x71, 0x74, 0x32, 0x3e, 0x3b, 0x1f, 0xd9, 0xd6, 0x78, 0xf8,
+	0xbd, 0x3d, 0x1a, 0xbe, 0x6a, 0x7e, 0x80, 0xf6, 0xa0, 0x99, 0x57, 0xfe, 0x30, 0x3a, 0x3d, 0x6f,
+	0x1a, 0x6f, 0x6b, 0xf1, 0xf0, 0xf4, 0xbc, 0x59, 0x40, 0x8f, 0xa1, 0x95, 0xd7, 0x9e, 0x61, 0x3c,
+	0xfc, 0x71, 0x88, 0xad, 0xb3, 0xe3, 0x6f, 0x87, 0xcd, 0xe2, 0xe0, 0x97, 0x82, 0x9c, 0x20, 0x35,
+	0x14, 0x5f, 0x53, 0x2e, 0x58, 0xfc, 0x06, 0x51, 0x80, 0xe5, 0x03, 0x8d, 0x9e, 0xac, 0x9a, 0x9f,
+	0x77, 0x1e, 0xf2, 0xf6, 0xc1, 0xdd, 0x1b, 0x24, 0x1b, 0xd5, 0xa7, 0x06, 0xfa, 0x35, 0xb7, 0x96,
+	0xf2, 0xcb, 0x10, 0x7d, 0xb9, 0x2a, 0xcd, 0x8a, 0xf5, 0xd9, 0x7e, 0xba, 0x4e, 0xe0, 0x75, 0x14,
+	0x83, 0xbf, 0x0c, 0xd8, 0x4f, 0xb3, 0x9d, 0xa4, 0xaf, 0x5c, 0xc6, 0xc5, 0xcf, 0xf0, 0x91, 0x3a,
+	0xe3, 0x35, 0x63, 0x06, 0x72, 0x70, 0x07, 0x35, 0x37, 0xbc, 0xb9, 0xf7, 0xe3, 0xe7, 0xf8, 0xbb,
+	0x9f, 0xbe, 0x99, 0x52, 0x31, 0x4b, 0x26, 0xa6, 0xcb, 0x82, 0xbe, 0x8a, 0x7c, 0x42, 0x59, 0xfa,
+	0xa1, 0xff, 0xc2, 0xa2, 0x49, 0xff, 0xf6, 0xdf, 0xbb, 0x17, 0xd1, 0x24, 0xa7, 0x98, 0x94, 0xd5,
+	0xeb, 0xf4, 0xec, 0xbf, 0x01, 0x00, 0xdf, 0x8f, 0x68, 0x09, 0x11, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -487,6 +728,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AccountHistoryClient interface {
 	GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (AccountHistory_GetActionsClient, error)
+	StreamAccountActions(ctx context.Context, in *StreamAccountActionsRequest, opts ...grpc.CallOption) (AccountHistory_StreamAccountActionsClient, error)
 }
 
 type accountHistoryClient struct {
@@ -529,9 +771,42 @@ func (x *accountHistoryGetActionsClient) Recv() (*ActionResponse, error) {
 	return m, nil
 }
 
+func (c *accountHistoryClient) StreamAccountActions(ctx context.Context, in *StreamAccountActionsRequest, opts ...grpc.CallOption) (AccountHistory_StreamAccountActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AccountHistory_serviceDesc.Streams[1], "/dfuse.eosio.accounthist.v1.AccountHistory/StreamAccountActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountHistoryStreamAccountActionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountHistory_StreamAccountActionsClient interface {
+	Recv() (*StreamActionResponse, error)
+	grpc.ClientStream
+}
+
+type accountHistoryStreamAccountActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountHistoryStreamAccountActionsClient) Recv() (*StreamActionResponse, error) {
+	m := new(StreamActionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AccountHistoryServer is the server API for AccountHistory service.
 type AccountHistoryServer interface {
 	GetActions(*GetActionsRequest, AccountHistory_GetActionsServer) error
+	StreamAccountActions(*StreamAccountActionsRequest, AccountHistory_StreamAccountActionsServer) error
 }
 
 // UnimplementedAccountHistoryServer can be embedded to have forward compatible implementations.
@@ -541,6 +816,9 @@ type UnimplementedAccountHistoryServer struct {
 func (*UnimplementedAccountHistoryServer) GetActions(req *GetActionsRequest, srv AccountHistory_GetActionsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetActions not implemented")
 }
+func (*UnimplementedAccountHistoryServer) StreamAccountActions(req *StreamAccountActionsRequest, srv AccountHistory_StreamAccountActionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccountActions not implemented")
+}
 
 func RegisterAccountHistoryServer(s *grpc.Server, srv AccountHistoryServer) {
 	s.RegisterService(&_AccountHistory_serviceDesc, srv)
@@ -567,6 +845,27 @@ func (x *accountHistoryGetActionsServer) Send(m *ActionResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AccountHistory_StreamAccountActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAccountActionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountHistoryServer).StreamAccountActions(m, &accountHistoryStreamAccountActionsServer{stream})
+}
+
+type AccountHistory_StreamAccountActionsServer interface {
+	Send(*StreamActionResponse) error
+	grpc.ServerStream
+}
+
+type accountHistoryStreamAccountActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountHistoryStreamAccountActionsServer) Send(m *StreamActionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AccountHistory_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dfuse.eosio.accounthist.v1.AccountHistory",
 	HandlerType: (*AccountHistoryServer)(nil),
@@ -577,6 +876,11 @@ var _AccountHistory_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AccountHistory_GetActions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamAccountActions",
+			Handler:       _AccountHistory_StreamAccountActions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dfuse/eosio/accounthist/v1/accounthist.proto",
 }
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: dfuse/eosio/pushtrx/v1/pushtrx.proto
+
+package pbpushtrx
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Guarantee int32
+
+const (
+	Guarantee_GUARANTEE_IN_BLOCK     Guarantee = 0
+	Guarantee_GUARANTEE_HANDOFFS_1   Guarantee = 1
+	Guarantee_GUARANTEE_HANDOFFS_2   Guarantee = 2
+	Guarantee_GUARANTEE_HANDOFFS_3   Guarantee = 3
+	Guarantee_GUARANTEE_IRREVERSIBLE Guarantee = 4
+)
+
+var Guarantee_name = map[int32]string{
+	0: "GUARANTEE_IN_BLOCK",
+	1: "GUARANTEE_HANDOFFS_1",
+	2: "GUARANTEE_HANDOFFS_2",
+	3: "GUARANTEE_HANDOFFS_3",
+	4: "GUARANTEE_IRREVERSIBLE",
+}
+
+var Guarantee_value = map[string]int32{
+	"GUARANTEE_IN_BLOCK":     0,
+	"GUARANTEE_HANDOFFS_1":   1,
+	"GUARANTEE_HANDOFFS_2":   2,
+	"GUARANTEE_HANDOFFS_3":   3,
+	"GUARANTEE_IRREVERSIBLE": 4,
+}
+
+func (x Guarantee) String() string {
+	return proto.EnumName(Guarantee_name, int32(x))
+}
+
+func (Guarantee) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{0}
+}
+
+type PushStage int32
+
+const (
+	PushStage_PUSH_STAGE_ACCEPTED     PushStage = 0
+	PushStage_PUSH_STAGE_IN_BLOCK     PushStage = 1
+	PushStage_PUSH_STAGE_HANDOFF      PushStage = 2
+	PushStage_PUSH_STAGE_FORKED       PushStage = 3
+	PushStage_PUSH_STAGE_IRREVERSIBLE PushStage = 4
+)
+
+var PushStage_name = map[int32]string{
+	0: "PUSH_STAGE_ACCEPTED",
+	1: "PUSH_STAGE_IN_BLOCK",
+	2: "PUSH_STAGE_HANDOFF",
+	3: "PUSH_STAGE_FORKED",
+	4: "PUSH_STAGE_IRREVERSIBLE",
+}
+
+var PushStage_value = map[string]int32{
+	"PUSH_STAGE_ACCEPTED":     0,
+	"PUSH_STAGE_IN_BLOCK":     1,
+	"PUSH_STAGE_HANDOFF":      2,
+	"PUSH_STAGE_FORKED":       3,
+	"PUSH_STAGE_IRREVERSIBLE": 4,
+}
+
+func (x PushStage) String() string {
+	return proto.EnumName(PushStage_name, int32(x))
+}
+
+func (PushStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{1}
+}
+
+type PushTransactionRequest struct {
+	// Packed transaction, same fields as the body of nodeos `/v1/chain/push_transaction`
+	Signatures []string `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// Either `none` (the default) or `zlib`
+	Compression           string    `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
+	PackedContextFreeData []byte    `protobuf:"bytes,3,opt,name=packed_context_free_data,json=packedContextFreeData,proto3" json:"packed_context_free_data,omitempty"`
+	PackedTrx             []byte    `protobuf:"bytes,4,opt,name=packed_trx,json=packedTrx,proto3" json:"packed_trx,omitempty"`
+	Guarantee             Guarantee `protobuf:"varint,5,opt,name=guarantee,proto3,enum=dfuse.eosio.pushtrx.v1.Guarantee" json:"guarantee,omitempty"`
+	// Pushes through nodeos `push_transaction` instead of `send_transaction`
+	UseLegacyPush        bool     `protobuf:"varint,6,opt,name=use_legacy_push,json=useLegacyPush,proto3" json:"use_legacy_push,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushTransactionRequest) Reset()         { *m = PushTransactionRequest{} }
+func (m *PushTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PushTransactionRequest) ProtoMessage()    {}
+func (*PushTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{0}
+}
+
+func (m *PushTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushTransactionRequest.Unmarshal(m, b)
+}
+func (m *PushTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PushTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushTransactionRequest.Merge(m, src)
+}
+func (m *PushTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PushTransactionRequest.Size(m)
+}
+func (m *PushTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushTransactionRequest proto.InternalMessageInfo
+
+func (m *PushTransactionRequest) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *PushTransactionRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *PushTransactionRequest) GetPackedContextFreeData() []byte {
+	if m != nil {
+		return m.PackedContextFreeData
+	}
+	return nil
+}
+
+func (m *PushTransactionRequest) GetPackedTrx() []byte {
+	if m != nil {
+		return m.PackedTrx
+	}
+	return nil
+}
+
+func (m *PushTransactionRequest) GetGuarantee() Guarantee {
+	if m != nil {
+		return m.Guarantee
+	}
+	return Guarantee_GUARANTEE_IN_BLOCK
+}
+
+func (m *PushTransactionRequest) GetUseLegacyPush() bool {
+	if m != nil {
+		return m.UseLegacyPush
+	}
+	return false
+}
+
+type PushTransactionResponse struct {
+	TransactionId        string               `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	BlockId              string               `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	BlockNum             uint64               `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Trace                *v1.TransactionTrace `protobuf:"bytes,4,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PushTransactionResponse) Reset()         { *m = PushTransactionResponse{} }
+func (m *PushTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*PushTransactionResponse) ProtoMessage()    {}
+func (*PushTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{1}
+}
+
+func (m *PushTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushTransactionResponse.Unmarshal(m, b)
+}
+func (m *PushTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *PushTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushTransactionResponse.Merge(m, src)
+}
+func (m *PushTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_PushTransactionResponse.Size(m)
+}
+func (m *PushTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushTransactionResponse proto.InternalMessageInfo
+
+func (m *PushTransactionResponse) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *PushTransactionResponse) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *PushTransactionResponse) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *PushTransactionResponse) GetTrace() *v1.TransactionTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+type PushTransactionStatusRequest struct {
+	// Packed transaction, same fields as in `PushTransactionRequest`
+	Signatures            []string `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Compression           string   `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
+	PackedContextFreeData []byte   `protobuf:"bytes,3,opt,name=packed_context_free_data,json=packedContextFreeData,proto3" json:"packed_context_free_data,omitempty"`
+	PackedTrx             []byte   `protobuf:"bytes,4,opt,name=packed_trx,json=packedTrx,proto3" json:"packed_trx,omitempty"`
+	UseLegacyPush         bool     `protobuf:"varint,5,opt,name=use_legacy_push,json=useLegacyPush,proto3" json:"use_legacy_push,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *PushTransactionStatusRequest) Reset()         { *m = PushTransactionStatusRequest{} }
+func (m *PushTransactionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*PushTransactionStatusRequest) ProtoMessage()    {}
+func (*PushTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{2}
+}
+
+func (m *PushTransactionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushTransactionStatusRequest.Unmarshal(m, b)
+}
+func (m *PushTransactionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushTransactionStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *PushTransactionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushTransactionStatusRequest.Merge(m, src)
+}
+func (m *PushTransactionStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_PushTransactionStatusRequest.Size(m)
+}
+func (m *PushTransactionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushTransactionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushTransactionStatusRequest proto.InternalMessageInfo
+
+func (m *PushTransactionStatusRequest) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *PushTransactionStatusRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *PushTransactionStatusRequest) GetPackedContextFreeData() []byte {
+	if m != nil {
+		return m.PackedContextFreeData
+	}
+	return nil
+}
+
+func (m *PushTransactionStatusRequest) GetPackedTrx() []byte {
+	if m != nil {
+		return m.PackedTrx
+	}
+	return nil
+}
+
+func (m *PushTransactionStatusRequest) GetUseLegacyPush() bool {
+	if m != nil {
+		return m.UseLegacyPush
+	}
+	return false
+}
+
+type PushTransactionStatusResponse struct {
+	Stage         PushStage `protobuf:"varint,1,opt,name=stage,proto3,enum=dfuse.eosio.pushtrx.v1.PushStage" json:"stage,omitempty"`
+	TransactionId string    `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Block holding the transaction, or the block that was forked out, unset on `PUSH_STAGE_ACCEPTED`
+	BlockId  string `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	BlockNum uint64 `protobuf:"varint,4,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// Only set on `PUSH_STAGE_HANDOFF`
+	Handoffs uint32 `protobuf:"varint,5,opt,name=handoffs,proto3" json:"handoffs,omitempty"`
+	// Only set on `PUSH_STAGE_IN_BLOCK` and `PUSH_STAGE_IRREVERSIBLE`
+	Trace                *v1.TransactionTrace `protobuf:"bytes,6,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PushTransactionStatusResponse) Reset()         { *m = PushTransactionStatusResponse{} }
+func (m *PushTransactionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*PushTransactionStatusResponse) ProtoMessage()    {}
+func (*PushTransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a692d3ef3a8a86, []int{3}
+}
+
+func (m *PushTransactionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushTransactionStatusResponse.Unmarshal(m, b)
+}
+func (m *PushTransactionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushTransactionStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *PushTransactionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushTransactionStatusResponse.Merge(m, src)
+}
+func (m *PushTransactionStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_PushTransactionStatusResponse.Size(m)
+}
+func (m *PushTransactionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushTransactionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushTransactionStatusResponse proto.InternalMessageInfo
+
+func (m *PushTransactionStatusResponse) GetStage() PushStage {
+	if m != nil {
+		return m.Stage
+	}
+	return PushStage_PUSH_STAGE_ACCEPTED
+}
+
+func (m *PushTransactionStatusResponse) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *PushTransactionStatusResponse) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *PushTransactionStatusResponse) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *PushTransactionStatusResponse) GetHandoffs() uint32 {
+	if m != nil {
+		return m.Handoffs
+	}
+	return 0
+}
+
+func (m *PushTransactionStatusResponse) GetTrace() *v1.TransactionTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dfuse.eosio.pushtrx.v1.Guarantee", Guarantee_name, Guarantee_value)
+	proto.RegisterEnum("dfuse.eosio.pushtrx.v1.PushStage", PushStage_name, PushStage_value)
+	proto.RegisterType((*PushTransactionRequest)(nil), "dfuse.eosio.pushtrx.v1.PushTransactionRequest")
+	proto.RegisterType((*PushTransactionResponse)(nil), "dfuse.eosio.pushtrx.v1.PushTransactionResponse")
+	proto.RegisterType((*PushTransactionStatusRequest)(nil), "dfuse.eosio.pushtrx.v1.PushTransactionStatusRequest")
+	proto.RegisterType((*PushTransactionStatusResponse)(nil), "dfuse.eosio.pushtrx.v1.PushTransactionStatusResponse")
+}
+
+func init() { proto.RegisterFile("dfuse/eosio/pushtrx/v1/pushtrx.proto", fileDescriptor_56a692d3ef3a8a86) }
+
+var fileDescriptor_56a692d3ef3a8a86 = []byte{
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0x66, 0xfa, 0xc1, 0x4b, 0x0f, 0x2f, 0xb0, 0xcc, 0xfb, 0x52, 0xd6, 0x22, 0xa6, 0x12, 0x25,
+	0x0d, 0x89, 0x5b, 0x5b, 0x34, 0x5c, 0x68, 0x62, 0x4a, 0xbb, 0x85, 0x06, 0x52, 0xc8, 0xb4, 0x78,
+	0xe1, 0xcd, 0x66, 0xba, 0x3b, 0x6d, 0x37, 0xd0, 0x9d, 0xba, 0x33, 0x4b, 0xea, 0x1f, 0x30, 0xf1,
+	0xc2, 0x44, 0xaf, 0xfd, 0x1f, 0xfe, 0x1e, 0x7f, 0x8a, 0xd9, 0x0f, 0xda, 0xa5, 0x14, 0x44, 0xaf,
+	0xbc, 0x9b, 0x39, 0xcf, 0x73, 0xce, 0x9e, 0xf3, 0x3c, 0xa7, 0x1d, 0x78, 0x62, 0x75, 0x3d, 0xc1,
+	0x8a, 0x8c, 0x0b, 0x9b, 0x17, 0x87, 0x9e, 0xe8, 0x4b, 0x77, 0x54, 0xbc, 0x2c, 0x5d, 0x1d, 0xb5,
+	0xa1, 0xcb, 0x25, 0xc7, 0xd9, 0x80, 0xa5, 0x05, 0x2c, 0xed, 0x0a, 0xba, 0x2c, 0xe5, 0xf2, 0xf1,
+	0x6c, 0x93, 0x5b, 0xcc, 0xf4, 0x73, 0x83, 0x43, 0x98, 0xb9, 0xf5, 0x2d, 0x01, 0xd9, 0x53, 0x4f,
+	0xf4, 0xdb, 0x2e, 0x75, 0x04, 0x35, 0xa5, 0xcd, 0x1d, 0xc2, 0xde, 0x7b, 0x4c, 0x48, 0xfc, 0x08,
+	0x40, 0xd8, 0x3d, 0x87, 0x4a, 0xcf, 0x65, 0x42, 0x45, 0xf9, 0x64, 0x21, 0x43, 0x62, 0x11, 0x9c,
+	0x87, 0x45, 0x93, 0x0f, 0x86, 0x2e, 0x13, 0xc2, 0xe6, 0x8e, 0x9a, 0xc8, 0xa3, 0x42, 0x86, 0xc4,
+	0x43, 0x78, 0x0f, 0xd4, 0x21, 0x35, 0xcf, 0x99, 0x65, 0x98, 0xdc, 0x91, 0x6c, 0x24, 0x8d, 0xae,
+	0xcb, 0x98, 0x61, 0x51, 0x49, 0xd5, 0x64, 0x1e, 0x15, 0xfe, 0x25, 0x6b, 0x21, 0x5e, 0x0d, 0xe1,
+	0xba, 0xcb, 0x58, 0x8d, 0x4a, 0x8a, 0x37, 0x01, 0xa2, 0x44, 0xe9, 0x8e, 0xd4, 0x54, 0x40, 0xcd,
+	0x84, 0x91, 0xb6, 0x3b, 0xc2, 0x6f, 0x20, 0xd3, 0xf3, 0xa8, 0x4b, 0x1d, 0xc9, 0x98, 0x9a, 0xce,
+	0xa3, 0xc2, 0x72, 0xf9, 0xb1, 0x36, 0x5b, 0x02, 0xed, 0xe0, 0x8a, 0x48, 0x26, 0x39, 0x78, 0x1b,
+	0x56, 0x3c, 0xc1, 0x8c, 0x0b, 0xd6, 0xa3, 0xe6, 0x07, 0xc3, 0x67, 0xab, 0xf3, 0x79, 0x54, 0x58,
+	0x20, 0x4b, 0x9e, 0x60, 0xc7, 0x41, 0xd4, 0x17, 0x65, 0xeb, 0x3b, 0x82, 0xf5, 0x1b, 0xea, 0x88,
+	0x21, 0x77, 0x04, 0xc3, 0x4f, 0x61, 0x59, 0x4e, 0xc2, 0x86, 0x6d, 0xa9, 0x28, 0x50, 0x60, 0x29,
+	0x16, 0x6d, 0x58, 0xf8, 0x01, 0x2c, 0x74, 0x2e, 0xb8, 0x79, 0xee, 0x13, 0x42, 0x89, 0xfe, 0x09,
+	0xee, 0x0d, 0x0b, 0x6f, 0x40, 0x26, 0x84, 0x1c, 0x6f, 0x10, 0xe8, 0x91, 0x22, 0x21, 0xb7, 0xe9,
+	0x0d, 0xf0, 0x6b, 0x48, 0x4b, 0x97, 0x9a, 0x2c, 0x98, 0x7e, 0xb1, 0xbc, 0x7d, 0x6d, 0xbe, 0xd0,
+	0xc1, 0xcb, 0x92, 0x16, 0x6b, 0xac, 0xed, 0xb3, 0x49, 0x98, 0xb4, 0xf5, 0x03, 0xc1, 0xc3, 0xa9,
+	0xc6, 0x5b, 0x92, 0x4a, 0x4f, 0xfc, 0xfd, 0xe6, 0xce, 0xf0, 0x26, 0x3d, 0xcb, 0x9b, 0xaf, 0x09,
+	0xd8, 0xbc, 0x65, 0xc4, 0xc8, 0xa1, 0x3d, 0x48, 0x0b, 0x49, 0x7b, 0x4c, 0x45, 0x77, 0xaf, 0x88,
+	0x5f, 0xa5, 0xe5, 0x13, 0x49, 0xc8, 0x9f, 0x61, 0x6d, 0xe2, 0x57, 0xd6, 0x26, 0xef, 0xb0, 0x36,
+	0x35, 0x65, 0x6d, 0x0e, 0x16, 0xfa, 0xd4, 0xb1, 0x78, 0xb7, 0x2b, 0x82, 0xd1, 0x96, 0xc8, 0xf8,
+	0x3e, 0xb1, 0x7d, 0xfe, 0x0f, 0x6c, 0xdf, 0xf9, 0x8c, 0x20, 0x33, 0x5e, 0x78, 0x9c, 0x05, 0x7c,
+	0x70, 0x56, 0x21, 0x95, 0x66, 0x5b, 0xd7, 0x8d, 0x46, 0xd3, 0xd8, 0x3f, 0x3e, 0xa9, 0x1e, 0x29,
+	0x73, 0x58, 0x85, 0xff, 0x27, 0xf1, 0xc3, 0x4a, 0xb3, 0x76, 0x52, 0xaf, 0xb7, 0x8c, 0x92, 0x82,
+	0x6e, 0x41, 0xca, 0x4a, 0xe2, 0x16, 0x64, 0x57, 0x49, 0xe2, 0x1c, 0x64, 0x63, 0x5f, 0x21, 0x44,
+	0x7f, 0xab, 0x93, 0x56, 0x63, 0xff, 0x58, 0x57, 0x52, 0x3b, 0x9f, 0x10, 0x64, 0xc6, 0xea, 0xe2,
+	0x75, 0xf8, 0xef, 0xf4, 0xac, 0x75, 0x68, 0xb4, 0xda, 0x95, 0x03, 0xdd, 0xa8, 0x54, 0xab, 0xfa,
+	0x69, 0x5b, 0xaf, 0x29, 0x73, 0x53, 0xc0, 0xb8, 0x53, 0xe4, 0x4f, 0x10, 0x03, 0xa2, 0xcf, 0x2a,
+	0x09, 0xbc, 0x06, 0xab, 0xb1, 0x78, 0xfd, 0x84, 0x1c, 0xe9, 0x35, 0x25, 0x89, 0x37, 0x60, 0x3d,
+	0x5e, 0xe7, 0x5a, 0x2f, 0xe5, 0x2f, 0x09, 0x58, 0x8d, 0xe9, 0xe6, 0xb7, 0xc5, 0x5c, 0xec, 0xc2,
+	0xca, 0xd4, 0x12, 0x61, 0xed, 0xae, 0x3d, 0xb9, 0xf9, 0x3f, 0x99, 0x2b, 0xde, 0x9b, 0x1f, 0xed,
+	0xe5, 0x47, 0x04, 0x6b, 0x33, 0x37, 0x17, 0xbf, 0xb8, 0x67, 0xa9, 0x6b, 0xbf, 0xe5, 0xdc, 0xcb,
+	0xdf, 0xcc, 0x0a, 0xdb, 0x78, 0x8e, 0xf6, 0xf5, 0x77, 0xd5, 0x9e, 0x2d, 0xfb, 0x5e, 0x47, 0x33,
+	0xf9, 0xa0, 0x18, 0x14, 0x79, 0x66, 0xf3, 0xe8, 0x10, 0x3d, 0x39, 0x9d, 0xe2, 0xec, 0x17, 0xe8,
+	0xd5, 0xb0, 0x13, 0x5d, 0x3a, 0xf3, 0xc1, 0x53, 0xb2, 0xfb, 0x73, 0x00, 0x3d, 0x80, 0x04, 0xec,
+	0xac, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TransactionPusherClient is the client API for TransactionPusher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransactionPusherClient interface {
+	// PushTransaction pushes a signed transaction to the chain and only returns once the
+	// requested guarantee is met, re-pushing the transaction to the configured nodes until
+	// it makes it into a block or expires.
+	PushTransaction(ctx context.Context, in *PushTransactionRequest, opts ...grpc.CallOption) (*PushTransactionResponse, error)
+	// PushTransactionStatus pushes a signed transaction to the chain and streams back each stage
+	// it goes through, up to the point where it becomes irreversible.
+	PushTransactionStatus(ctx context.Context, in *PushTransactionStatusRequest, opts ...grpc.CallOption) (TransactionPusher_PushTransactionStatusClient, error)
+}
+
+type transactionPusherClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTransactionPusherClient(cc *grpc.ClientConn) TransactionPusherClient {
+	return &transactionPusherClient{cc}
+}
+
+func (c *transactionPusherClient) PushTransaction(ctx context.Context, in *PushTransactionRequest, opts ...grpc.CallOption) (*PushTransactionResponse, error) {
+	out := new(PushTransactionResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.pushtrx.v1.TransactionPusher/PushTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionPusherClient) PushTransactionStatus(ctx context.Context, in *PushTransactionStatusRequest, opts ...grpc.CallOption) (TransactionPusher_PushTransactionStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TransactionPusher_serviceDesc.Streams[0], "/dfuse.eosio.pushtrx.v1.TransactionPusher/PushTransactionStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionPusherPushTransactionStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionPusher_PushTransactionStatusClient interface {
+	Recv() (*PushTransactionStatusResponse, error)
+	grpc.ClientStream
+}
+
+type transactionPusherPushTransactionStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionPusherPushTransactionStatusClient) Recv() (*PushTransactionStatusResponse, error) {
+	m := new(PushTransactionStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TransactionPusherServer is the server API for TransactionPusher service.
+type TransactionPusherServer interface {
+	// PushTransaction pushes a signed transaction to the chain and only returns once the
+	// requested guarantee is met, re-pushing the transaction to the configured nodes until
+	// it makes it into a block or expires.
+	PushTransaction(context.Context, *PushTransactionRequest) (*PushTransactionResponse, error)
+	// PushTransactionStatus pushes a signed transaction to the chain and streams back each stage
+	// it goes through, up to the point where it becomes irreversible.
+	PushTransactionStatus(*PushTransactionStatusRequest, TransactionPusher_PushTransactionStatusServer) error
+}
+
+// UnimplementedTransactionPusherServer can be embedded to have forward compatible implementations.
+type UnimplementedTransactionPusherServer struct {
+}
+
+func (*UnimplementedTransactionPusherServer) PushTransaction(ctx context.Context, req *PushTransactionRequest) (*PushTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushTransaction not implemented")
+}
+func (*UnimplementedTransactionPusherServer) PushTransactionStatus(req *PushTransactionStatusRequest, srv TransactionPusher_PushTransactionStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushTransactionStatus not implemented")
+}
+
+func RegisterTransactionPusherServer(s *grpc.Server, srv TransactionPusherServer) {
+	s.RegisterService(&_TransactionPusher_serviceDesc, srv)
+}
+
+func _TransactionPusher_PushTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionPusherServer).PushTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.pushtrx.v1.TransactionPusher/PushTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionPusherServer).PushTransaction(ctx, req.(*PushTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionPusher_PushTransactionStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PushTransactionStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionPusherServer).PushTransactionStatus(m, &transactionPusherPushTransactionStatusServer{stream})
+}
+
+type TransactionPusher_PushTransactionStatusServer interface {
+	Send(*PushTransactionStatusResponse) error
+	grpc.ServerStream
+}
+
+type transactionPusherPushTransactionStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionPusherPushTransactionStatusServer) Send(m *PushTransactionStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _TransactionPusher_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dfuse.eosio.pushtrx.v1.TransactionPusher",
+	HandlerType: (*TransactionPusherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PushTransaction",
+			Handler:    _TransactionPusher_PushTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PushTransactionStatus",
+			Handler:       _TransactionPusher_PushTransactionStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dfuse/eosio/pushtrx/v1/pushtrx.proto",
+}
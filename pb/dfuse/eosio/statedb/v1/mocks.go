@@ -20,7 +20,8 @@ func init() {
 type MockStateClient struct {
 	tableRowsStream State_StreamTableRowsClient
 
-	streamTableRows *MockStreamTableRows
+	streamTableRows            *MockStreamTableRows
+	streamMultiScopesTableRows *MockStreamMultiScopesTableRows
 }
 
 type MockStreamTableRows struct {
@@ -51,6 +52,30 @@ func (s *MockStreamTableRows) Recv() (*TableRowResponse, error) {
 	return out, nil
 }
 
+type MockStreamMultiScopesTableRows struct {
+	*mockStream
+
+	LastIrrBlockID  string                    `json:"last_irreversible_block_id"`
+	LastIrrBlockNum uint64                    `json:"last_irreversible_block_num"`
+	UpToBlockID     string                    `json:"up_to_block_id"`
+	UpToBlockNum    uint64                    `json:"up_to_block_num"`
+	Scopes          []*TableRowsScopeResponse `json:"scopes"`
+
+	at int
+}
+
+func (s *MockStreamMultiScopesTableRows) Recv() (*TableRowsScopeResponse, error) {
+	if s.at >= len(s.Scopes) {
+		return nil, io.EOF
+	}
+
+	out := s.Scopes[s.at]
+	zlog.Debug("streaming scope rows at", zap.Int("index", s.at), zap.Reflect("scope_rows", out))
+	s.at++
+
+	return out, nil
+}
+
 func NewMockStateClient() *MockStateClient {
 	return &MockStateClient{}
 }
@@ -67,6 +92,26 @@ func (m *MockStateClient) GetPermissionLinks(ctx context.Context, in *GetPermiss
 	return nil, nil
 }
 
+func (m *MockStateClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockStateClient) StreamPermissions(ctx context.Context, in *StreamPermissionsRequest, opts ...grpc.CallOption) (State_StreamPermissionsClient, error) {
+	return nil, nil
+}
+
+func (m *MockStateClient) GetAccountResources(ctx context.Context, in *GetAccountResourcesRequest, opts ...grpc.CallOption) (*GetAccountResourcesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockStateClient) GetResourceLimitsState(ctx context.Context, in *GetResourceLimitsStateRequest, opts ...grpc.CallOption) (*GetResourceLimitsStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockStateClient) GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error) {
+	return nil, nil
+}
+
 func (m *MockStateClient) GetTableRow(ctx context.Context, in *GetTableRowRequest, opts ...grpc.CallOption) (*GetTableRowResponse, error) {
 	return nil, nil
 }
@@ -84,7 +129,11 @@ func (m *MockStateClient) StreamTableScopes(ctx context.Context, in *StreamTable
 }
 
 func (m *MockStateClient) StreamMultiScopesTableRows(ctx context.Context, in *StreamMultiScopesTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiScopesTableRowsClient, error) {
-	return nil, nil
+	if m.streamMultiScopesTableRows == nil {
+		return nil, nil
+	}
+
+	return m.streamMultiScopesTableRows, nil
 }
 
 func (m *MockStateClient) StreamMultiContractsTableRows(ctx context.Context, in *StreamMultiContractsTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiContractsTableRowsClient, error) {
@@ -104,6 +153,19 @@ func (m *MockStateClient) SetStreamTableRows(response *MockStreamTableRows) {
 	m.streamTableRows = response
 }
 
+func (m *MockStateClient) SetStreamMultiScopesTableRows(response *MockStreamMultiScopesTableRows) {
+	response.mockStream = &mockStream{
+		headers: metadata.MD{
+			MetdataLastIrrBlockID:  []string{response.LastIrrBlockID},
+			MetdataLastIrrBlockNum: []string{strconv.FormatUint(response.LastIrrBlockNum, 10)},
+			MetdataUpToBlockID:     []string{response.UpToBlockID},
+			MetdataUpToBlockNum:    []string{strconv.FormatUint(response.UpToBlockNum, 10)},
+		},
+	}
+
+	m.streamMultiScopesTableRows = response
+}
+
 type mockStream struct {
 	headers  metadata.MD
 	trailers metadata.MD
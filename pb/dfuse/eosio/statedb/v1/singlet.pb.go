@@ -59,24 +59,124 @@ func (m *ContractABIValue) GetRawAbi() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ContractABIValue)(nil), "dfuse.eosio.statedb.v1.ContractABIValue")
+type ContractCodeValue struct {
+	CodeHash             []byte   `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	VmType               uint32   `protobuf:"varint,2,opt,name=vm_type,json=vmType,proto3" json:"vm_type,omitempty"`
+	VmVersion            uint32   `protobuf:"varint,3,opt,name=vm_version,json=vmVersion,proto3" json:"vm_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractCodeValue) Reset()         { *m = ContractCodeValue{} }
+func (m *ContractCodeValue) String() string { return proto.CompactTextString(m) }
+func (*ContractCodeValue) ProtoMessage()    {}
+func (*ContractCodeValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab28fe579975afc, []int{1}
+}
+
+func (m *ContractCodeValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractCodeValue.Unmarshal(m, b)
+}
+func (m *ContractCodeValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractCodeValue.Marshal(b, m, deterministic)
+}
+func (m *ContractCodeValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCodeValue.Merge(m, src)
+}
+func (m *ContractCodeValue) XXX_Size() int {
+	return xxx_messageInfo_ContractCodeValue.Size(m)
+}
+func (m *ContractCodeValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCodeValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCodeValue proto.InternalMessageInfo
+
+func (m *ContractCodeValue) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *ContractCodeValue) GetVmType() uint32 {
+	if m != nil {
+		return m.VmType
+	}
+	return 0
+}
+
+func (m *ContractCodeValue) GetVmVersion() uint32 {
+	if m != nil {
+		return m.VmVersion
+	}
+	return 0
+}
+
+type RAMUsageValue struct {
+	Usage                uint64   `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RAMUsageValue) Reset()         { *m = RAMUsageValue{} }
+func (m *RAMUsageValue) String() string { return proto.CompactTextString(m) }
+func (*RAMUsageValue) ProtoMessage()    {}
+func (*RAMUsageValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab28fe579975afc, []int{2}
+}
+
+func (m *RAMUsageValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RAMUsageValue.Unmarshal(m, b)
+}
+func (m *RAMUsageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RAMUsageValue.Marshal(b, m, deterministic)
+}
+func (m *RAMUsageValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RAMUsageValue.Merge(m, src)
+}
+func (m *RAMUsageValue) XXX_Size() int {
+	return xxx_messageInfo_RAMUsageValue.Size(m)
+}
+func (m *RAMUsageValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RAMUsageValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RAMUsageValue proto.InternalMessageInfo
+
+func (m *RAMUsageValue) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
 }
 
 func init() {
-	proto.RegisterFile("dfuse/eosio/statedb/v1/singlet.proto", fileDescriptor_1ab28fe579975afc)
+	proto.RegisterType((*ContractABIValue)(nil), "dfuse.eosio.statedb.v1.ContractABIValue")
+	proto.RegisterType((*ContractCodeValue)(nil), "dfuse.eosio.statedb.v1.ContractCodeValue")
+	proto.RegisterType((*RAMUsageValue)(nil), "dfuse.eosio.statedb.v1.RAMUsageValue")
 }
 
+func init() { proto.RegisterFile("dfuse/eosio/statedb/v1/singlet.proto", fileDescriptor_1ab28fe579975afc) }
+
 var fileDescriptor_1ab28fe579975afc = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x49, 0x2b, 0x2d,
-	0x4e, 0xd5, 0x4f, 0xcd, 0x2f, 0xce, 0xcc, 0xd7, 0x2f, 0x2e, 0x49, 0x2c, 0x49, 0x4d, 0x49, 0xd2,
-	0x2f, 0x33, 0xd4, 0x2f, 0xce, 0xcc, 0x4b, 0xcf, 0x49, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xab, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xaa, 0xd2, 0x2b, 0x33, 0x54, 0xd2, 0xe6,
-	0x12, 0x70, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x71, 0x74, 0xf2, 0x0c, 0x4b, 0xcc, 0x29,
-	0x4d, 0x15, 0x12, 0xe7, 0x62, 0x2f, 0x4a, 0x2c, 0x8f, 0x4f, 0x4c, 0xca, 0x94, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x09, 0x62, 0x2b, 0x4a, 0x2c, 0x77, 0x4c, 0xca, 0x74, 0x72, 0x8d, 0x72, 0x4e, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x9b, 0xa8, 0x9b, 0x99, 0x0f, 0x65,
-	0x40, 0x1c, 0x50, 0x90, 0xa4, 0x8f, 0xdd, 0x3d, 0xd6, 0x05, 0x49, 0x50, 0x4e, 0x12, 0x1b, 0xd8,
-	0x49, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x2e, 0x4f, 0x8b, 0xba, 0x00, 0x00, 0x00,
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x4f, 0x4b, 0xc4, 0x30,
+	0x10, 0xc5, 0xa9, 0x7f, 0xaa, 0x1b, 0x5c, 0xd0, 0x22, 0x5a, 0x10, 0x61, 0x29, 0x0a, 0x0b, 0x62,
+	0xc3, 0xe2, 0xd1, 0x53, 0xb7, 0x08, 0x7a, 0xf0, 0x52, 0x74, 0x0f, 0x5e, 0x4a, 0xd2, 0x8e, 0x6d,
+	0x60, 0xdb, 0x09, 0x49, 0x9a, 0x65, 0xbf, 0xbd, 0x34, 0x8d, 0xb7, 0xbd, 0xcd, 0x7b, 0xf3, 0x63,
+	0x1e, 0xf3, 0xc8, 0x43, 0xfd, 0x3b, 0x68, 0xa0, 0x80, 0x5a, 0x20, 0xd5, 0x86, 0x19, 0xa8, 0x39,
+	0xb5, 0x2b, 0xaa, 0x45, 0xdf, 0x6c, 0xc1, 0xa4, 0x52, 0xa1, 0xc1, 0xe8, 0xc6, 0x51, 0xa9, 0xa3,
+	0x52, 0x4f, 0xa5, 0x76, 0x95, 0x3c, 0x91, 0xcb, 0x1c, 0x7b, 0xa3, 0x58, 0x65, 0xb2, 0xf5, 0xc7,
+	0x86, 0x6d, 0x07, 0x88, 0x6e, 0xc9, 0x99, 0x62, 0xbb, 0x92, 0x71, 0x11, 0x07, 0x8b, 0x60, 0x79,
+	0x51, 0x84, 0x8a, 0xed, 0x32, 0x2e, 0x92, 0x96, 0x5c, 0xfd, 0xc3, 0x39, 0xd6, 0x30, 0xd1, 0x77,
+	0x64, 0x56, 0x61, 0x0d, 0x65, 0xcb, 0x74, 0xeb, 0xf9, 0xf3, 0xd1, 0x78, 0x67, 0xba, 0x1d, 0x4f,
+	0xd9, 0xae, 0x34, 0x7b, 0x09, 0xf1, 0xd1, 0x22, 0x58, 0xce, 0x8b, 0xd0, 0x76, 0x5f, 0x7b, 0x09,
+	0xd1, 0x3d, 0x21, 0xb6, 0x2b, 0x2d, 0x28, 0x2d, 0xb0, 0x8f, 0x8f, 0xdd, 0x6e, 0x66, 0xbb, 0xcd,
+	0x64, 0x24, 0x8f, 0x64, 0x5e, 0x64, 0x9f, 0xdf, 0x9a, 0x35, 0x3e, 0xe5, 0x9a, 0x9c, 0x0e, 0xa3,
+	0x72, 0x09, 0x27, 0xc5, 0x24, 0xd6, 0x6f, 0x3f, 0x79, 0x23, 0x4c, 0x3b, 0xf0, 0xb4, 0xc2, 0x8e,
+	0xba, 0x17, 0x9f, 0x05, 0xfa, 0x61, 0x6a, 0x44, 0x72, 0x7a, 0xb8, 0xa0, 0x57, 0xc9, 0xbd, 0xe0,
+	0xa1, 0xeb, 0xe8, 0xe5, 0x6f, 0x00, 0xa2, 0x3b, 0xa1, 0xe9, 0x4b, 0x01, 0x00, 0x00,
 }
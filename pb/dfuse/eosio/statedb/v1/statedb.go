@@ -15,6 +15,7 @@ const MetdataUpToBlockID = "statedb-up-to-block-id"
 const MetdataUpToBlockNum = "statedb-up-to-block-num"
 const MetdataLastIrrBlockID = "statedb-last-irr-block-id"
 const MetdataLastIrrBlockNum = "statedb-last-irr-block-num"
+const MetdataNextCursor = "statedb-next-cursor"
 
 var ErrStreamReferenceNotFound = errors.New("not found")
 var SkipTable = errors.New("skip table")
@@ -68,6 +69,7 @@ func ForEachTableRows(ctx context.Context, client StateClient, request *StreamTa
 		response, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
+				ref.NextCursor = extractNextCursor(stream)
 				return ref, nil
 			}
 
@@ -123,9 +125,13 @@ func ForEachTableScopes(ctx context.Context, client StateClient, blockNum uint64
 // **Important** The last irreversible block will always be set but the
 //               up to block value can be `nil`, for example if irreversible
 //               only was set.
+//
+// The next cursor is only set on limited table rows streams that have more
+// rows to return, pass it back in the request to continue the read.
 type StreamReference struct {
 	UpToBlock             bstream.BlockRef
 	LastIrreversibleBlock bstream.BlockRef
+	NextCursor            string
 }
 
 func ExtractStreamReference(stream grpc.ClientStream) (*StreamReference, error) {
@@ -168,3 +174,12 @@ func ExtractStreamReference(stream grpc.ClientStream) (*StreamReference, error)
 
 	return ref, nil
 }
+
+func extractNextCursor(stream grpc.ClientStream) string {
+	cursors := stream.Trailer().Get(MetdataNextCursor)
+	if len(cursors) <= 0 {
+		return ""
+	}
+
+	return cursors[0]
+}
@@ -6,8 +6,10 @@ package pbstatedb
 import (
 	context "context"
 	fmt "fmt"
+	v11 "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	v1 "github.com/dfuse-io/pbgo/dfuse/bstream/v1"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -386,6 +388,754 @@ func (m *LinkedPermission) GetPermissionName() string {
 	return ""
 }
 
+type GetPermissionsRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	IrreversibleOnly     bool     `protobuf:"varint,3,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
+func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsRequest) ProtoMessage()    {}
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{7}
+}
+
+func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPermissionsRequest.Unmarshal(m, b)
+}
+func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsRequest.Merge(m, src)
+}
+func (m *GetPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPermissionsRequest.Size(m)
+}
+func (m *GetPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPermissionsRequest proto.InternalMessageInfo
+
+func (m *GetPermissionsRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *GetPermissionsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetPermissionsRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
+type GetPermissionsResponse struct {
+	UpToBlock             *v1.BlockRef  `protobuf:"bytes,1,opt,name=up_to_block,json=upToBlock,proto3" json:"up_to_block,omitempty"`
+	LastIrreversibleBlock *v1.BlockRef  `protobuf:"bytes,2,opt,name=last_irreversible_block,json=lastIrreversibleBlock,proto3" json:"last_irreversible_block,omitempty"`
+	Permissions           []*Permission `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}      `json:"-"`
+	XXX_unrecognized      []byte        `json:"-"`
+	XXX_sizecache         int32         `json:"-"`
+}
+
+func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{} }
+func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsResponse) ProtoMessage()    {}
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{8}
+}
+
+func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPermissionsResponse.Unmarshal(m, b)
+}
+func (m *GetPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsResponse.Merge(m, src)
+}
+func (m *GetPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPermissionsResponse.Size(m)
+}
+func (m *GetPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPermissionsResponse proto.InternalMessageInfo
+
+func (m *GetPermissionsResponse) GetUpToBlock() *v1.BlockRef {
+	if m != nil {
+		return m.UpToBlock
+	}
+	return nil
+}
+
+func (m *GetPermissionsResponse) GetLastIrreversibleBlock() *v1.BlockRef {
+	if m != nil {
+		return m.LastIrreversibleBlock
+	}
+	return nil
+}
+
+func (m *GetPermissionsResponse) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type StreamPermissionsRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Accounts             []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	IrreversibleOnly     bool     `protobuf:"varint,3,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamPermissionsRequest) Reset()         { *m = StreamPermissionsRequest{} }
+func (m *StreamPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPermissionsRequest) ProtoMessage()    {}
+func (*StreamPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{9}
+}
+
+func (m *StreamPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPermissionsRequest.Unmarshal(m, b)
+}
+func (m *StreamPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPermissionsRequest.Merge(m, src)
+}
+func (m *StreamPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPermissionsRequest.Size(m)
+}
+func (m *StreamPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPermissionsRequest proto.InternalMessageInfo
+
+func (m *StreamPermissionsRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *StreamPermissionsRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *StreamPermissionsRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
+type AccountPermissionsResponse struct {
+	Account              string        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Permissions          []*Permission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AccountPermissionsResponse) Reset()         { *m = AccountPermissionsResponse{} }
+func (m *AccountPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountPermissionsResponse) ProtoMessage()    {}
+func (*AccountPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{10}
+}
+
+func (m *AccountPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountPermissionsResponse.Unmarshal(m, b)
+}
+func (m *AccountPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPermissionsResponse.Merge(m, src)
+}
+func (m *AccountPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountPermissionsResponse.Size(m)
+}
+func (m *AccountPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPermissionsResponse proto.InternalMessageInfo
+
+func (m *AccountPermissionsResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountPermissionsResponse) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type Permission struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent               string               `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	LastUpdated          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	RequiredAuth         *v11.Authority       `protobuf:"bytes,4,opt,name=required_auth,json=requiredAuth,proto3" json:"required_auth,omitempty"`
+	BlockNum             uint64               `protobuf:"varint,5,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{11}
+}
+
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Permission.Unmarshal(m, b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+}
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
+}
+func (m *Permission) XXX_Size() int {
+	return xxx_messageInfo_Permission.Size(m)
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Permission) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *Permission) GetLastUpdated() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return nil
+}
+
+func (m *Permission) GetRequiredAuth() *v11.Authority {
+	if m != nil {
+		return m.RequiredAuth
+	}
+	return nil
+}
+
+func (m *Permission) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+type GetAccountResourcesRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	IrreversibleOnly     bool     `protobuf:"varint,3,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountResourcesRequest) Reset()         { *m = GetAccountResourcesRequest{} }
+func (m *GetAccountResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountResourcesRequest) ProtoMessage()    {}
+func (*GetAccountResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{12}
+}
+
+func (m *GetAccountResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountResourcesRequest.Unmarshal(m, b)
+}
+func (m *GetAccountResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountResourcesRequest.Merge(m, src)
+}
+func (m *GetAccountResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountResourcesRequest.Size(m)
+}
+func (m *GetAccountResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountResourcesRequest proto.InternalMessageInfo
+
+func (m *GetAccountResourcesRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *GetAccountResourcesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetAccountResourcesRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
+type GetAccountResourcesResponse struct {
+	UpToBlock             *v1.BlockRef             `protobuf:"bytes,1,opt,name=up_to_block,json=upToBlock,proto3" json:"up_to_block,omitempty"`
+	LastIrreversibleBlock *v1.BlockRef             `protobuf:"bytes,2,opt,name=last_irreversible_block,json=lastIrreversibleBlock,proto3" json:"last_irreversible_block,omitempty"`
+	Limits                *v11.RlimitAccountLimits `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	LimitsBlockNum        uint64                   `protobuf:"varint,4,opt,name=limits_block_num,json=limitsBlockNum,proto3" json:"limits_block_num,omitempty"`
+	Usage                 *v11.RlimitAccountUsage  `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	UsageBlockNum         uint64                   `protobuf:"varint,6,opt,name=usage_block_num,json=usageBlockNum,proto3" json:"usage_block_num,omitempty"`
+	RamUsage              uint64                   `protobuf:"varint,7,opt,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty"`
+	RamUsageBlockNum      uint64                   `protobuf:"varint,8,opt,name=ram_usage_block_num,json=ramUsageBlockNum,proto3" json:"ram_usage_block_num,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                 `json:"-"`
+	XXX_unrecognized      []byte                   `json:"-"`
+	XXX_sizecache         int32                    `json:"-"`
+}
+
+func (m *GetAccountResourcesResponse) Reset()         { *m = GetAccountResourcesResponse{} }
+func (m *GetAccountResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountResourcesResponse) ProtoMessage()    {}
+func (*GetAccountResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{13}
+}
+
+func (m *GetAccountResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountResourcesResponse.Unmarshal(m, b)
+}
+func (m *GetAccountResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountResourcesResponse.Merge(m, src)
+}
+func (m *GetAccountResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountResourcesResponse.Size(m)
+}
+func (m *GetAccountResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountResourcesResponse proto.InternalMessageInfo
+
+func (m *GetAccountResourcesResponse) GetUpToBlock() *v1.BlockRef {
+	if m != nil {
+		return m.UpToBlock
+	}
+	return nil
+}
+
+func (m *GetAccountResourcesResponse) GetLastIrreversibleBlock() *v1.BlockRef {
+	if m != nil {
+		return m.LastIrreversibleBlock
+	}
+	return nil
+}
+
+func (m *GetAccountResourcesResponse) GetLimits() *v11.RlimitAccountLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *GetAccountResourcesResponse) GetLimitsBlockNum() uint64 {
+	if m != nil {
+		return m.LimitsBlockNum
+	}
+	return 0
+}
+
+func (m *GetAccountResourcesResponse) GetUsage() *v11.RlimitAccountUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *GetAccountResourcesResponse) GetUsageBlockNum() uint64 {
+	if m != nil {
+		return m.UsageBlockNum
+	}
+	return 0
+}
+
+func (m *GetAccountResourcesResponse) GetRamUsage() uint64 {
+	if m != nil {
+		return m.RamUsage
+	}
+	return 0
+}
+
+func (m *GetAccountResourcesResponse) GetRamUsageBlockNum() uint64 {
+	if m != nil {
+		return m.RamUsageBlockNum
+	}
+	return 0
+}
+
+type GetResourceLimitsStateRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	IrreversibleOnly     bool     `protobuf:"varint,2,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResourceLimitsStateRequest) Reset()         { *m = GetResourceLimitsStateRequest{} }
+func (m *GetResourceLimitsStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResourceLimitsStateRequest) ProtoMessage()    {}
+func (*GetResourceLimitsStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{14}
+}
+
+func (m *GetResourceLimitsStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceLimitsStateRequest.Unmarshal(m, b)
+}
+func (m *GetResourceLimitsStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceLimitsStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResourceLimitsStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceLimitsStateRequest.Merge(m, src)
+}
+func (m *GetResourceLimitsStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResourceLimitsStateRequest.Size(m)
+}
+func (m *GetResourceLimitsStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceLimitsStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceLimitsStateRequest proto.InternalMessageInfo
+
+func (m *GetResourceLimitsStateRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *GetResourceLimitsStateRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
+type GetResourceLimitsStateResponse struct {
+	UpToBlock             *v1.BlockRef      `protobuf:"bytes,1,opt,name=up_to_block,json=upToBlock,proto3" json:"up_to_block,omitempty"`
+	LastIrreversibleBlock *v1.BlockRef      `protobuf:"bytes,2,opt,name=last_irreversible_block,json=lastIrreversibleBlock,proto3" json:"last_irreversible_block,omitempty"`
+	Config                *v11.RlimitConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	ConfigBlockNum        uint64            `protobuf:"varint,4,opt,name=config_block_num,json=configBlockNum,proto3" json:"config_block_num,omitempty"`
+	State                 *v11.RlimitState  `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	StateBlockNum         uint64            `protobuf:"varint,6,opt,name=state_block_num,json=stateBlockNum,proto3" json:"state_block_num,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *GetResourceLimitsStateResponse) Reset()         { *m = GetResourceLimitsStateResponse{} }
+func (m *GetResourceLimitsStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResourceLimitsStateResponse) ProtoMessage()    {}
+func (*GetResourceLimitsStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{15}
+}
+
+func (m *GetResourceLimitsStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceLimitsStateResponse.Unmarshal(m, b)
+}
+func (m *GetResourceLimitsStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceLimitsStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResourceLimitsStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceLimitsStateResponse.Merge(m, src)
+}
+func (m *GetResourceLimitsStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResourceLimitsStateResponse.Size(m)
+}
+func (m *GetResourceLimitsStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceLimitsStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceLimitsStateResponse proto.InternalMessageInfo
+
+func (m *GetResourceLimitsStateResponse) GetUpToBlock() *v1.BlockRef {
+	if m != nil {
+		return m.UpToBlock
+	}
+	return nil
+}
+
+func (m *GetResourceLimitsStateResponse) GetLastIrreversibleBlock() *v1.BlockRef {
+	if m != nil {
+		return m.LastIrreversibleBlock
+	}
+	return nil
+}
+
+func (m *GetResourceLimitsStateResponse) GetConfig() *v11.RlimitConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *GetResourceLimitsStateResponse) GetConfigBlockNum() uint64 {
+	if m != nil {
+		return m.ConfigBlockNum
+	}
+	return 0
+}
+
+func (m *GetResourceLimitsStateResponse) GetState() *v11.RlimitState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *GetResourceLimitsStateResponse) GetStateBlockNum() uint64 {
+	if m != nil {
+		return m.StateBlockNum
+	}
+	return 0
+}
+
+type GetCodeRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	IrreversibleOnly     bool     `protobuf:"varint,3,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	WithDeployments      bool     `protobuf:"varint,4,opt,name=with_deployments,json=withDeployments,proto3" json:"with_deployments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodeRequest) Reset()         { *m = GetCodeRequest{} }
+func (m *GetCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodeRequest) ProtoMessage()    {}
+func (*GetCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{16}
+}
+
+func (m *GetCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodeRequest.Unmarshal(m, b)
+}
+func (m *GetCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodeRequest.Merge(m, src)
+}
+func (m *GetCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodeRequest.Size(m)
+}
+func (m *GetCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodeRequest proto.InternalMessageInfo
+
+func (m *GetCodeRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *GetCodeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetCodeRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
+func (m *GetCodeRequest) GetWithDeployments() bool {
+	if m != nil {
+		return m.WithDeployments
+	}
+	return false
+}
+
+type GetCodeResponse struct {
+	UpToBlock             *v1.BlockRef      `protobuf:"bytes,1,opt,name=up_to_block,json=upToBlock,proto3" json:"up_to_block,omitempty"`
+	LastIrreversibleBlock *v1.BlockRef      `protobuf:"bytes,2,opt,name=last_irreversible_block,json=lastIrreversibleBlock,proto3" json:"last_irreversible_block,omitempty"`
+	Code                  *CodeDeployment   `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Deployments           []*CodeDeployment `protobuf:"bytes,4,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *GetCodeResponse) Reset()         { *m = GetCodeResponse{} }
+func (m *GetCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodeResponse) ProtoMessage()    {}
+func (*GetCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{17}
+}
+
+func (m *GetCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodeResponse.Unmarshal(m, b)
+}
+func (m *GetCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodeResponse.Merge(m, src)
+}
+func (m *GetCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodeResponse.Size(m)
+}
+func (m *GetCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodeResponse proto.InternalMessageInfo
+
+func (m *GetCodeResponse) GetUpToBlock() *v1.BlockRef {
+	if m != nil {
+		return m.UpToBlock
+	}
+	return nil
+}
+
+func (m *GetCodeResponse) GetLastIrreversibleBlock() *v1.BlockRef {
+	if m != nil {
+		return m.LastIrreversibleBlock
+	}
+	return nil
+}
+
+func (m *GetCodeResponse) GetCode() *CodeDeployment {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *GetCodeResponse) GetDeployments() []*CodeDeployment {
+	if m != nil {
+		return m.Deployments
+	}
+	return nil
+}
+
+type CodeDeployment struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	CodeHash             string   `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	VmType               uint32   `protobuf:"varint,3,opt,name=vm_type,json=vmType,proto3" json:"vm_type,omitempty"`
+	VmVersion            uint32   `protobuf:"varint,4,opt,name=vm_version,json=vmVersion,proto3" json:"vm_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodeDeployment) Reset()         { *m = CodeDeployment{} }
+func (m *CodeDeployment) String() string { return proto.CompactTextString(m) }
+func (*CodeDeployment) ProtoMessage()    {}
+func (*CodeDeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7eba888d47f0653d, []int{18}
+}
+
+func (m *CodeDeployment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeDeployment.Unmarshal(m, b)
+}
+func (m *CodeDeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeDeployment.Marshal(b, m, deterministic)
+}
+func (m *CodeDeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeDeployment.Merge(m, src)
+}
+func (m *CodeDeployment) XXX_Size() int {
+	return xxx_messageInfo_CodeDeployment.Size(m)
+}
+func (m *CodeDeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeDeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeDeployment proto.InternalMessageInfo
+
+func (m *CodeDeployment) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *CodeDeployment) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *CodeDeployment) GetVmType() uint32 {
+	if m != nil {
+		return m.VmType
+	}
+	return 0
+}
+
+func (m *CodeDeployment) GetVmVersion() uint32 {
+	if m != nil {
+		return m.VmVersion
+	}
+	return 0
+}
+
 type GetTableRowRequest struct {
 	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
 	KeyType              string   `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
@@ -405,7 +1155,7 @@ func (m *GetTableRowRequest) Reset()         { *m = GetTableRowRequest{} }
 func (m *GetTableRowRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTableRowRequest) ProtoMessage()    {}
 func (*GetTableRowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{7}
+	return fileDescriptor_7eba888d47f0653d, []int{19}
 }
 
 func (m *GetTableRowRequest) XXX_Unmarshal(b []byte) error {
@@ -502,7 +1252,7 @@ func (m *GetTableRowResponse) Reset()         { *m = GetTableRowResponse{} }
 func (m *GetTableRowResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTableRowResponse) ProtoMessage()    {}
 func (*GetTableRowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{8}
+	return fileDescriptor_7eba888d47f0653d, []int{20}
 }
 
 func (m *GetTableRowResponse) XXX_Unmarshal(b []byte) error {
@@ -545,14 +1295,24 @@ func (m *GetTableRowResponse) GetRow() *TableRowResponse {
 }
 
 type StreamTableRowsRequest struct {
-	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	KeyType              string   `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
-	ToJson               bool     `protobuf:"varint,3,opt,name=to_json,json=toJson,proto3" json:"to_json,omitempty"`
-	WithBlockNum         bool     `protobuf:"varint,4,opt,name=with_block_num,json=withBlockNum,proto3" json:"with_block_num,omitempty"`
-	IrreversibleOnly     bool     `protobuf:"varint,5,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
-	Contract             string   `protobuf:"bytes,6,opt,name=contract,proto3" json:"contract,omitempty"`
-	Table                string   `protobuf:"bytes,7,opt,name=table,proto3" json:"table,omitempty"`
-	Scope                string   `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	BlockNum         uint64 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	KeyType          string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	ToJson           bool   `protobuf:"varint,3,opt,name=to_json,json=toJson,proto3" json:"to_json,omitempty"`
+	WithBlockNum     bool   `protobuf:"varint,4,opt,name=with_block_num,json=withBlockNum,proto3" json:"with_block_num,omitempty"`
+	IrreversibleOnly bool   `protobuf:"varint,5,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	Contract         string `protobuf:"bytes,6,opt,name=contract,proto3" json:"contract,omitempty"`
+	Table            string `protobuf:"bytes,7,opt,name=table,proto3" json:"table,omitempty"`
+	Scope            string `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Only the rows whose primary key is within these inclusive bounds, expressed using `key_type`,
+	// are returned, empty means unbounded. The whole table is still read at the block.
+	LowerBound string `protobuf:"bytes,9,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound string `protobuf:"bytes,10,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	// Maximum number of rows to return, 0 means no limit, when more rows are available,
+	// the `statedb-next-cursor` trailer contains the cursor to pass to get the next ones.
+	// Each page reads the whole table at the block.
+	Limit uint64 `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Continuation cursor, overrides `block_num` and `lower_bound` when set
+	Cursor               string   `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -562,7 +1322,7 @@ func (m *StreamTableRowsRequest) Reset()         { *m = StreamTableRowsRequest{}
 func (m *StreamTableRowsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamTableRowsRequest) ProtoMessage()    {}
 func (*StreamTableRowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{9}
+	return fileDescriptor_7eba888d47f0653d, []int{21}
 }
 
 func (m *StreamTableRowsRequest) XXX_Unmarshal(b []byte) error {
@@ -639,6 +1399,34 @@ func (m *StreamTableRowsRequest) GetScope() string {
 	return ""
 }
 
+func (m *StreamTableRowsRequest) GetLowerBound() string {
+	if m != nil {
+		return m.LowerBound
+	}
+	return ""
+}
+
+func (m *StreamTableRowsRequest) GetUpperBound() string {
+	if m != nil {
+		return m.UpperBound
+	}
+	return ""
+}
+
+func (m *StreamTableRowsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *StreamTableRowsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type TableRowResponse struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -654,7 +1442,7 @@ func (m *TableRowResponse) Reset()         { *m = TableRowResponse{} }
 func (m *TableRowResponse) String() string { return proto.CompactTextString(m) }
 func (*TableRowResponse) ProtoMessage()    {}
 func (*TableRowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{10}
+	return fileDescriptor_7eba888d47f0653d, []int{22}
 }
 
 func (m *TableRowResponse) XXX_Unmarshal(b []byte) error {
@@ -723,7 +1511,7 @@ func (m *StreamTableScopesRequest) Reset()         { *m = StreamTableScopesReque
 func (m *StreamTableScopesRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamTableScopesRequest) ProtoMessage()    {}
 func (*StreamTableScopesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{11}
+	return fileDescriptor_7eba888d47f0653d, []int{23}
 }
 
 func (m *StreamTableScopesRequest) XXX_Unmarshal(b []byte) error {
@@ -777,7 +1565,7 @@ func (m *TableScopeResponse) Reset()         { *m = TableScopeResponse{} }
 func (m *TableScopeResponse) String() string { return proto.CompactTextString(m) }
 func (*TableScopeResponse) ProtoMessage()    {}
 func (*TableScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{12}
+	return fileDescriptor_7eba888d47f0653d, []int{24}
 }
 
 func (m *TableScopeResponse) XXX_Unmarshal(b []byte) error {
@@ -830,7 +1618,7 @@ func (m *StreamMultiScopesTableRowsRequest) Reset()         { *m = StreamMultiSc
 func (m *StreamMultiScopesTableRowsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamMultiScopesTableRowsRequest) ProtoMessage()    {}
 func (*StreamMultiScopesTableRowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{13}
+	return fileDescriptor_7eba888d47f0653d, []int{25}
 }
 
 func (m *StreamMultiScopesTableRowsRequest) XXX_Unmarshal(b []byte) error {
@@ -925,7 +1713,7 @@ func (m *StreamMultiContractsTableRowsRequest) Reset()         { *m = StreamMult
 func (m *StreamMultiContractsTableRowsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamMultiContractsTableRowsRequest) ProtoMessage()    {}
 func (*StreamMultiContractsTableRowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{14}
+	return fileDescriptor_7eba888d47f0653d, []int{26}
 }
 
 func (m *StreamMultiContractsTableRowsRequest) XXX_Unmarshal(b []byte) error {
@@ -1014,7 +1802,7 @@ func (m *TableRowsScopeResponse) Reset()         { *m = TableRowsScopeResponse{}
 func (m *TableRowsScopeResponse) String() string { return proto.CompactTextString(m) }
 func (*TableRowsScopeResponse) ProtoMessage()    {}
 func (*TableRowsScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{15}
+	return fileDescriptor_7eba888d47f0653d, []int{27}
 }
 
 func (m *TableRowsScopeResponse) XXX_Unmarshal(b []byte) error {
@@ -1061,7 +1849,7 @@ func (m *TableRowsContractResponse) Reset()         { *m = TableRowsContractResp
 func (m *TableRowsContractResponse) String() string { return proto.CompactTextString(m) }
 func (*TableRowsContractResponse) ProtoMessage()    {}
 func (*TableRowsContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7eba888d47f0653d, []int{16}
+	return fileDescriptor_7eba888d47f0653d, []int{28}
 }
 
 func (m *TableRowsContractResponse) XXX_Unmarshal(b []byte) error {
@@ -1104,6 +1892,18 @@ func init() {
 	proto.RegisterType((*GetPermissionLinksRequest)(nil), "dfuse.eosio.statedb.v1.GetPermissionLinksRequest")
 	proto.RegisterType((*GetPermissionLinksResponse)(nil), "dfuse.eosio.statedb.v1.GetPermissionLinksResponse")
 	proto.RegisterType((*LinkedPermission)(nil), "dfuse.eosio.statedb.v1.LinkedPermission")
+	proto.RegisterType((*GetPermissionsRequest)(nil), "dfuse.eosio.statedb.v1.GetPermissionsRequest")
+	proto.RegisterType((*GetPermissionsResponse)(nil), "dfuse.eosio.statedb.v1.GetPermissionsResponse")
+	proto.RegisterType((*StreamPermissionsRequest)(nil), "dfuse.eosio.statedb.v1.StreamPermissionsRequest")
+	proto.RegisterType((*AccountPermissionsResponse)(nil), "dfuse.eosio.statedb.v1.AccountPermissionsResponse")
+	proto.RegisterType((*Permission)(nil), "dfuse.eosio.statedb.v1.Permission")
+	proto.RegisterType((*GetAccountResourcesRequest)(nil), "dfuse.eosio.statedb.v1.GetAccountResourcesRequest")
+	proto.RegisterType((*GetAccountResourcesResponse)(nil), "dfuse.eosio.statedb.v1.GetAccountResourcesResponse")
+	proto.RegisterType((*GetResourceLimitsStateRequest)(nil), "dfuse.eosio.statedb.v1.GetResourceLimitsStateRequest")
+	proto.RegisterType((*GetResourceLimitsStateResponse)(nil), "dfuse.eosio.statedb.v1.GetResourceLimitsStateResponse")
+	proto.RegisterType((*GetCodeRequest)(nil), "dfuse.eosio.statedb.v1.GetCodeRequest")
+	proto.RegisterType((*GetCodeResponse)(nil), "dfuse.eosio.statedb.v1.GetCodeResponse")
+	proto.RegisterType((*CodeDeployment)(nil), "dfuse.eosio.statedb.v1.CodeDeployment")
 	proto.RegisterType((*GetTableRowRequest)(nil), "dfuse.eosio.statedb.v1.GetTableRowRequest")
 	proto.RegisterType((*GetTableRowResponse)(nil), "dfuse.eosio.statedb.v1.GetTableRowResponse")
 	proto.RegisterType((*StreamTableRowsRequest)(nil), "dfuse.eosio.statedb.v1.StreamTableRowsRequest")
@@ -1116,76 +1916,116 @@ func init() {
 	proto.RegisterType((*TableRowsContractResponse)(nil), "dfuse.eosio.statedb.v1.TableRowsContractResponse")
 }
 
-func init() {
-	proto.RegisterFile("dfuse/eosio/statedb/v1/statedb.proto", fileDescriptor_7eba888d47f0653d)
-}
+func init() { proto.RegisterFile("dfuse/eosio/statedb/v1/statedb.proto", fileDescriptor_7eba888d47f0653d) }
 
 var fileDescriptor_7eba888d47f0653d = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x5f, 0x6f, 0xdc, 0x44,
-	0x10, 0x97, 0xef, 0x2e, 0xf7, 0x67, 0x2e, 0xa4, 0xe9, 0x42, 0xaf, 0x8e, 0xa1, 0x90, 0x5a, 0x01,
-	0x4e, 0xad, 0x7a, 0x7f, 0xc2, 0x13, 0xa5, 0x2f, 0x49, 0x85, 0x4e, 0x6d, 0xa0, 0x80, 0x13, 0x09,
-	0x89, 0x17, 0x6b, 0x7d, 0xd9, 0x50, 0x73, 0xb6, 0xd7, 0x78, 0xd7, 0x39, 0x59, 0xbc, 0xc2, 0x03,
-	0x8f, 0x20, 0xbe, 0x00, 0x9f, 0x83, 0x4f, 0xc3, 0xb7, 0xe0, 0x11, 0x79, 0xbd, 0xf6, 0xd9, 0x77,
-	0x67, 0xc7, 0x11, 0x2f, 0x95, 0x78, 0xdb, 0x99, 0xdd, 0xf9, 0xcd, 0xcc, 0x6f, 0xc7, 0xb3, 0x63,
-	0x38, 0xba, 0xbc, 0x0a, 0x19, 0x19, 0x13, 0xca, 0x6c, 0x3a, 0x66, 0x1c, 0x73, 0x72, 0x69, 0x8d,
-	0xaf, 0xa7, 0xe9, 0x72, 0xe4, 0x07, 0x94, 0x53, 0x34, 0x10, 0xa7, 0x46, 0xe2, 0xd4, 0x28, 0xdd,
-	0xba, 0x9e, 0x6a, 0xef, 0x27, 0xd6, 0x16, 0xe3, 0x01, 0xc1, 0x6e, 0x6c, 0x27, 0x97, 0x89, 0x9d,
-	0x8e, 0xe1, 0xad, 0x19, 0xe1, 0x27, 0xa7, 0x2f, 0x0c, 0xf2, 0x63, 0x48, 0x18, 0x47, 0x1a, 0x74,
-	0xe7, 0xd4, 0xe3, 0x01, 0x9e, 0x73, 0x55, 0x39, 0x54, 0x86, 0x3d, 0x23, 0x93, 0xd1, 0xbb, 0xd0,
-	0xb3, 0x1c, 0x3a, 0x5f, 0x98, 0x5e, 0xe8, 0xaa, 0x8d, 0x43, 0x65, 0xd8, 0x32, 0xba, 0x42, 0xf1,
-	0x2a, 0x74, 0xd1, 0x7d, 0xe8, 0x70, 0x6a, 0xfe, 0xc0, 0xa8, 0xa7, 0x36, 0x0f, 0x95, 0x61, 0xd7,
-	0x68, 0x73, 0xfa, 0x92, 0x51, 0x4f, 0xc7, 0xb0, 0x97, 0xba, 0x60, 0x3e, 0xf5, 0x18, 0x29, 0xe2,
-	0x28, 0x9b, 0x38, 0x01, 0x5e, 0x9a, 0xd8, 0xb2, 0x85, 0x8b, 0x5d, 0xa3, 0x1d, 0xe0, 0xe5, 0x89,
-	0x65, 0xa3, 0x03, 0xe8, 0xc6, 0xe8, 0x62, 0xa7, 0x29, 0x22, 0xeb, 0xc4, 0xf2, 0x89, 0x65, 0xeb,
-	0xe7, 0x70, 0x6f, 0x46, 0xf8, 0x19, 0x89, 0x4e, 0xe6, 0x73, 0x1a, 0x7a, 0x9c, 0xa5, 0xd9, 0x3c,
-	0x00, 0xf0, 0x43, 0xcb, 0xb1, 0xe7, 0xe6, 0x82, 0x44, 0x32, 0x9f, 0x5e, 0xa2, 0x39, 0x23, 0x51,
-	0x65, 0x42, 0xfa, 0x37, 0x30, 0x58, 0x07, 0xad, 0x13, 0xbf, 0x06, 0x5d, 0x2c, 0x0d, 0xd4, 0xc6,
-	0x61, 0x33, 0x26, 0x30, 0x95, 0x75, 0x03, 0x0e, 0x66, 0x84, 0x7f, 0x4d, 0x02, 0xd7, 0x66, 0xcc,
-	0xa6, 0xde, 0x17, 0xb6, 0xb7, 0xc8, 0x62, 0xad, 0x44, 0x55, 0xa1, 0x23, 0x51, 0x44, 0x9c, 0x3d,
-	0x23, 0x15, 0xf5, 0x7f, 0x14, 0xd0, 0xb6, 0x81, 0xca, 0x58, 0x9f, 0x42, 0x3f, 0xf4, 0x4d, 0x4e,
-	0x4d, 0x01, 0x25, 0x70, 0xfb, 0xc7, 0xda, 0x28, 0x29, 0x97, 0xb4, 0x16, 0xae, 0xa7, 0xa3, 0xd3,
-	0x78, 0xdb, 0x20, 0x57, 0x46, 0x2f, 0xf4, 0x2f, 0xa8, 0x90, 0x90, 0x01, 0xf7, 0x1d, 0xcc, 0xb8,
-	0x69, 0x07, 0x01, 0xb9, 0x26, 0x01, 0xb3, 0x2d, 0x87, 0x48, 0x9c, 0xc6, 0x8d, 0x38, 0xf7, 0x62,
-	0xd3, 0x17, 0x39, 0xcb, 0x04, 0xf3, 0x25, 0xf4, 0xfd, 0x2c, 0x54, 0xa6, 0x36, 0x0f, 0x9b, 0xc3,
-	0xfe, 0xf1, 0x70, 0xb4, 0xbd, 0x7c, 0x47, 0x71, 0x2e, 0xe4, 0x72, 0x95, 0x9b, 0x91, 0x37, 0xd6,
-	0x29, 0xec, 0xaf, 0x1f, 0xa8, 0xac, 0xdf, 0x01, 0xb4, 0xf1, 0x9c, 0xdb, 0xd4, 0x93, 0x1c, 0x4a,
-	0x09, 0x7d, 0x0c, 0x77, 0x56, 0xb0, 0xa6, 0x87, 0x5d, 0x22, 0x0b, 0x6c, 0x6f, 0xa5, 0x7e, 0x85,
-	0x5d, 0xa2, 0xff, 0xd9, 0x00, 0x34, 0x23, 0xfc, 0x02, 0x5b, 0x0e, 0x31, 0xe8, 0xb2, 0xd6, 0xcd,
-	0x1d, 0x40, 0x77, 0x41, 0x22, 0x93, 0x47, 0x3e, 0x49, 0xaf, 0x6e, 0x41, 0xa2, 0x8b, 0xc8, 0x27,
-	0xa5, 0x9f, 0x0c, 0x3a, 0x82, 0xbd, 0xa5, 0xcd, 0x5f, 0x9b, 0x2b, 0xd4, 0x96, 0xd8, 0xdf, 0x8d,
-	0xb5, 0xa7, 0x29, 0xf2, 0x63, 0xb8, 0x5b, 0xb8, 0x19, 0xea, 0x39, 0x91, 0xba, 0x23, 0x0e, 0xee,
-	0xe7, 0x37, 0xbe, 0xf2, 0x9c, 0xa8, 0xc0, 0x4b, 0x7b, 0x8d, 0x97, 0x77, 0x60, 0x87, 0xc7, 0x29,
-	0xa9, 0x1d, 0xb1, 0x91, 0x08, 0xb1, 0x96, 0xcd, 0xa9, 0x4f, 0xd4, 0x6e, 0xa2, 0x15, 0x02, 0xfa,
-	0x00, 0xfa, 0x7e, 0x60, 0xbb, 0x38, 0x88, 0xc4, 0x27, 0xd5, 0x13, 0x7b, 0x20, 0x55, 0x67, 0x24,
-	0xd2, 0xff, 0x56, 0xe0, 0xed, 0x02, 0x47, 0x6f, 0x68, 0x21, 0x3e, 0x85, 0x66, 0x40, 0x97, 0x82,
-	0xf8, 0x8a, 0x02, 0x5c, 0x4f, 0xc3, 0x88, 0x8d, 0xf4, 0x9f, 0x1b, 0x30, 0x38, 0x17, 0x9e, 0xd2,
-	0x7d, 0xf6, 0x3f, 0xac, 0x05, 0xfd, 0x17, 0x05, 0xf6, 0x37, 0xee, 0x79, 0x1f, 0x9a, 0xab, 0x5e,
-	0x1b, 0x2f, 0x11, 0x82, 0xd6, 0x25, 0xe6, 0x58, 0xb6, 0x73, 0xb1, 0x8e, 0x75, 0x59, 0xae, 0x3d,
-	0x43, 0xac, 0x63, 0x27, 0x3e, 0x8e, 0x48, 0x20, 0x12, 0xec, 0x19, 0x89, 0x80, 0x1e, 0xc2, 0x6e,
-	0x96, 0xba, 0x45, 0x02, 0x91, 0x54, 0xcb, 0xe8, 0xa7, 0x9c, 0x5a, 0x24, 0xd0, 0x6d, 0x50, 0x73,
-	0xb7, 0x71, 0x1e, 0xc7, 0x56, 0xef, 0x3e, 0xf2, 0x44, 0x34, 0xca, 0x88, 0x68, 0xe6, 0x88, 0xd0,
-	0x67, 0x80, 0x56, 0x4e, 0xea, 0x3d, 0x08, 0x19, 0x77, 0x8d, 0x3c, 0x77, 0xbf, 0x35, 0xe0, 0x61,
-	0x12, 0xf4, 0x97, 0xa1, 0xc3, 0xed, 0x24, 0xe8, 0xdb, 0x55, 0xd3, 0xad, 0xa3, 0x2f, 0xd4, 0x5f,
-	0xab, 0xb4, 0xfe, 0x76, 0x6e, 0xa8, 0xbf, 0x76, 0xdd, 0xfa, 0xeb, 0x94, 0xd4, 0xdf, 0x00, 0xda,
-	0x82, 0x04, 0xa6, 0x76, 0xc5, 0x03, 0x29, 0x25, 0xfd, 0x8f, 0x06, 0x1c, 0xe5, 0x38, 0x79, 0x2e,
-	0x93, 0xb9, 0x25, 0x2d, 0x5b, 0xf9, 0x7e, 0xb3, 0x09, 0x79, 0x0f, 0x7a, 0xe9, 0xcd, 0xa5, 0x9c,
-	0xac, 0x14, 0xba, 0x03, 0x83, 0x8c, 0x81, 0x62, 0xdd, 0x65, 0xa9, 0x2a, 0xf9, 0x54, 0x9f, 0x41,
-	0x2b, 0xa0, 0x4b, 0xa6, 0xb6, 0xaa, 0xdf, 0xd6, 0x8d, 0xd6, 0x26, 0xac, 0xf4, 0x10, 0x0e, 0x32,
-	0x6f, 0xe9, 0x0d, 0x64, 0x0e, 0xab, 0x5e, 0xd7, 0xff, 0xe4, 0xf6, 0xf8, 0xaf, 0x0e, 0xec, 0x9c,
-	0xc7, 0xa7, 0xd0, 0xb7, 0xd0, 0x4e, 0xe6, 0x45, 0xf4, 0x61, 0x19, 0x46, 0x61, 0x64, 0xd5, 0x3e,
-	0xba, 0xe9, 0x98, 0x0c, 0x9e, 0x8a, 0x41, 0x34, 0x37, 0xd0, 0xa1, 0x27, 0x15, 0x96, 0x9b, 0xd3,
-	0xa4, 0x36, 0xaa, 0x7b, 0x5c, 0x3a, 0xfc, 0x49, 0x4c, 0x0b, 0x6b, 0x93, 0x19, 0x9a, 0x56, 0xa0,
-	0x6c, 0x1f, 0x0d, 0xb5, 0xe3, 0xdb, 0x98, 0x48, 0xe7, 0x57, 0xd0, 0xcf, 0x3d, 0xc3, 0xe8, 0x51,
-	0x05, 0xc4, 0xda, 0x3c, 0xa3, 0x3d, 0xae, 0x75, 0x56, 0xfa, 0x71, 0xe1, 0xce, 0xda, 0x53, 0x88,
-	0x4a, 0x79, 0xda, 0xfe, 0x66, 0x6a, 0xb5, 0x6b, 0x65, 0xa2, 0x20, 0x06, 0x77, 0x37, 0x7a, 0x3d,
-	0x9a, 0xd4, 0x70, 0x58, 0x78, 0x16, 0xb4, 0x47, 0x95, 0x2e, 0x0b, 0x5f, 0xd9, 0x44, 0x41, 0xbf,
-	0x2a, 0xa0, 0x95, 0x37, 0x6b, 0xf4, 0x69, 0xb5, 0xfb, 0x8a, 0x06, 0x5f, 0x5e, 0x52, 0xdb, 0xbf,
-	0xf8, 0x89, 0x82, 0x7e, 0x57, 0xe0, 0x41, 0x65, 0x93, 0x44, 0xcf, 0x6a, 0x84, 0x53, 0xda, 0x5b,
-	0xb5, 0xe9, 0x8d, 0x11, 0xad, 0x77, 0x85, 0x89, 0x72, 0xfa, 0xf9, 0x77, 0xcf, 0xbf, 0xb7, 0xf9,
-	0xeb, 0xd0, 0x1a, 0xcd, 0xa9, 0x3b, 0x16, 0x00, 0x4f, 0x6c, 0x2a, 0x17, 0xc9, 0xaf, 0xab, 0x6f,
-	0x8d, 0xb7, 0xff, 0xc9, 0x7e, 0xe6, 0x5b, 0x52, 0xb0, 0xda, 0xe2, 0xa7, 0xf4, 0x93, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xcc, 0x45, 0x01, 0x9c, 0xf4, 0x0e, 0x00, 0x00,
+	// 1695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xdb, 0x6f, 0xdc, 0x44,
+	0x17, 0x97, 0xf7, 0x96, 0xdd, 0xb3, 0xb9, 0x75, 0xda, 0xa6, 0x1b, 0xe7, 0x6b, 0x9b, 0x5a, 0xfd,
+	0xda, 0xb4, 0x55, 0x37, 0x97, 0xea, 0xfb, 0x10, 0xa5, 0x20, 0x25, 0x29, 0x4a, 0x6f, 0x14, 0x70,
+	0x52, 0x90, 0xfa, 0x62, 0xd9, 0xbb, 0x93, 0xc4, 0x64, 0xed, 0x71, 0xed, 0xf1, 0x46, 0xab, 0x22,
+	0x54, 0x21, 0x21, 0xc4, 0x13, 0x02, 0xf1, 0x8e, 0x78, 0xe2, 0xbf, 0xe1, 0x0f, 0xe0, 0x8d, 0x7f,
+	0x80, 0x27, 0x24, 0x78, 0x44, 0x73, 0xb1, 0xd7, 0xde, 0x5d, 0x3b, 0xbb, 0x54, 0xaa, 0x22, 0xf1,
+	0x36, 0x73, 0xe6, 0x5c, 0xe6, 0x9c, 0xf3, 0xf3, 0xcc, 0x99, 0x63, 0xb8, 0xda, 0xde, 0x0f, 0x03,
+	0xbc, 0x8a, 0x49, 0x60, 0x93, 0xd5, 0x80, 0x9a, 0x14, 0xb7, 0xad, 0xd5, 0xee, 0x7a, 0x34, 0x6c,
+	0x7a, 0x3e, 0xa1, 0x04, 0x2d, 0x70, 0xae, 0x26, 0xe7, 0x6a, 0x46, 0x4b, 0xdd, 0x75, 0xf5, 0xf2,
+	0x01, 0x21, 0x07, 0x1d, 0xbc, 0xca, 0xb9, 0xac, 0x70, 0x7f, 0x95, 0xda, 0x0e, 0x0e, 0xa8, 0xe9,
+	0x78, 0x42, 0x50, 0xbd, 0x24, 0xd4, 0x5b, 0x01, 0xf5, 0xb1, 0xe9, 0x30, 0xc5, 0x72, 0x28, 0xd7,
+	0x97, 0x93, 0xe6, 0x5b, 0xa4, 0x8d, 0x5b, 0x8c, 0x87, 0x0f, 0x04, 0x87, 0x66, 0xc2, 0xcc, 0x0e,
+	0xa6, 0x9b, 0x5b, 0x0f, 0x75, 0xfc, 0x22, 0xc4, 0x01, 0x45, 0x2a, 0x54, 0x5b, 0xc4, 0xa5, 0xbe,
+	0xd9, 0xa2, 0x0d, 0x65, 0x59, 0x59, 0xa9, 0xe9, 0xf1, 0x1c, 0x2d, 0x41, 0xcd, 0xea, 0x90, 0xd6,
+	0x91, 0xe1, 0x86, 0x4e, 0xa3, 0xb0, 0xac, 0xac, 0x94, 0xf4, 0x2a, 0x27, 0x3c, 0x0d, 0x1d, 0x74,
+	0x01, 0xa6, 0x28, 0x31, 0x3e, 0x0b, 0x88, 0xdb, 0x28, 0x2e, 0x2b, 0x2b, 0x55, 0xbd, 0x42, 0xc9,
+	0xa3, 0x80, 0xb8, 0x9a, 0x09, 0xb3, 0x91, 0x89, 0xc0, 0x23, 0x6e, 0x80, 0xd3, 0x7a, 0x94, 0x61,
+	0x3d, 0xbe, 0x79, 0x6c, 0x98, 0x96, 0xcd, 0x4d, 0x4c, 0xeb, 0x15, 0xdf, 0x3c, 0xde, 0xb4, 0x6c,
+	0xb4, 0x08, 0x55, 0xa6, 0x9d, 0xaf, 0x14, 0xf9, 0xce, 0xa6, 0xd8, 0x7c, 0xd3, 0xb2, 0xb5, 0x5d,
+	0x38, 0xbf, 0x83, 0xe9, 0x63, 0xdc, 0xdb, 0x6c, 0xb5, 0x48, 0xe8, 0xd2, 0x20, 0xf2, 0xe6, 0x22,
+	0x80, 0x17, 0x5a, 0x1d, 0xbb, 0x65, 0x1c, 0xe1, 0x9e, 0xf4, 0xa7, 0x26, 0x28, 0x8f, 0x71, 0x2f,
+	0xd7, 0x21, 0xed, 0x63, 0x58, 0x18, 0x54, 0x3a, 0xce, 0xfe, 0x55, 0xa8, 0x9a, 0x52, 0xa0, 0x51,
+	0x58, 0x2e, 0xb2, 0x00, 0x46, 0x73, 0x4d, 0x87, 0xc5, 0x1d, 0x4c, 0x3f, 0xc2, 0xbe, 0x63, 0x07,
+	0x81, 0x4d, 0xdc, 0x27, 0xb6, 0x7b, 0x14, 0xef, 0x35, 0x57, 0x6b, 0x03, 0xa6, 0xa4, 0x16, 0xbe,
+	0xcf, 0x9a, 0x1e, 0x4d, 0xb5, 0xbf, 0x14, 0x50, 0x47, 0x29, 0x95, 0x7b, 0xbd, 0x0b, 0xf5, 0xd0,
+	0x33, 0x28, 0x31, 0xb8, 0x2a, 0xae, 0xb7, 0xbe, 0xa1, 0x36, 0x05, 0xe2, 0x22, 0xb4, 0x74, 0xd7,
+	0x9b, 0x5b, 0x6c, 0x59, 0xc7, 0xfb, 0x7a, 0x2d, 0xf4, 0xf6, 0x08, 0x9f, 0x21, 0x1d, 0x2e, 0x74,
+	0xcc, 0x80, 0x1a, 0xb6, 0xef, 0xe3, 0x2e, 0xf6, 0x03, 0xdb, 0xea, 0x60, 0xa9, 0xa7, 0x70, 0xa2,
+	0x9e, 0xf3, 0x4c, 0xf4, 0x61, 0x42, 0x52, 0xe8, 0x7c, 0x04, 0x75, 0x2f, 0xde, 0x6a, 0xd0, 0x28,
+	0x2e, 0x17, 0x57, 0xea, 0x1b, 0x2b, 0xcd, 0xd1, 0x5f, 0x40, 0x93, 0xf9, 0x82, 0xdb, 0x7d, 0xdf,
+	0xf4, 0xa4, 0xb0, 0x46, 0x60, 0x7e, 0x90, 0x21, 0x17, 0xbf, 0x0b, 0x50, 0x31, 0x5b, 0xd4, 0x26,
+	0xae, 0x8c, 0xa1, 0x9c, 0xa1, 0xeb, 0x30, 0xd7, 0x57, 0x6b, 0xb8, 0xa6, 0x83, 0x25, 0xc0, 0x66,
+	0xfb, 0xe4, 0xa7, 0xa6, 0x83, 0xb5, 0x97, 0x1c, 0x67, 0x7d, 0x6b, 0xaf, 0x99, 0x3b, 0x74, 0x0b,
+	0xce, 0xa4, 0x62, 0x4b, 0xdc, 0x4e, 0x4f, 0x7e, 0x3d, 0xf3, 0xc9, 0x85, 0x0f, 0xdd, 0x4e, 0x4f,
+	0xfb, 0x5d, 0x81, 0x85, 0x41, 0xeb, 0xa7, 0x34, 0xc9, 0xf7, 0x47, 0x25, 0x59, 0xcb, 0x4a, 0x72,
+	0x56, 0x7a, 0x5f, 0x29, 0xd0, 0xd8, 0xe5, 0x36, 0x27, 0x8d, 0x78, 0xce, 0x37, 0x38, 0x59, 0xcc,
+	0x3f, 0x07, 0x55, 0x7e, 0xfd, 0xa3, 0xc2, 0x9e, 0x48, 0xac, 0x92, 0x4e, 0xec, 0x40, 0x00, 0x0a,
+	0xff, 0x2c, 0x00, 0xbf, 0x2a, 0x00, 0xfd, 0x35, 0x84, 0xa0, 0xc4, 0xb1, 0x29, 0x6c, 0xf1, 0x31,
+	0x83, 0xb4, 0x67, 0xfa, 0x38, 0x86, 0x96, 0x9c, 0xa1, 0x77, 0x61, 0x9a, 0x67, 0x35, 0xf4, 0xda,
+	0xcc, 0x4c, 0xa3, 0x28, 0x53, 0x29, 0x6e, 0x94, 0x66, 0x74, 0xa3, 0x34, 0xf7, 0xa2, 0x1b, 0x45,
+	0xaf, 0x33, 0xfe, 0x67, 0x82, 0x1d, 0xdd, 0x87, 0x19, 0x1f, 0xbf, 0x08, 0x6d, 0x1f, 0xb7, 0x0d,
+	0x33, 0xa4, 0x87, 0x8d, 0x12, 0x97, 0xbf, 0x9c, 0xf2, 0x40, 0xdc, 0x23, 0xdd, 0xf5, 0xe6, 0x66,
+	0x48, 0x0f, 0x89, 0x6f, 0xd3, 0x9e, 0x3e, 0x1d, 0x49, 0x31, 0x52, 0x3a, 0x47, 0xe5, 0x81, 0xe3,
+	0xf5, 0x95, 0x38, 0xb7, 0x64, 0x78, 0x75, 0x1c, 0x90, 0xd0, 0x6f, 0xe1, 0x37, 0xfa, 0x45, 0xfd,
+	0x52, 0x84, 0xa5, 0x91, 0x5b, 0x38, 0xa5, 0x9f, 0xd5, 0x26, 0x54, 0x3a, 0xb6, 0x63, 0xd3, 0x40,
+	0xa6, 0xf3, 0xc6, 0xe8, 0x74, 0xe8, 0x9c, 0x49, 0x7a, 0xf5, 0x84, 0x0b, 0xe8, 0x52, 0x10, 0xad,
+	0xc0, 0xbc, 0x18, 0x19, 0xfd, 0xe8, 0x96, 0x78, 0x74, 0x67, 0x05, 0x7d, 0x2b, 0x8a, 0xf1, 0x7b,
+	0x50, 0x0e, 0x03, 0xf3, 0x00, 0xf3, 0xc4, 0x0d, 0x1e, 0xd1, 0xa3, 0x6d, 0x3d, 0x63, 0xfc, 0xba,
+	0x10, 0x43, 0xd7, 0x60, 0x8e, 0x0f, 0x12, 0x86, 0x2a, 0xdc, 0xd0, 0x0c, 0x27, 0xc7, 0x76, 0x96,
+	0xa0, 0xe6, 0x9b, 0x8e, 0x21, 0x6c, 0x4d, 0x89, 0x44, 0xfb, 0xa6, 0xc3, 0x75, 0xa1, 0xdb, 0x70,
+	0x36, 0x5e, 0x4c, 0x28, 0xaa, 0x72, 0xb6, 0xf9, 0x88, 0x2d, 0xd2, 0xa5, 0xd9, 0x70, 0x71, 0x07,
+	0xc7, 0x89, 0x14, 0xae, 0xef, 0xb2, 0xef, 0x6c, 0x2c, 0x54, 0x8d, 0xc4, 0x4e, 0x21, 0x03, 0x3b,
+	0x7f, 0x16, 0xe0, 0x52, 0x96, 0xad, 0x53, 0x0a, 0x9f, 0xbb, 0x50, 0x69, 0x11, 0x77, 0xdf, 0x3e,
+	0x90, 0xf0, 0xd1, 0xf2, 0x52, 0xba, 0xcd, 0x39, 0x75, 0x29, 0xc1, 0x70, 0x23, 0x46, 0xc3, 0xb8,
+	0x11, 0xf4, 0x38, 0x9f, 0x6f, 0x41, 0x99, 0x1f, 0x6d, 0x12, 0x37, 0x57, 0xf2, 0x8c, 0x88, 0x78,
+	0x09, 0x7e, 0x06, 0x18, 0x3e, 0x18, 0x06, 0x0c, 0x27, 0xc7, 0x49, 0xfe, 0x51, 0xe1, 0x05, 0xe5,
+	0x36, 0x69, 0xe3, 0x37, 0x78, 0x58, 0xa0, 0x1b, 0x30, 0x7f, 0x6c, 0xd3, 0x43, 0xa3, 0x8d, 0xbd,
+	0x0e, 0xe9, 0x39, 0x98, 0xdd, 0x2d, 0x25, 0xce, 0x3b, 0xc7, 0xe8, 0xf7, 0xfb, 0x64, 0xed, 0xe7,
+	0x02, 0xcc, 0xc5, 0x3b, 0x3c, 0xb5, 0x60, 0x28, 0xb1, 0x64, 0x48, 0x28, 0x5c, 0xcb, 0xba, 0x9a,
+	0x98, 0x0f, 0x7d, 0xd7, 0x74, 0x2e, 0x83, 0x1e, 0x40, 0x3d, 0x1d, 0x85, 0xe2, 0x04, 0x2a, 0x92,
+	0xa2, 0xda, 0x97, 0x0a, 0xcc, 0xa6, 0xd7, 0xf3, 0x73, 0xb9, 0x04, 0x35, 0xb6, 0x03, 0xe3, 0xd0,
+	0x0c, 0x0e, 0x65, 0x36, 0xab, 0x8c, 0xf0, 0xc0, 0x0c, 0x0e, 0xd9, 0xcb, 0xa1, 0xeb, 0x18, 0xb4,
+	0xe7, 0x09, 0xaf, 0x66, 0xf4, 0x4a, 0xd7, 0xd9, 0xeb, 0x79, 0x98, 0xbd, 0x02, 0xba, 0x8e, 0xc1,
+	0xfd, 0x27, 0x2e, 0x4f, 0xda, 0x8c, 0x5e, 0xeb, 0x3a, 0x9f, 0x08, 0x82, 0xf6, 0x53, 0x01, 0xd0,
+	0x0e, 0xa6, 0x7b, 0xa6, 0xd5, 0xc1, 0x3a, 0x39, 0x1e, 0x0b, 0x54, 0x8b, 0x50, 0x3d, 0xc2, 0x3d,
+	0x61, 0x4c, 0xa2, 0xea, 0x08, 0xf7, 0xb8, 0xb5, 0xac, 0x87, 0x10, 0xba, 0x0a, 0xb3, 0x1c, 0x41,
+	0xe9, 0x2f, 0xa8, 0xaa, 0x4f, 0x33, 0xea, 0x56, 0xee, 0x29, 0x54, 0xce, 0x00, 0x65, 0xb2, 0xda,
+	0xad, 0x0c, 0x54, 0xbb, 0xe7, 0xa0, 0x4c, 0x99, 0x4b, 0xfc, 0x50, 0xad, 0xe9, 0x62, 0xc2, 0xa8,
+	0x41, 0x8b, 0x78, 0x98, 0x9f, 0xa1, 0x35, 0x5d, 0x4c, 0xd0, 0x65, 0xa8, 0x7b, 0xbe, 0xed, 0x98,
+	0x7e, 0x8f, 0x3f, 0x94, 0x6a, 0x7c, 0x0d, 0x24, 0xe9, 0x31, 0xee, 0x69, 0xbf, 0x29, 0x70, 0x36,
+	0x15, 0xa3, 0x53, 0x0b, 0xeb, 0xa2, 0x4f, 0x8e, 0x1b, 0xc5, 0x11, 0x77, 0x56, 0x02, 0x92, 0x83,
+	0x6e, 0xe8, 0x4c, 0x48, 0xfb, 0xa3, 0x00, 0x0b, 0xa2, 0xde, 0x8c, 0xd6, 0x83, 0x7f, 0x29, 0x16,
+	0x3a, 0xe4, 0x18, 0xfb, 0x86, 0x45, 0x42, 0xb7, 0x1d, 0x61, 0x81, 0x93, 0xb6, 0x18, 0x85, 0x31,
+	0x84, 0x9e, 0x17, 0x33, 0x80, 0x60, 0xe0, 0x24, 0xc1, 0x70, 0x0e, 0xca, 0xfc, 0x78, 0x6f, 0xd4,
+	0x79, 0xa4, 0xc4, 0x84, 0x95, 0xaa, 0xad, 0xd0, 0x0f, 0x88, 0xdf, 0x98, 0x16, 0xa5, 0xaa, 0x98,
+	0x69, 0x5f, 0x29, 0x30, 0x3f, 0x84, 0xab, 0x79, 0x28, 0xf6, 0x5f, 0xec, 0x6c, 0xc8, 0xaa, 0xdf,
+	0xb6, 0x49, 0x4d, 0xd9, 0x14, 0xe0, 0x63, 0x46, 0x8b, 0x63, 0x5b, 0xd3, 0xf9, 0x98, 0x19, 0xf7,
+	0xcc, 0x1e, 0xf6, 0x79, 0x40, 0x6b, 0xba, 0x98, 0xa0, 0x2b, 0x30, 0x1d, 0x87, 0xda, 0xc2, 0xbe,
+	0xac, 0x46, 0xeb, 0x51, 0x0e, 0x2d, 0xec, 0x6b, 0x76, 0xf4, 0xda, 0xe0, 0x9b, 0xd9, 0x65, 0xb1,
+	0x18, 0xfb, 0xb5, 0x11, 0x07, 0xbe, 0x90, 0x15, 0xf8, 0x62, 0x22, 0xf0, 0xda, 0x0e, 0xa0, 0xbe,
+	0x91, 0xf1, 0xda, 0x0a, 0x71, 0xae, 0x0a, 0x89, 0x5c, 0x69, 0xdf, 0x15, 0xe0, 0x8a, 0xd8, 0xf4,
+	0x07, 0x61, 0x87, 0xda, 0x62, 0xd3, 0x93, 0xa1, 0x77, 0xe2, 0xdd, 0xa7, 0xf0, 0x5e, 0xca, 0xc4,
+	0x7b, 0xf9, 0x04, 0xbc, 0x57, 0xc6, 0xc5, 0xfb, 0x54, 0x06, 0xde, 0x17, 0xa0, 0xc2, 0x83, 0x10,
+	0x34, 0xaa, 0xfc, 0x89, 0x27, 0x67, 0xda, 0x0f, 0x05, 0xb8, 0x9a, 0x88, 0xc9, 0xb6, 0x74, 0x66,
+	0xc2, 0xb0, 0x8c, 0x8c, 0xf7, 0xe9, 0x0e, 0xc8, 0x7f, 0xd8, 0xe5, 0x28, 0x9d, 0x95, 0x31, 0xe9,
+	0x13, 0xb4, 0x0e, 0x2c, 0xc4, 0x11, 0x48, 0xe3, 0x2e, 0x76, 0x55, 0x49, 0xba, 0x7a, 0x0f, 0x4a,
+	0x3e, 0x39, 0x8e, 0x6e, 0xf7, 0xf1, 0x8f, 0x52, 0x2e, 0xa5, 0x85, 0xb0, 0x18, 0x5b, 0x8b, 0x32,
+	0x10, 0x1b, 0xcc, 0xeb, 0xd1, 0xbc, 0x96, 0xd9, 0x8d, 0x6f, 0xa7, 0xa1, 0xcc, 0x8b, 0x4a, 0xf4,
+	0x29, 0x54, 0x44, 0xd7, 0x11, 0xfd, 0x37, 0x4b, 0x47, 0xaa, 0xf1, 0xa9, 0x5e, 0x3b, 0x89, 0x4d,
+	0x6e, 0x9e, 0xc0, 0x6c, 0xba, 0x2d, 0x88, 0x6e, 0xe7, 0x48, 0x0e, 0xf7, 0x24, 0xd5, 0xe6, 0xb8,
+	0xec, 0xd2, 0xe0, 0x4b, 0x5e, 0x9d, 0x0c, 0xf4, 0xf7, 0xd0, 0x7a, 0x8e, 0x96, 0xd1, 0x0d, 0x46,
+	0x75, 0x63, 0x12, 0x91, 0x94, 0xb7, 0xfd, 0xd5, 0x7c, 0x6f, 0x87, 0xfb, 0x34, 0x6a, 0x73, 0x5c,
+	0xf6, 0xd8, 0xdb, 0x33, 0x43, 0x3d, 0x1f, 0xb4, 0x96, 0xa5, 0x24, 0xab, 0x3d, 0x94, 0xed, 0x6b,
+	0x76, 0x3b, 0x67, 0x4d, 0x41, 0x5f, 0xf0, 0x22, 0x67, 0xb0, 0x1f, 0x80, 0xf2, 0x02, 0x97, 0xd1,
+	0xbf, 0x50, 0xef, 0x4c, 0x24, 0x23, 0x9d, 0xff, 0x5a, 0xb4, 0xf8, 0x46, 0x3c, 0x2a, 0xd1, 0xff,
+	0x72, 0xf4, 0x65, 0x3f, 0x78, 0xd5, 0xff, 0x4f, 0x2a, 0x26, 0x77, 0xf2, 0x1c, 0xa6, 0xe4, 0x0b,
+	0x06, 0xe5, 0x7d, 0x18, 0x89, 0x47, 0x98, 0x7a, 0xfd, 0x44, 0x3e, 0xa9, 0x7b, 0x1f, 0xea, 0x89,
+	0x52, 0x12, 0xdd, 0xcc, 0x91, 0x1b, 0xa8, 0xc9, 0xd5, 0x5b, 0x63, 0xf1, 0x4a, 0x3b, 0x0e, 0xcc,
+	0x0d, 0x94, 0x73, 0xa8, 0x99, 0x0f, 0xa4, 0xc1, 0x2b, 0x42, 0x1d, 0xfb, 0xfc, 0x59, 0x53, 0x50,
+	0x10, 0x21, 0x37, 0x51, 0x3f, 0x9c, 0x84, 0xdc, 0xe1, 0x52, 0x43, 0xbd, 0x99, 0x6b, 0x32, 0x75,
+	0x72, 0xaf, 0x29, 0xe8, 0x1b, 0x05, 0xd4, 0xec, 0x02, 0x00, 0xbd, 0x9d, 0x6f, 0x3e, 0xa7, 0x68,
+	0xc8, 0xfe, 0x70, 0x47, 0xdf, 0x22, 0x6b, 0x0a, 0xfa, 0x5e, 0x81, 0x8b, 0xb9, 0x17, 0x2f, 0xba,
+	0x37, 0xc6, 0x76, 0x32, 0xef, 0x6b, 0x75, 0xfd, 0xc4, 0x1d, 0x0d, 0xde, 0x34, 0x6b, 0xca, 0xd6,
+	0xfb, 0xcf, 0xb7, 0x0f, 0x6c, 0x7a, 0x18, 0x5a, 0xcd, 0x16, 0x71, 0x56, 0xb9, 0x82, 0xdb, 0x36,
+	0x91, 0x03, 0xf1, 0x63, 0xcc, 0xb3, 0x56, 0x47, 0xff, 0xa6, 0x7b, 0xc7, 0xb3, 0xe4, 0xc4, 0xaa,
+	0xf0, 0x8e, 0xe9, 0x9d, 0xbf, 0x07, 0x00, 0x75, 0x07, 0xdf, 0xcd, 0xd1, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1200,21 +2040,18 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type StateClient interface {
-	// Replaces /v0/state/abi
 	GetABI(ctx context.Context, in *GetABIRequest, opts ...grpc.CallOption) (*GetABIResponse, error)
-	// Replaces /v0/state/key_accounts
 	GetKeyAccounts(ctx context.Context, in *GetKeyAccountsRequest, opts ...grpc.CallOption) (*GetKeyAccountsResponse, error)
-	// Replaces /v0/state/permission_links
 	GetPermissionLinks(ctx context.Context, in *GetPermissionLinksRequest, opts ...grpc.CallOption) (*GetPermissionLinksResponse, error)
-	// Replaces /v0/state/table/row
+	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	StreamPermissions(ctx context.Context, in *StreamPermissionsRequest, opts ...grpc.CallOption) (State_StreamPermissionsClient, error)
+	GetAccountResources(ctx context.Context, in *GetAccountResourcesRequest, opts ...grpc.CallOption) (*GetAccountResourcesResponse, error)
+	GetResourceLimitsState(ctx context.Context, in *GetResourceLimitsStateRequest, opts ...grpc.CallOption) (*GetResourceLimitsStateResponse, error)
+	GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error)
 	GetTableRow(ctx context.Context, in *GetTableRowRequest, opts ...grpc.CallOption) (*GetTableRowResponse, error)
-	// Replaces /v0/state/table
 	StreamTableRows(ctx context.Context, in *StreamTableRowsRequest, opts ...grpc.CallOption) (State_StreamTableRowsClient, error)
-	// Replaces /v0/state/table_scopes
 	StreamTableScopes(ctx context.Context, in *StreamTableScopesRequest, opts ...grpc.CallOption) (State_StreamTableScopesClient, error)
-	// Replaces /v0/state/tables/scopes
 	StreamMultiScopesTableRows(ctx context.Context, in *StreamMultiScopesTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiScopesTableRowsClient, error)
-	// Replaces /v0/state/tables/accounts
 	StreamMultiContractsTableRows(ctx context.Context, in *StreamMultiContractsTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiContractsTableRowsClient, error)
 }
 
@@ -1253,6 +2090,74 @@ func (c *stateClient) GetPermissionLinks(ctx context.Context, in *GetPermissionL
 	return out, nil
 }
 
+func (c *stateClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	out := new(GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.statedb.v1.State/GetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateClient) StreamPermissions(ctx context.Context, in *StreamPermissionsRequest, opts ...grpc.CallOption) (State_StreamPermissionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[0], "/dfuse.eosio.statedb.v1.State/StreamPermissions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateStreamPermissionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type State_StreamPermissionsClient interface {
+	Recv() (*AccountPermissionsResponse, error)
+	grpc.ClientStream
+}
+
+type stateStreamPermissionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateStreamPermissionsClient) Recv() (*AccountPermissionsResponse, error) {
+	m := new(AccountPermissionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stateClient) GetAccountResources(ctx context.Context, in *GetAccountResourcesRequest, opts ...grpc.CallOption) (*GetAccountResourcesResponse, error) {
+	out := new(GetAccountResourcesResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.statedb.v1.State/GetAccountResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateClient) GetResourceLimitsState(ctx context.Context, in *GetResourceLimitsStateRequest, opts ...grpc.CallOption) (*GetResourceLimitsStateResponse, error) {
+	out := new(GetResourceLimitsStateResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.statedb.v1.State/GetResourceLimitsState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateClient) GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error) {
+	out := new(GetCodeResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.statedb.v1.State/GetCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stateClient) GetTableRow(ctx context.Context, in *GetTableRowRequest, opts ...grpc.CallOption) (*GetTableRowResponse, error) {
 	out := new(GetTableRowResponse)
 	err := c.cc.Invoke(ctx, "/dfuse.eosio.statedb.v1.State/GetTableRow", in, out, opts...)
@@ -1263,7 +2168,7 @@ func (c *stateClient) GetTableRow(ctx context.Context, in *GetTableRowRequest, o
 }
 
 func (c *stateClient) StreamTableRows(ctx context.Context, in *StreamTableRowsRequest, opts ...grpc.CallOption) (State_StreamTableRowsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[0], "/dfuse.eosio.statedb.v1.State/StreamTableRows", opts...)
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[1], "/dfuse.eosio.statedb.v1.State/StreamTableRows", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1295,7 +2200,7 @@ func (x *stateStreamTableRowsClient) Recv() (*TableRowResponse, error) {
 }
 
 func (c *stateClient) StreamTableScopes(ctx context.Context, in *StreamTableScopesRequest, opts ...grpc.CallOption) (State_StreamTableScopesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[1], "/dfuse.eosio.statedb.v1.State/StreamTableScopes", opts...)
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[2], "/dfuse.eosio.statedb.v1.State/StreamTableScopes", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,7 +2232,7 @@ func (x *stateStreamTableScopesClient) Recv() (*TableScopeResponse, error) {
 }
 
 func (c *stateClient) StreamMultiScopesTableRows(ctx context.Context, in *StreamMultiScopesTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiScopesTableRowsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[2], "/dfuse.eosio.statedb.v1.State/StreamMultiScopesTableRows", opts...)
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[3], "/dfuse.eosio.statedb.v1.State/StreamMultiScopesTableRows", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1359,7 +2264,7 @@ func (x *stateStreamMultiScopesTableRowsClient) Recv() (*TableRowsScopeResponse,
 }
 
 func (c *stateClient) StreamMultiContractsTableRows(ctx context.Context, in *StreamMultiContractsTableRowsRequest, opts ...grpc.CallOption) (State_StreamMultiContractsTableRowsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[3], "/dfuse.eosio.statedb.v1.State/StreamMultiContractsTableRows", opts...)
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[4], "/dfuse.eosio.statedb.v1.State/StreamMultiContractsTableRows", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1392,21 +2297,18 @@ func (x *stateStreamMultiContractsTableRowsClient) Recv() (*TableRowsContractRes
 
 // StateServer is the server API for State service.
 type StateServer interface {
-	// Replaces /v0/state/abi
 	GetABI(context.Context, *GetABIRequest) (*GetABIResponse, error)
-	// Replaces /v0/state/key_accounts
 	GetKeyAccounts(context.Context, *GetKeyAccountsRequest) (*GetKeyAccountsResponse, error)
-	// Replaces /v0/state/permission_links
 	GetPermissionLinks(context.Context, *GetPermissionLinksRequest) (*GetPermissionLinksResponse, error)
-	// Replaces /v0/state/table/row
+	GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error)
+	StreamPermissions(*StreamPermissionsRequest, State_StreamPermissionsServer) error
+	GetAccountResources(context.Context, *GetAccountResourcesRequest) (*GetAccountResourcesResponse, error)
+	GetResourceLimitsState(context.Context, *GetResourceLimitsStateRequest) (*GetResourceLimitsStateResponse, error)
+	GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error)
 	GetTableRow(context.Context, *GetTableRowRequest) (*GetTableRowResponse, error)
-	// Replaces /v0/state/table
 	StreamTableRows(*StreamTableRowsRequest, State_StreamTableRowsServer) error
-	// Replaces /v0/state/table_scopes
 	StreamTableScopes(*StreamTableScopesRequest, State_StreamTableScopesServer) error
-	// Replaces /v0/state/tables/scopes
 	StreamMultiScopesTableRows(*StreamMultiScopesTableRowsRequest, State_StreamMultiScopesTableRowsServer) error
-	// Replaces /v0/state/tables/accounts
 	StreamMultiContractsTableRows(*StreamMultiContractsTableRowsRequest, State_StreamMultiContractsTableRowsServer) error
 }
 
@@ -1423,6 +2325,21 @@ func (*UnimplementedStateServer) GetKeyAccounts(ctx context.Context, req *GetKey
 func (*UnimplementedStateServer) GetPermissionLinks(ctx context.Context, req *GetPermissionLinksRequest) (*GetPermissionLinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionLinks not implemented")
 }
+func (*UnimplementedStateServer) GetPermissions(ctx context.Context, req *GetPermissionsRequest) (*GetPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (*UnimplementedStateServer) StreamPermissions(req *StreamPermissionsRequest, srv State_StreamPermissionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPermissions not implemented")
+}
+func (*UnimplementedStateServer) GetAccountResources(ctx context.Context, req *GetAccountResourcesRequest) (*GetAccountResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountResources not implemented")
+}
+func (*UnimplementedStateServer) GetResourceLimitsState(ctx context.Context, req *GetResourceLimitsStateRequest) (*GetResourceLimitsStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceLimitsState not implemented")
+}
+func (*UnimplementedStateServer) GetCode(ctx context.Context, req *GetCodeRequest) (*GetCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
+}
 func (*UnimplementedStateServer) GetTableRow(ctx context.Context, req *GetTableRowRequest) (*GetTableRowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTableRow not implemented")
 }
@@ -1497,6 +2414,99 @@ func _State_GetPermissionLinks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _State_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.statedb.v1.State/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _State_StreamPermissions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPermissionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServer).StreamPermissions(m, &stateStreamPermissionsServer{stream})
+}
+
+type State_StreamPermissionsServer interface {
+	Send(*AccountPermissionsResponse) error
+	grpc.ServerStream
+}
+
+type stateStreamPermissionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateStreamPermissionsServer) Send(m *AccountPermissionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _State_GetAccountResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetAccountResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.statedb.v1.State/GetAccountResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetAccountResources(ctx, req.(*GetAccountResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _State_GetResourceLimitsState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceLimitsStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetResourceLimitsState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.statedb.v1.State/GetResourceLimitsState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetResourceLimitsState(ctx, req.(*GetResourceLimitsStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _State_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.statedb.v1.State/GetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetCode(ctx, req.(*GetCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _State_GetTableRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTableRowRequest)
 	if err := dec(in); err != nil {
@@ -1615,12 +2625,33 @@ var _State_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissionLinks",
 			Handler:    _State_GetPermissionLinks_Handler,
 		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _State_GetPermissions_Handler,
+		},
+		{
+			MethodName: "GetAccountResources",
+			Handler:    _State_GetAccountResources_Handler,
+		},
+		{
+			MethodName: "GetResourceLimitsState",
+			Handler:    _State_GetResourceLimitsState_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _State_GetCode_Handler,
+		},
 		{
 			MethodName: "GetTableRow",
 			Handler:    _State_GetTableRow_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPermissions",
+			Handler:       _State_StreamPermissions_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamTableRows",
 			Handler:       _State_StreamTableRows_Handler,
@@ -5,7 +5,9 @@ package pbstatedb
 
 import (
 	fmt "fmt"
+	v1 "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
 )
 
@@ -145,8 +147,6 @@ func (m *ContractTableScopeValue) GetPayer() uint64 {
 	return 0
 }
 
-// KeyAccountValue is actual empty and the bool field is there mainly to permit a future
-// extension where we could add more fields in there.
 type KeyAccountValue struct {
 	Present              bool     `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -186,32 +186,103 @@ func (m *KeyAccountValue) GetPresent() bool {
 	return false
 }
 
+type PermissionValue struct {
+	Id                   uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId             uint64               `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	LastUpdated          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Authority            *v1.Authority        `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PermissionValue) Reset()         { *m = PermissionValue{} }
+func (m *PermissionValue) String() string { return proto.CompactTextString(m) }
+func (*PermissionValue) ProtoMessage()    {}
+func (*PermissionValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc566c0547764ba, []int{4}
+}
+
+func (m *PermissionValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionValue.Unmarshal(m, b)
+}
+func (m *PermissionValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionValue.Marshal(b, m, deterministic)
+}
+func (m *PermissionValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionValue.Merge(m, src)
+}
+func (m *PermissionValue) XXX_Size() int {
+	return xxx_messageInfo_PermissionValue.Size(m)
+}
+func (m *PermissionValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionValue proto.InternalMessageInfo
+
+func (m *PermissionValue) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PermissionValue) GetParentId() uint64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *PermissionValue) GetLastUpdated() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return nil
+}
+
+func (m *PermissionValue) GetAuthority() *v1.Authority {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthLinkValue)(nil), "dfuse.eosio.statedb.v1.AuthLinkValue")
 	proto.RegisterType((*ContractStateValue)(nil), "dfuse.eosio.statedb.v1.ContractStateValue")
 	proto.RegisterType((*ContractTableScopeValue)(nil), "dfuse.eosio.statedb.v1.ContractTableScopeValue")
 	proto.RegisterType((*KeyAccountValue)(nil), "dfuse.eosio.statedb.v1.KeyAccountValue")
+	proto.RegisterType((*PermissionValue)(nil), "dfuse.eosio.statedb.v1.PermissionValue")
 }
 
-func init() {
-	proto.RegisterFile("dfuse/eosio/statedb/v1/tablet.proto", fileDescriptor_5cc566c0547764ba)
-}
+func init() { proto.RegisterFile("dfuse/eosio/statedb/v1/tablet.proto", fileDescriptor_5cc566c0547764ba) }
 
 var fileDescriptor_5cc566c0547764ba = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x4f, 0x4b, 0x03, 0x31,
-	0x10, 0xc5, 0x59, 0xa9, 0x7f, 0x18, 0x14, 0x21, 0x88, 0xee, 0x49, 0xca, 0x7a, 0x29, 0x88, 0x09,
-	0xc5, 0xa3, 0x20, 0xd4, 0xe2, 0x49, 0x4f, 0x5b, 0xf1, 0xe0, 0x2d, 0xc9, 0x8e, 0x36, 0xd8, 0x66,
-	0x42, 0x32, 0x29, 0xf4, 0xdb, 0x4b, 0xb3, 0x5b, 0xf0, 0x20, 0xde, 0xde, 0x0b, 0xbf, 0xf7, 0xc8,
-	0x3c, 0xb8, 0xe9, 0x3e, 0x73, 0x42, 0x85, 0x94, 0x1c, 0xa9, 0xc4, 0x9a, 0xb1, 0x33, 0x6a, 0x33,
-	0x55, 0xac, 0xcd, 0x0a, 0x59, 0x86, 0x48, 0x4c, 0xe2, 0xb2, 0x40, 0xb2, 0x40, 0x72, 0x80, 0xe4,
-	0x66, 0xda, 0x28, 0x38, 0x9b, 0x65, 0x5e, 0xbe, 0x3a, 0xff, 0xfd, 0xae, 0x57, 0x19, 0xc5, 0x35,
-	0x40, 0xc0, 0xb8, 0x76, 0x29, 0x39, 0xf2, 0x75, 0x35, 0xae, 0x26, 0xa3, 0xf6, 0xd7, 0x4b, 0xf3,
-	0x08, 0x62, 0x4e, 0x9e, 0xa3, 0xb6, 0xbc, 0xd8, 0xd5, 0xf4, 0xa9, 0x0b, 0x38, 0x0c, 0x7a, 0x8b,
-	0x71, 0x08, 0xf4, 0x46, 0x08, 0x18, 0x75, 0x9a, 0x75, 0x7d, 0x30, 0xae, 0x26, 0xa7, 0x6d, 0xd1,
-	0x8d, 0x82, 0xab, 0x7d, 0xfe, 0x6d, 0xf7, 0xc1, 0x85, 0xa5, 0xf0, 0x5f, 0x49, 0x73, 0x0b, 0xe7,
-	0x2f, 0xb8, 0x9d, 0x59, 0x4b, 0xd9, 0x73, 0x0f, 0xd6, 0x70, 0x1c, 0x22, 0x26, 0xf4, 0x5c, 0xd0,
-	0x93, 0x76, 0x6f, 0x9f, 0x9e, 0x3f, 0xe6, 0x5f, 0x8e, 0x97, 0xd9, 0x48, 0x4b, 0x6b, 0x55, 0x6e,
-	0xbe, 0x73, 0x34, 0x88, 0x7e, 0xa1, 0x60, 0xd4, 0xdf, 0x83, 0x3d, 0x04, 0x33, 0x18, 0x73, 0x54,
-	0x46, 0xbb, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x01, 0x25, 0x90, 0x11, 0x5b, 0x01, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6b, 0xdb, 0x30,
+	0x14, 0xc6, 0x71, 0xe6, 0x6d, 0x89, 0x92, 0x2d, 0x20, 0xc6, 0x66, 0x32, 0x58, 0x8c, 0x77, 0x09,
+	0x8c, 0x49, 0x64, 0x3b, 0x8e, 0x0c, 0xb2, 0xb0, 0x43, 0x69, 0x0f, 0xc5, 0x49, 0x7b, 0xe8, 0x25,
+	0xc8, 0x96, 0x92, 0x88, 0xda, 0x96, 0xb0, 0x9e, 0x02, 0xf9, 0xdf, 0xfa, 0xc7, 0x15, 0x4b, 0x76,
+	0x9b, 0x43, 0xe9, 0xed, 0x3d, 0xf1, 0xfb, 0x3e, 0x3e, 0x7f, 0xcf, 0xe8, 0x3b, 0xdf, 0x59, 0x23,
+	0xa8, 0x50, 0x46, 0x2a, 0x6a, 0x80, 0x81, 0xe0, 0x19, 0x3d, 0xce, 0x29, 0xb0, 0xac, 0x10, 0x40,
+	0x74, 0xad, 0x40, 0xe1, 0xcf, 0x0e, 0x22, 0x0e, 0x22, 0x2d, 0x44, 0x8e, 0xf3, 0xc9, 0x74, 0xaf,
+	0xd4, 0xbe, 0x10, 0xd4, 0x51, 0x99, 0xdd, 0x51, 0x90, 0xa5, 0x30, 0xc0, 0x4a, 0xed, 0x85, 0x93,
+	0xf8, 0xdc, 0x3d, 0x57, 0x5c, 0xe4, 0x8d, 0xb7, 0x1b, 0x3c, 0x91, 0x50, 0xf4, 0x61, 0x69, 0xe1,
+	0x70, 0x25, 0xab, 0xfb, 0x5b, 0x56, 0x58, 0x81, 0xbf, 0x21, 0xa4, 0x45, 0x5d, 0x4a, 0x63, 0xa4,
+	0xaa, 0xa2, 0x20, 0x0e, 0x66, 0x61, 0x7a, 0xf6, 0x92, 0xfc, 0x45, 0x78, 0xa5, 0x2a, 0xa8, 0x59,
+	0x0e, 0xeb, 0x26, 0x89, 0x57, 0x7d, 0x42, 0x6f, 0x35, 0x3b, 0x89, 0xba, 0x15, 0xf8, 0x05, 0x63,
+	0x14, 0x72, 0x06, 0x2c, 0xea, 0xc5, 0xc1, 0x6c, 0x94, 0xba, 0x39, 0xa1, 0xe8, 0x4b, 0xa7, 0xdf,
+	0x34, 0xdf, 0xb8, 0xce, 0x95, 0x7e, 0xcd, 0x24, 0xf9, 0x81, 0xc6, 0x97, 0xe2, 0xb4, 0xcc, 0x73,
+	0x65, 0x2b, 0xf0, 0x60, 0x84, 0xde, 0xeb, 0x5a, 0x18, 0x51, 0x81, 0x43, 0xfb, 0x69, 0xb7, 0x26,
+	0x0f, 0x01, 0x1a, 0x5f, 0x3f, 0x85, 0xf5, 0xf4, 0x47, 0xd4, 0x93, 0xbc, 0xf5, 0xec, 0x49, 0x8e,
+	0xbf, 0xa2, 0x81, 0x66, 0xb5, 0xa8, 0x60, 0x2b, 0xb9, 0x8b, 0x16, 0xa6, 0x7d, 0xff, 0x70, 0xc1,
+	0xf1, 0x02, 0x8d, 0x0a, 0x66, 0x60, 0x6b, 0x35, 0x6f, 0x6a, 0x8e, 0xde, 0xc4, 0xc1, 0x6c, 0xf8,
+	0x6b, 0x42, 0x7c, 0xd3, 0xa4, 0x6b, 0x9a, 0x6c, 0xba, 0xa6, 0xd3, 0x61, 0xc3, 0xdf, 0x78, 0x1c,
+	0x2f, 0xd0, 0x80, 0x59, 0x38, 0xa8, 0x5a, 0xc2, 0x29, 0x0a, 0x9d, 0x76, 0x4a, 0xce, 0xaf, 0xe7,
+	0xbb, 0x3f, 0xce, 0xc9, 0xb2, 0xc3, 0xd2, 0x67, 0xc5, 0xbf, 0xff, 0x77, 0xab, 0xbd, 0x84, 0x83,
+	0xcd, 0x48, 0xae, 0x4a, 0xea, 0x74, 0x3f, 0xa5, 0x6a, 0x07, 0x7f, 0x45, 0x9d, 0xd1, 0x97, 0x7f,
+	0x99, 0x3f, 0x3a, 0x6b, 0x97, 0xec, 0x9d, 0x8b, 0xf9, 0xfb, 0x71, 0x00, 0xee, 0x18, 0xfe, 0xa7,
+	0x5d, 0x02, 0x00, 0x00,
 }
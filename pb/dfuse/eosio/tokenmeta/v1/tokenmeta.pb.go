@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -189,6 +190,59 @@ func (GetTokenBalancesRequest_Option) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_acfa679eff1c5edb, []int{5, 1}
 }
 
+type BalanceChange_Type int32
+
+const (
+	BalanceChange_SET_BALANCE    BalanceChange_Type = 0
+	BalanceChange_REMOVE_BALANCE BalanceChange_Type = 1
+	BalanceChange_SET_TOKEN      BalanceChange_Type = 2
+)
+
+var BalanceChange_Type_name = map[int32]string{
+	0: "SET_BALANCE",
+	1: "REMOVE_BALANCE",
+	2: "SET_TOKEN",
+}
+
+var BalanceChange_Type_value = map[string]int32{
+	"SET_BALANCE":    0,
+	"REMOVE_BALANCE": 1,
+	"SET_TOKEN":      2,
+}
+
+func (x BalanceChange_Type) String() string {
+	return proto.EnumName(BalanceChange_Type_name, int32(x))
+}
+
+func (BalanceChange_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{10, 0}
+}
+
+type SupplyChange_Type int32
+
+const (
+	SupplyChange_ISSUE  SupplyChange_Type = 0
+	SupplyChange_RETIRE SupplyChange_Type = 1
+)
+
+var SupplyChange_Type_name = map[int32]string{
+	0: "ISSUE",
+	1: "RETIRE",
+}
+
+var SupplyChange_Type_value = map[string]int32{
+	"ISSUE":  0,
+	"RETIRE": 1,
+}
+
+func (x SupplyChange_Type) String() string {
+	return proto.EnumName(SupplyChange_Type_name, int32(x))
+}
+
+func (SupplyChange_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{15, 0}
+}
+
 type GetTokensRequest struct {
 	Limit                uint32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	SortOrder            SortOrder                  `protobuf:"varint,2,opt,name=sort_order,json=sortOrder,proto3,enum=dfuse.eosio.tokenmeta.v1.SortOrder" json:"sort_order,omitempty"`
@@ -199,9 +253,13 @@ type GetTokensRequest struct {
 	FilterTokenContracts []string                   `protobuf:"bytes,5,rep,name=filter_token_contracts,json=filterTokenContracts,proto3" json:"filter_token_contracts,omitempty"`
 	BeforeCursor         *TokenCursor               `protobuf:"bytes,6,opt,name=before_cursor,json=beforeCursor,proto3" json:"before_cursor,omitempty"`
 	AfterCursor          *TokenCursor               `protobuf:"bytes,7,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	// When non-zero, answers as of the end of this block, requires tokenmeta to run with a history store
+	AtBlockNum uint64 `protobuf:"varint,10,opt,name=at_block_num,json=atBlockNum,proto3" json:"at_block_num,omitempty"`
+	// In head mode, answers as of the last irreversible block instead of the head block
+	IrreversibleOnly     bool     `protobuf:"varint,11,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetTokensRequest) Reset()         { *m = GetTokensRequest{} }
@@ -292,6 +350,20 @@ func (m *GetTokensRequest) GetAfterCursor() *TokenCursor {
 	return nil
 }
 
+func (m *GetTokensRequest) GetAtBlockNum() uint64 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *GetTokensRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
 type TokensResponse struct {
 	Tokens               []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	AtBlockNum           uint64   `protobuf:"varint,2,opt,name=atBlockNum,proto3" json:"atBlockNum,omitempty"`
@@ -356,9 +428,13 @@ type Token struct {
 	TotalSupply   uint64 `protobuf:"varint,6,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 	Holders       uint64 `protobuf:"varint,7,opt,name=holders,proto3" json:"holders,omitempty"`
 	// Eventually:
-	MarketCap            uint64   `protobuf:"varint,8,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
-	Website              string   `protobuf:"bytes,9,opt,name=website,proto3" json:"website,omitempty"`
-	Logo                 string   `protobuf:"bytes,10,opt,name=logo,proto3" json:"logo,omitempty"`
+	MarketCap uint64 `protobuf:"varint,8,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
+	Website   string `protobuf:"bytes,9,opt,name=website,proto3" json:"website,omitempty"`
+	Logo      string `protobuf:"bytes,10,opt,name=logo,proto3" json:"logo,omitempty"`
+	// Token standard the contract implements: `eosio.token`, `wrapped`, `simpleassets`, `atomicassets`,
+	// `eosio.system` or one added with `tokenmeta.RegisterTokenStandard`. Empty for tokens indexed before
+	// the standard was tracked, which all have the tables of `eosio.token`.
+	Standard             string   `protobuf:"bytes,11,opt,name=standard,proto3" json:"standard,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -459,6 +535,13 @@ func (m *Token) GetLogo() string {
 	return ""
 }
 
+func (m *Token) GetStandard() string {
+	if m != nil {
+		return m.Standard
+	}
+	return ""
+}
+
 type GetAccountBalancesRequest struct {
 	Account              string                              `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Limit                uint32                              `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -471,9 +554,13 @@ type GetAccountBalancesRequest struct {
 	Options              []GetAccountBalancesRequest_Option  `protobuf:"varint,9,rep,packed,name=options,proto3,enum=dfuse.eosio.tokenmeta.v1.GetAccountBalancesRequest_Option" json:"options,omitempty"`
 	BeforeCursor         *AccountBalanceCursor               `protobuf:"bytes,7,opt,name=before_cursor,json=beforeCursor,proto3" json:"before_cursor,omitempty"`
 	AfterCursor          *AccountBalanceCursor               `protobuf:"bytes,8,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+	// When non-zero, answers as of the end of this block, requires tokenmeta to run with a history store
+	AtBlockNum uint64 `protobuf:"varint,12,opt,name=at_block_num,json=atBlockNum,proto3" json:"at_block_num,omitempty"`
+	// In head mode, answers as of the last irreversible block instead of the head block
+	IrreversibleOnly     bool     `protobuf:"varint,13,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetAccountBalancesRequest) Reset()         { *m = GetAccountBalancesRequest{} }
@@ -578,6 +665,20 @@ func (m *GetAccountBalancesRequest) GetAfterCursor() *AccountBalanceCursor {
 	return nil
 }
 
+func (m *GetAccountBalancesRequest) GetAtBlockNum() uint64 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *GetAccountBalancesRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
 type AccountBalancesResponse struct {
 	Balances             []*AccountBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
 	AtBlockNum           uint64            `protobuf:"varint,2,opt,name=atBlockNum,proto3" json:"atBlockNum,omitempty"`
@@ -645,9 +746,13 @@ type GetTokenBalancesRequest struct {
 	Options              []GetTokenBalancesRequest_Option  `protobuf:"varint,9,rep,packed,name=options,proto3,enum=dfuse.eosio.tokenmeta.v1.GetTokenBalancesRequest_Option" json:"options,omitempty"`
 	BeforeCursor         *AccountBalanceCursor             `protobuf:"bytes,7,opt,name=before_cursor,json=beforeCursor,proto3" json:"before_cursor,omitempty"`
 	AfterCursor          *AccountBalanceCursor             `protobuf:"bytes,8,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	// When non-zero, answers as of the end of this block, requires tokenmeta to run with a history store
+	AtBlockNum uint64 `protobuf:"varint,12,opt,name=at_block_num,json=atBlockNum,proto3" json:"at_block_num,omitempty"`
+	// In head mode, answers as of the last irreversible block instead of the head block
+	IrreversibleOnly     bool     `protobuf:"varint,13,opt,name=irreversible_only,json=irreversibleOnly,proto3" json:"irreversible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetTokenBalancesRequest) Reset()         { *m = GetTokenBalancesRequest{} }
@@ -752,6 +857,20 @@ func (m *GetTokenBalancesRequest) GetAfterCursor() *AccountBalanceCursor {
 	return nil
 }
 
+func (m *GetTokenBalancesRequest) GetAtBlockNum() uint64 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *GetTokenBalancesRequest) GetIrreversibleOnly() bool {
+	if m != nil {
+		return m.IrreversibleOnly
+	}
+	return false
+}
+
 type TokenBalancesResponse struct {
 	Tokens               []*TokenContractBalancesResponse `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	AtBlockNum           uint64                           `protobuf:"varint,2,opt,name=atBlockNum,proto3" json:"atBlockNum,omitempty"`
@@ -855,11 +974,13 @@ func (m *TokenContractBalancesResponse) GetBalances() []*AccountBalance {
 }
 
 type AccountBalance struct {
-	TokenContract        string   `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Amount               uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Precision            uint32   `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	Symbol               string   `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Account       string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Precision     uint32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	Symbol        string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// In head mode, true when the balance changed in a block that is not irreversible yet
+	Reversible           bool     `protobuf:"varint,6,opt,name=reversible,proto3" json:"reversible,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -925,6 +1046,514 @@ func (m *AccountBalance) GetSymbol() string {
 	return ""
 }
 
+func (m *AccountBalance) GetReversible() bool {
+	if m != nil {
+		return m.Reversible
+	}
+	return false
+}
+
+type StreamBalanceChangesRequest struct {
+	// Only balance changes of these holder accounts, token changes are only streamed when empty
+	FilterAccounts       []string `protobuf:"bytes,1,rep,name=filter_accounts,json=filterAccounts,proto3" json:"filter_accounts,omitempty"`
+	FilterTokenContracts []string `protobuf:"bytes,2,rep,name=filter_token_contracts,json=filterTokenContracts,proto3" json:"filter_token_contracts,omitempty"`
+	FilterTokenSymbols   []string `protobuf:"bytes,3,rep,name=filter_token_symbols,json=filterTokenSymbols,proto3" json:"filter_token_symbols,omitempty"`
+	// Resumes the stream right after this change, it must be among the recently processed blocks
+	Cursor               *BalanceChangeCursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StreamBalanceChangesRequest) Reset()         { *m = StreamBalanceChangesRequest{} }
+func (m *StreamBalanceChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBalanceChangesRequest) ProtoMessage()    {}
+func (*StreamBalanceChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{9}
+}
+
+func (m *StreamBalanceChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBalanceChangesRequest.Unmarshal(m, b)
+}
+func (m *StreamBalanceChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBalanceChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamBalanceChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBalanceChangesRequest.Merge(m, src)
+}
+func (m *StreamBalanceChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamBalanceChangesRequest.Size(m)
+}
+func (m *StreamBalanceChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBalanceChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBalanceChangesRequest proto.InternalMessageInfo
+
+func (m *StreamBalanceChangesRequest) GetFilterAccounts() []string {
+	if m != nil {
+		return m.FilterAccounts
+	}
+	return nil
+}
+
+func (m *StreamBalanceChangesRequest) GetFilterTokenContracts() []string {
+	if m != nil {
+		return m.FilterTokenContracts
+	}
+	return nil
+}
+
+func (m *StreamBalanceChangesRequest) GetFilterTokenSymbols() []string {
+	if m != nil {
+		return m.FilterTokenSymbols
+	}
+	return nil
+}
+
+func (m *StreamBalanceChangesRequest) GetCursor() *BalanceChangeCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type BalanceChange struct {
+	Type BalanceChange_Type `protobuf:"varint,1,opt,name=type,proto3,enum=dfuse.eosio.tokenmeta.v1.BalanceChange_Type" json:"type,omitempty"`
+	// Set on `SET_BALANCE` and `REMOVE_BALANCE`, the amount is 0 on `REMOVE_BALANCE`
+	Balance *AccountBalance `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Set on `SET_TOKEN`
+	Token                *Token               `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	BlockNum             uint64               `protobuf:"varint,4,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockId              string               `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Cursor               *BalanceChangeCursor `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{10}
+}
+
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceChange.Unmarshal(m, b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return xxx_messageInfo_BalanceChange.Size(m)
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetType() BalanceChange_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BalanceChange_SET_BALANCE
+}
+
+func (m *BalanceChange) GetBalance() *AccountBalance {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetCursor() *BalanceChangeCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type GetTokenStatsRequest struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Symbol        string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Number of top holders returned, 10 when 0
+	TopHoldersLimit      uint32   `protobuf:"varint,3,opt,name=top_holders_limit,json=topHoldersLimit,proto3" json:"top_holders_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTokenStatsRequest) Reset()         { *m = GetTokenStatsRequest{} }
+func (m *GetTokenStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenStatsRequest) ProtoMessage()    {}
+func (*GetTokenStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{11}
+}
+
+func (m *GetTokenStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenStatsRequest.Unmarshal(m, b)
+}
+func (m *GetTokenStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTokenStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenStatsRequest.Merge(m, src)
+}
+func (m *GetTokenStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTokenStatsRequest.Size(m)
+}
+func (m *GetTokenStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenStatsRequest proto.InternalMessageInfo
+
+func (m *GetTokenStatsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *GetTokenStatsRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GetTokenStatsRequest) GetTopHoldersLimit() uint32 {
+	if m != nil {
+		return m.TopHoldersLimit
+	}
+	return 0
+}
+
+type TokenStats struct {
+	Token *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Transfers of the last 24 hours and 7 days before the last processed block, counted in hourly buckets
+	Transfers_24H *TransferStats `protobuf:"bytes,2,opt,name=transfers_24h,json=transfers24h,proto3" json:"transfers_24h,omitempty"`
+	Transfers_7D  *TransferStats `protobuf:"bytes,3,opt,name=transfers_7d,json=transfers7d,proto3" json:"transfers_7d,omitempty"`
+	TopHolders    []*TokenHolder `protobuf:"bytes,4,rep,name=top_holders,json=topHolders,proto3" json:"top_holders,omitempty"`
+	// Most recent `issue` and `retire` actions, at most 100, oldest first
+	SupplyChanges []*SupplyChange `protobuf:"bytes,5,rep,name=supply_changes,json=supplyChanges,proto3" json:"supply_changes,omitempty"`
+	// Transfers and supply changes are aggregated from the irreversible blocks processed since this block
+	SinceBlockNum        uint64   `protobuf:"varint,6,opt,name=since_block_num,json=sinceBlockNum,proto3" json:"since_block_num,omitempty"`
+	AtBlockNum           uint64   `protobuf:"varint,7,opt,name=at_block_num,json=atBlockNum,proto3" json:"at_block_num,omitempty"`
+	AtBlockId            string   `protobuf:"bytes,8,opt,name=at_block_id,json=atBlockId,proto3" json:"at_block_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenStats) Reset()         { *m = TokenStats{} }
+func (m *TokenStats) String() string { return proto.CompactTextString(m) }
+func (*TokenStats) ProtoMessage()    {}
+func (*TokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{12}
+}
+
+func (m *TokenStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenStats.Unmarshal(m, b)
+}
+func (m *TokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenStats.Marshal(b, m, deterministic)
+}
+func (m *TokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStats.Merge(m, src)
+}
+func (m *TokenStats) XXX_Size() int {
+	return xxx_messageInfo_TokenStats.Size(m)
+}
+func (m *TokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStats proto.InternalMessageInfo
+
+func (m *TokenStats) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *TokenStats) GetTransfers_24H() *TransferStats {
+	if m != nil {
+		return m.Transfers_24H
+	}
+	return nil
+}
+
+func (m *TokenStats) GetTransfers_7D() *TransferStats {
+	if m != nil {
+		return m.Transfers_7D
+	}
+	return nil
+}
+
+func (m *TokenStats) GetTopHolders() []*TokenHolder {
+	if m != nil {
+		return m.TopHolders
+	}
+	return nil
+}
+
+func (m *TokenStats) GetSupplyChanges() []*SupplyChange {
+	if m != nil {
+		return m.SupplyChanges
+	}
+	return nil
+}
+
+func (m *TokenStats) GetSinceBlockNum() uint64 {
+	if m != nil {
+		return m.SinceBlockNum
+	}
+	return 0
+}
+
+func (m *TokenStats) GetAtBlockNum() uint64 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *TokenStats) GetAtBlockId() string {
+	if m != nil {
+		return m.AtBlockId
+	}
+	return ""
+}
+
+type TransferStats struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Sum of the transferred amounts, in the token's smallest unit
+	Volume          uint64 `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	UniqueSenders   uint64 `protobuf:"varint,3,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders,omitempty"`
+	UniqueReceivers uint64 `protobuf:"varint,4,opt,name=unique_receivers,json=uniqueReceivers,proto3" json:"unique_receivers,omitempty"`
+	// False while the blocks processed since `since_block_num` span less than the window, the statistics then only cover part of it
+	Complete             bool     `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferStats) Reset()         { *m = TransferStats{} }
+func (m *TransferStats) String() string { return proto.CompactTextString(m) }
+func (*TransferStats) ProtoMessage()    {}
+func (*TransferStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{13}
+}
+
+func (m *TransferStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferStats.Unmarshal(m, b)
+}
+func (m *TransferStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferStats.Marshal(b, m, deterministic)
+}
+func (m *TransferStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferStats.Merge(m, src)
+}
+func (m *TransferStats) XXX_Size() int {
+	return xxx_messageInfo_TransferStats.Size(m)
+}
+func (m *TransferStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferStats proto.InternalMessageInfo
+
+func (m *TransferStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TransferStats) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *TransferStats) GetUniqueSenders() uint64 {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return 0
+}
+
+func (m *TransferStats) GetUniqueReceivers() uint64 {
+	if m != nil {
+		return m.UniqueReceivers
+	}
+	return 0
+}
+
+func (m *TransferStats) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type TokenHolder struct {
+	Balance *AccountBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Share of the token's supply held, in percent
+	SupplyPercent        float64  `protobuf:"fixed64,2,opt,name=supply_percent,json=supplyPercent,proto3" json:"supply_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{14}
+}
+
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenHolder.Unmarshal(m, b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return xxx_messageInfo_TokenHolder.Size(m)
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetBalance() *AccountBalance {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *TokenHolder) GetSupplyPercent() float64 {
+	if m != nil {
+		return m.SupplyPercent
+	}
+	return 0
+}
+
+type SupplyChange struct {
+	Type SupplyChange_Type `protobuf:"varint,1,opt,name=type,proto3,enum=dfuse.eosio.tokenmeta.v1.SupplyChange_Type" json:"type,omitempty"`
+	// Issued or retired amount, in the token's smallest unit
+	Amount               uint64               `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNum             uint64               `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockId              string               `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	BlockTime            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	TransactionId        string               `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SupplyChange) Reset()         { *m = SupplyChange{} }
+func (m *SupplyChange) String() string { return proto.CompactTextString(m) }
+func (*SupplyChange) ProtoMessage()    {}
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{15}
+}
+
+func (m *SupplyChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SupplyChange.Unmarshal(m, b)
+}
+func (m *SupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SupplyChange.Marshal(b, m, deterministic)
+}
+func (m *SupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyChange.Merge(m, src)
+}
+func (m *SupplyChange) XXX_Size() int {
+	return xxx_messageInfo_SupplyChange.Size(m)
+}
+func (m *SupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyChange proto.InternalMessageInfo
+
+func (m *SupplyChange) GetType() SupplyChange_Type {
+	if m != nil {
+		return m.Type
+	}
+	return SupplyChange_ISSUE
+}
+
+func (m *SupplyChange) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SupplyChange) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *SupplyChange) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *SupplyChange) GetBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.BlockTime
+	}
+	return nil
+}
+
+func (m *SupplyChange) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
 type TransactionCursor struct {
 	Ver                  int32    `protobuf:"varint,1,opt,name=ver,proto3" json:"ver,omitempty"`
 	TransactionIndex     uint32   `protobuf:"varint,2,opt,name=transactionIndex,proto3" json:"transactionIndex,omitempty"`
@@ -938,7 +1567,7 @@ func (m *TransactionCursor) Reset()         { *m = TransactionCursor{} }
 func (m *TransactionCursor) String() string { return proto.CompactTextString(m) }
 func (*TransactionCursor) ProtoMessage()    {}
 func (*TransactionCursor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acfa679eff1c5edb, []int{9}
+	return fileDescriptor_acfa679eff1c5edb, []int{16}
 }
 
 func (m *TransactionCursor) XXX_Unmarshal(b []byte) error {
@@ -993,7 +1622,7 @@ func (m *TokenCursor) Reset()         { *m = TokenCursor{} }
 func (m *TokenCursor) String() string { return proto.CompactTextString(m) }
 func (*TokenCursor) ProtoMessage()    {}
 func (*TokenCursor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acfa679eff1c5edb, []int{10}
+	return fileDescriptor_acfa679eff1c5edb, []int{17}
 }
 
 func (m *TokenCursor) XXX_Unmarshal(b []byte) error {
@@ -1049,7 +1678,7 @@ func (m *AccountBalanceCursor) Reset()         { *m = AccountBalanceCursor{} }
 func (m *AccountBalanceCursor) String() string { return proto.CompactTextString(m) }
 func (*AccountBalanceCursor) ProtoMessage()    {}
 func (*AccountBalanceCursor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acfa679eff1c5edb, []int{11}
+	return fileDescriptor_acfa679eff1c5edb, []int{18}
 }
 
 func (m *AccountBalanceCursor) XXX_Unmarshal(b []byte) error {
@@ -1098,6 +1727,70 @@ func (m *AccountBalanceCursor) GetAccount() string {
 	return ""
 }
 
+type BalanceChangeCursor struct {
+	Ver      int32  `protobuf:"varint,1,opt,name=ver,proto3" json:"ver,omitempty"`
+	BlockNum uint64 `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockId  string `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Position of the change among the changes of its block
+	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceChangeCursor) Reset()         { *m = BalanceChangeCursor{} }
+func (m *BalanceChangeCursor) String() string { return proto.CompactTextString(m) }
+func (*BalanceChangeCursor) ProtoMessage()    {}
+func (*BalanceChangeCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acfa679eff1c5edb, []int{19}
+}
+
+func (m *BalanceChangeCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceChangeCursor.Unmarshal(m, b)
+}
+func (m *BalanceChangeCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceChangeCursor.Marshal(b, m, deterministic)
+}
+func (m *BalanceChangeCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChangeCursor.Merge(m, src)
+}
+func (m *BalanceChangeCursor) XXX_Size() int {
+	return xxx_messageInfo_BalanceChangeCursor.Size(m)
+}
+func (m *BalanceChangeCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChangeCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChangeCursor proto.InternalMessageInfo
+
+func (m *BalanceChangeCursor) GetVer() int32 {
+	if m != nil {
+		return m.Ver
+	}
+	return 0
+}
+
+func (m *BalanceChangeCursor) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *BalanceChangeCursor) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *BalanceChangeCursor) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.SortOrder", SortOrder_name, SortOrder_value)
 	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.GetTokensRequest_SortField", GetTokensRequest_SortField_name, GetTokensRequest_SortField_value)
@@ -1105,6 +1798,8 @@ func init() {
 	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.GetAccountBalancesRequest_Option", GetAccountBalancesRequest_Option_name, GetAccountBalancesRequest_Option_value)
 	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.GetTokenBalancesRequest_SortField", GetTokenBalancesRequest_SortField_name, GetTokenBalancesRequest_SortField_value)
 	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.GetTokenBalancesRequest_Option", GetTokenBalancesRequest_Option_name, GetTokenBalancesRequest_Option_value)
+	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.BalanceChange_Type", BalanceChange_Type_name, BalanceChange_Type_value)
+	proto.RegisterEnum("dfuse.eosio.tokenmeta.v1.SupplyChange_Type", SupplyChange_Type_name, SupplyChange_Type_value)
 	proto.RegisterType((*GetTokensRequest)(nil), "dfuse.eosio.tokenmeta.v1.GetTokensRequest")
 	proto.RegisterType((*TokensResponse)(nil), "dfuse.eosio.tokenmeta.v1.TokensResponse")
 	proto.RegisterType((*Token)(nil), "dfuse.eosio.tokenmeta.v1.Token")
@@ -1114,9 +1809,17 @@ func init() {
 	proto.RegisterType((*TokenBalancesResponse)(nil), "dfuse.eosio.tokenmeta.v1.TokenBalancesResponse")
 	proto.RegisterType((*TokenContractBalancesResponse)(nil), "dfuse.eosio.tokenmeta.v1.TokenContractBalancesResponse")
 	proto.RegisterType((*AccountBalance)(nil), "dfuse.eosio.tokenmeta.v1.AccountBalance")
+	proto.RegisterType((*StreamBalanceChangesRequest)(nil), "dfuse.eosio.tokenmeta.v1.StreamBalanceChangesRequest")
+	proto.RegisterType((*BalanceChange)(nil), "dfuse.eosio.tokenmeta.v1.BalanceChange")
+	proto.RegisterType((*GetTokenStatsRequest)(nil), "dfuse.eosio.tokenmeta.v1.GetTokenStatsRequest")
+	proto.RegisterType((*TokenStats)(nil), "dfuse.eosio.tokenmeta.v1.TokenStats")
+	proto.RegisterType((*TransferStats)(nil), "dfuse.eosio.tokenmeta.v1.TransferStats")
+	proto.RegisterType((*TokenHolder)(nil), "dfuse.eosio.tokenmeta.v1.TokenHolder")
+	proto.RegisterType((*SupplyChange)(nil), "dfuse.eosio.tokenmeta.v1.SupplyChange")
 	proto.RegisterType((*TransactionCursor)(nil), "dfuse.eosio.tokenmeta.v1.TransactionCursor")
 	proto.RegisterType((*TokenCursor)(nil), "dfuse.eosio.tokenmeta.v1.TokenCursor")
 	proto.RegisterType((*AccountBalanceCursor)(nil), "dfuse.eosio.tokenmeta.v1.AccountBalanceCursor")
+	proto.RegisterType((*BalanceChangeCursor)(nil), "dfuse.eosio.tokenmeta.v1.BalanceChangeCursor")
 }
 
 func init() {
@@ -1124,80 +1827,124 @@ func init() {
 }
 
 var fileDescriptor_acfa679eff1c5edb = []byte{
-	// 1155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xc7, 0xeb, 0x38, 0x1f, 0xf5, 0x49, 0x1b, 0xcc, 0xa8, 0x74, 0x4d, 0xc5, 0x2e, 0x21, 0xa8,
-	0x52, 0x54, 0x89, 0x84, 0x76, 0x17, 0x2d, 0x62, 0xb5, 0x48, 0x69, 0x1a, 0xda, 0xb2, 0x6d, 0xb3,
-	0xd8, 0x29, 0x17, 0x2b, 0x24, 0xcb, 0x71, 0x26, 0x5b, 0xab, 0x71, 0x26, 0x78, 0x26, 0x65, 0x2b,
-	0xb8, 0xe2, 0x86, 0x97, 0x00, 0x21, 0x71, 0xc1, 0x5b, 0xf0, 0x36, 0xbc, 0x06, 0x12, 0xca, 0xcc,
-	0x38, 0x89, 0xd3, 0xb8, 0xf9, 0x68, 0x11, 0x37, 0x7b, 0x37, 0xe7, 0xcc, 0x9c, 0xff, 0x9c, 0xf9,
-	0xfa, 0xe5, 0xc4, 0x50, 0x6c, 0xb5, 0xfb, 0x14, 0x97, 0x31, 0xa1, 0x1e, 0x29, 0x33, 0x72, 0x89,
-	0xbb, 0x3e, 0x66, 0x4e, 0xf9, 0x6a, 0x77, 0x64, 0x94, 0x7a, 0x01, 0x61, 0x04, 0x19, 0x7c, 0x64,
-	0x89, 0x8f, 0x2c, 0x8d, 0x3a, 0xaf, 0x76, 0x0b, 0x7f, 0xa5, 0x40, 0x3f, 0xc4, 0xac, 0x31, 0xf0,
-	0x51, 0x13, 0x7f, 0xdf, 0xc7, 0x94, 0xa1, 0x0d, 0x48, 0x75, 0x3c, 0xdf, 0x63, 0x86, 0x92, 0x57,
-	0x8a, 0xeb, 0xa6, 0x30, 0xd0, 0x3e, 0x00, 0x25, 0x01, 0xb3, 0x49, 0xd0, 0xc2, 0x81, 0x91, 0xc8,
-	0x2b, 0xc5, 0xdc, 0xde, 0xc7, 0xa5, 0x38, 0xe5, 0x92, 0x45, 0x02, 0x56, 0x1f, 0x0c, 0x35, 0x35,
-	0x1a, 0x36, 0x91, 0x25, 0x35, 0xda, 0x1e, 0xee, 0xb4, 0x0c, 0x95, 0x6b, 0x3c, 0x89, 0xd7, 0x98,
-	0xcc, 0x8c, 0x8b, 0x7e, 0x35, 0x88, 0x15, 0xa2, 0xbc, 0x89, 0xce, 0x61, 0x83, 0x62, 0x97, 0x74,
-	0x5b, 0x4e, 0x70, 0x6d, 0x8f, 0xa5, 0xb8, 0x3a, 0x7f, 0x8a, 0x68, 0x28, 0x30, 0xf4, 0xa1, 0xf6,
-	0x0d, 0x59, 0x91, 0xb5, 0x76, 0x87, 0xac, 0xa3, 0xf3, 0x88, 0xf4, 0x3f, 0x85, 0x8d, 0xb6, 0xd7,
-	0x61, 0x38, 0xb0, 0xb9, 0x8a, 0x4d, 0xaf, 0xfd, 0x26, 0xe9, 0x50, 0x23, 0x99, 0x57, 0x8b, 0x9a,
-	0x89, 0x44, 0x1f, 0x17, 0xb4, 0x44, 0x0f, 0x7a, 0x02, 0x9b, 0x91, 0x08, 0x97, 0x74, 0x59, 0xe0,
-	0xb8, 0x8c, 0x1a, 0x29, 0x1e, 0xb3, 0x31, 0x16, 0x53, 0x0d, 0xfb, 0xd0, 0xd7, 0xb0, 0xde, 0xc4,
-	0x6d, 0x12, 0x60, 0xdb, 0xed, 0x07, 0x94, 0x04, 0x46, 0x3a, 0xaf, 0x14, 0xb3, 0x7b, 0xdb, 0xf1,
-	0x0b, 0x11, 0x02, 0x7c, 0xb0, 0xb9, 0x26, 0x62, 0x85, 0x85, 0x8e, 0x60, 0xcd, 0x69, 0x0f, 0x12,
-	0x90, 0x52, 0x99, 0x45, 0xa4, 0xb2, 0x3c, 0x54, 0x18, 0x85, 0xe7, 0xa0, 0x8d, 0xb6, 0x62, 0x15,
-	0x92, 0x67, 0xf5, 0xb3, 0x9a, 0xbe, 0x82, 0x34, 0x48, 0x55, 0x4e, 0x5e, 0x1e, 0x55, 0x74, 0x05,
-	0x65, 0x21, 0x73, 0x54, 0x3f, 0x39, 0xa8, 0x99, 0x96, 0x9e, 0x40, 0x39, 0x80, 0xd3, 0x8a, 0xf9,
-	0xa2, 0xd6, 0xb0, 0xab, 0x95, 0x97, 0xba, 0x5a, 0xf8, 0x45, 0x81, 0x5c, 0xb8, 0xd9, 0xb4, 0x47,
-	0xba, 0x14, 0xa3, 0xa7, 0x90, 0xe6, 0x53, 0x53, 0x43, 0xc9, 0xab, 0xc5, 0xec, 0xde, 0x87, 0x33,
-	0xb2, 0x32, 0xe5, 0x70, 0xf4, 0x08, 0xc0, 0x61, 0xfb, 0x1d, 0xe2, 0x5e, 0x9e, 0xf5, 0x7d, 0x7e,
-	0xc1, 0x93, 0xe6, 0x98, 0x07, 0x7d, 0x00, 0x9a, 0xb4, 0x8e, 0xc5, 0xdd, 0xd5, 0xcc, 0x91, 0xa3,
-	0xf0, 0x47, 0x02, 0x52, 0x5c, 0x0f, 0x6d, 0xc1, 0x6a, 0x78, 0x22, 0xfc, 0x05, 0x69, 0xe6, 0xd0,
-	0x46, 0x9b, 0x90, 0x16, 0xe7, 0xcb, 0xf5, 0x35, 0x53, 0x5a, 0x03, 0xed, 0x5e, 0x80, 0x5d, 0x8f,
-	0x7a, 0xa4, 0xcb, 0xb5, 0xd7, 0xcd, 0x91, 0x63, 0x10, 0xe5, 0x51, 0xda, 0xc7, 0x81, 0x91, 0x14,
-	0x51, 0xc2, 0x42, 0xdb, 0x90, 0xf3, 0x9d, 0x37, 0x9e, 0xdf, 0xf7, 0x6d, 0xda, 0xef, 0xf5, 0x3a,
-	0xd7, 0x46, 0x8a, 0x67, 0xbd, 0x2e, 0xbd, 0x16, 0x77, 0xa2, 0x8f, 0x60, 0x8d, 0x11, 0xe6, 0x74,
-	0xc2, 0x41, 0x69, 0x3e, 0x28, 0xcb, 0x7d, 0x72, 0x88, 0x01, 0x99, 0x0b, 0xd2, 0x69, 0xe1, 0x80,
-	0xf2, 0xb3, 0x4c, 0x9a, 0xa1, 0x89, 0x1e, 0x02, 0xf8, 0x4e, 0x70, 0x89, 0x99, 0xed, 0x3a, 0x3d,
-	0xfe, 0xa6, 0x92, 0xa6, 0x26, 0x3c, 0x55, 0xa7, 0x37, 0x08, 0xfc, 0x01, 0x37, 0xa9, 0xc7, 0x30,
-	0x7f, 0x18, 0x9a, 0x19, 0x9a, 0x08, 0x41, 0xb2, 0x43, 0x5e, 0x13, 0x03, 0xb8, 0x9b, 0xb7, 0x0b,
-	0xff, 0xa4, 0xe1, 0xfd, 0x43, 0xcc, 0x2a, 0xae, 0x4b, 0xfa, 0x5d, 0xb6, 0xef, 0x74, 0x9c, 0xae,
-	0x8b, 0x87, 0xdc, 0x31, 0x20, 0xe3, 0x88, 0x1e, 0xb9, 0x6f, 0xa1, 0x39, 0x22, 0x52, 0x22, 0x9e,
-	0x48, 0xea, 0x52, 0x44, 0xfa, 0x2e, 0x42, 0xa4, 0x24, 0xd7, 0x78, 0x7e, 0xeb, 0xdb, 0x9e, 0x9e,
-	0xfc, 0x62, 0x68, 0x82, 0xbb, 0xa1, 0x89, 0xc4, 0xa0, 0x29, 0x7b, 0x1f, 0xe9, 0x4f, 0x63, 0xd4,
-	0x72, 0xc4, 0x89, 0x23, 0x5b, 0x3a, 0x96, 0x6c, 0x0d, 0xc8, 0x90, 0x1e, 0xf3, 0x48, 0x97, 0x1a,
-	0x5a, 0x5e, 0x2d, 0xe6, 0xf6, 0xbe, 0x58, 0x66, 0x2d, 0x75, 0x2e, 0x61, 0x86, 0x52, 0xc8, 0x9a,
-	0x24, 0x9f, 0xc0, 0x55, 0x29, 0x5e, 0x3b, 0x2a, 0x3c, 0x15, 0x81, 0xdf, 0x4c, 0x20, 0x70, 0x75,
-	0x29, 0xcd, 0x08, 0x0b, 0xbf, 0x9c, 0xc9, 0x42, 0x80, 0x74, 0xe5, 0xb4, 0x7e, 0x7e, 0xd6, 0xd0,
-	0x13, 0x48, 0x87, 0x35, 0x89, 0xc2, 0x6f, 0x2b, 0x27, 0xe7, 0x35, 0x5d, 0x2d, 0xe4, 0x21, 0x2d,
-	0x96, 0x8e, 0x36, 0x01, 0xd5, 0xea, 0x96, 0x7d, 0x7c, 0x56, 0x3d, 0x39, 0x3f, 0xa8, 0xd9, 0x56,
-	0xa3, 0xf2, 0xa2, 0x76, 0xa0, 0xaf, 0x14, 0x7e, 0x53, 0xe0, 0xc1, 0x8d, 0x4d, 0x93, 0xdc, 0x3c,
-	0x80, 0xd5, 0xa6, 0xf4, 0x49, 0x72, 0x16, 0xe7, 0x5d, 0x8c, 0x39, 0x8c, 0xbc, 0x23, 0x44, 0x7f,
-	0xce, 0xc0, 0x83, 0xf0, 0xe7, 0x73, 0x92, 0x0e, 0xdb, 0x90, 0x8b, 0x5e, 0x3e, 0x09, 0x89, 0x75,
-	0x36, 0x7e, 0xeb, 0xfe, 0x43, 0x54, 0xbc, 0x9a, 0x82, 0x8a, 0x67, 0xb3, 0xcb, 0x80, 0xff, 0x13,
-	0x14, 0xfe, 0xad, 0xa0, 0xb8, 0x53, 0xf2, 0x8b, 0x94, 0x32, 0xa9, 0x39, 0x4a, 0x19, 0xf1, 0x7b,
-	0x63, 0x4b, 0xe2, 0x87, 0x90, 0x90, 0x7a, 0x47, 0xbc, 0x53, 0x5e, 0x3a, 0x8a, 0xcc, 0x49, 0x4c,
-	0x7c, 0xbe, 0xf8, 0x4a, 0xde, 0x42, 0x22, 0x0e, 0x12, 0x7f, 0x2a, 0xf0, 0xde, 0xc4, 0x96, 0x49,
-	0x44, 0xd4, 0x27, 0x4a, 0xab, 0xa7, 0xb3, 0x0a, 0x3e, 0xf9, 0x28, 0x27, 0x85, 0xee, 0xa9, 0xe4,
-	0xfa, 0x55, 0x81, 0x87, 0xb7, 0xce, 0x83, 0x3e, 0x83, 0x14, 0x9f, 0x89, 0xa3, 0x62, 0x8e, 0x52,
-	0x50, 0x8c, 0x8e, 0xa0, 0x30, 0xb1, 0x2c, 0x0a, 0x0b, 0xbf, 0x2b, 0x90, 0x8b, 0x76, 0xce, 0xcb,
-	0xb0, 0xb1, 0x42, 0x28, 0x11, 0x2d, 0x84, 0x36, 0x21, 0xed, 0xf8, 0xbc, 0x43, 0xe5, 0x9b, 0x25,
-	0xad, 0x68, 0xfd, 0x98, 0x9c, 0x52, 0x3f, 0xca, 0xaa, 0x33, 0x35, 0x5e, 0x75, 0x16, 0x7e, 0x84,
-	0x77, 0x1b, 0x81, 0xd3, 0xa5, 0x8e, 0x3b, 0xb8, 0x10, 0xf2, 0xce, 0xea, 0xa0, 0x5e, 0xe1, 0x80,
-	0x27, 0x96, 0x32, 0x07, 0x4d, 0xb4, 0x03, 0x3a, 0x1b, 0x0d, 0x3b, 0xee, 0xb6, 0xf0, 0x1b, 0x49,
-	0xd7, 0x1b, 0x7e, 0x54, 0x84, 0x77, 0xc6, 0x7c, 0x47, 0x0e, 0xbd, 0x90, 0xe7, 0x36, 0xe9, 0x2e,
-	0x58, 0x90, 0x1d, 0xfb, 0x57, 0x30, 0x65, 0xda, 0xf1, 0x3a, 0x3a, 0x11, 0x5b, 0x47, 0xab, 0x91,
-	0x15, 0x5d, 0xc1, 0xc6, 0xb4, 0x27, 0x74, 0x3f, 0xea, 0xe3, 0xe7, 0x92, 0x8c, 0x9c, 0xcb, 0xce,
-	0x23, 0xf1, 0x2a, 0x05, 0x75, 0x33, 0xa0, 0x56, 0xac, 0xaa, 0xbe, 0x32, 0x78, 0x9e, 0x07, 0x35,
-	0xab, 0xaa, 0x2b, 0x7b, 0x7f, 0x27, 0x40, 0xe3, 0xab, 0x3d, 0xc5, 0xcc, 0x41, 0x0e, 0x68, 0xc3,
-	0x3f, 0x89, 0x68, 0x67, 0xfe, 0x7f, 0x92, 0x5b, 0xc5, 0x19, 0x17, 0x78, 0x74, 0xf3, 0x7f, 0x02,
-	0x74, 0xb3, 0x40, 0x42, 0x8f, 0x97, 0x28, 0xa7, 0xb6, 0x76, 0xe7, 0xbd, 0xfb, 0xa3, 0xd9, 0xaf,
-	0x46, 0x5f, 0x15, 0x86, 0x73, 0xef, 0x2e, 0xcc, 0xe8, 0xad, 0xf2, 0x8c, 0xe5, 0x4e, 0xce, 0xbb,
-	0x7f, 0xfc, 0xea, 0xf0, 0xb5, 0xc7, 0x2e, 0xfa, 0xcd, 0x92, 0x4b, 0xfc, 0x32, 0x0f, 0xfe, 0xc4,
-	0x23, 0xb2, 0x21, 0x3e, 0x94, 0xf4, 0x9a, 0xe5, 0xb8, 0xef, 0x26, 0xcf, 0x7a, 0xcd, 0xa1, 0xd9,
-	0x4c, 0xf3, 0x4f, 0x27, 0x8f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x82, 0x39, 0xb9, 0xbd, 0x66,
-	0x11, 0x00, 0x00,
+	// 1865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x23, 0x47,
+	0x15, 0xdf, 0xd1, 0xe8, 0xdf, 0x3c, 0x59, 0xb2, 0xb6, 0x31, 0x9b, 0x89, 0xc3, 0x6e, 0xc4, 0xc0,
+	0x66, 0xc5, 0x86, 0xc8, 0x59, 0x65, 0x53, 0x0b, 0x49, 0x05, 0x90, 0x6d, 0x65, 0xed, 0xac, 0x6d,
+	0x2d, 0x2d, 0x39, 0x87, 0x14, 0x55, 0x53, 0x23, 0xa9, 0x6d, 0x4f, 0xad, 0xe6, 0x4f, 0x66, 0x5a,
+	0x66, 0x5d, 0x70, 0xe1, 0x94, 0x0b, 0x1f, 0x01, 0xaa, 0x38, 0x71, 0xe1, 0x3b, 0xc0, 0x89, 0x1b,
+	0x17, 0xbe, 0x00, 0xdf, 0x82, 0x3b, 0x35, 0xdd, 0x3d, 0xff, 0x64, 0x8f, 0xfe, 0x79, 0x81, 0x4b,
+	0x6e, 0xf3, 0x5e, 0xbf, 0xf7, 0xba, 0xfb, 0xf5, 0xeb, 0x5f, 0xff, 0xba, 0x07, 0x9a, 0xe3, 0xb3,
+	0xa9, 0x4f, 0x76, 0x88, 0xe3, 0x9b, 0xce, 0x0e, 0x75, 0x5e, 0x11, 0xdb, 0x22, 0xd4, 0xd8, 0xb9,
+	0x7c, 0x12, 0x0b, 0x2d, 0xd7, 0x73, 0xa8, 0x83, 0x54, 0x66, 0xd9, 0x62, 0x96, 0xad, 0xb8, 0xf1,
+	0xf2, 0xc9, 0xf6, 0xbb, 0xe7, 0x8e, 0x73, 0x3e, 0x21, 0x3b, 0xcc, 0x6e, 0x38, 0x3d, 0xdb, 0xa1,
+	0xa6, 0x45, 0x7c, 0x6a, 0x58, 0x2e, 0x77, 0xd5, 0x7e, 0x5f, 0x84, 0xfa, 0x73, 0x42, 0x07, 0x81,
+	0x93, 0x8f, 0xc9, 0xd7, 0x53, 0xe2, 0x53, 0xb4, 0x05, 0x85, 0x89, 0x69, 0x99, 0x54, 0x95, 0x1a,
+	0x52, 0xb3, 0x8a, 0xb9, 0x80, 0x76, 0x01, 0x7c, 0xc7, 0xa3, 0xba, 0xe3, 0x8d, 0x89, 0xa7, 0xe6,
+	0x1a, 0x52, 0xb3, 0xd6, 0xfe, 0x41, 0x2b, 0xab, 0xeb, 0x56, 0xdf, 0xf1, 0x68, 0x2f, 0x30, 0xc5,
+	0x8a, 0x1f, 0x7e, 0xa2, 0xbe, 0x88, 0x71, 0x66, 0x92, 0xc9, 0x58, 0x95, 0x59, 0x8c, 0xa7, 0xd9,
+	0x31, 0x66, 0x47, 0xc6, 0x82, 0x7e, 0x1e, 0xf8, 0xf2, 0xa0, 0xec, 0x13, 0x9d, 0xc2, 0x96, 0x4f,
+	0x46, 0x8e, 0x3d, 0x36, 0xbc, 0x2b, 0x3d, 0x31, 0xc4, 0xf2, 0xf2, 0x43, 0x44, 0x51, 0x80, 0x48,
+	0x87, 0xce, 0xae, 0x85, 0xe5, 0xa3, 0x56, 0x6e, 0x31, 0xea, 0x74, 0x3f, 0x7c, 0xf8, 0x1f, 0xc2,
+	0xd6, 0x99, 0x39, 0xa1, 0xc4, 0xd3, 0x59, 0x14, 0xdd, 0xbf, 0xb2, 0x86, 0xce, 0xc4, 0x57, 0xf3,
+	0x0d, 0xb9, 0xa9, 0x60, 0xc4, 0xdb, 0x58, 0xc0, 0x3e, 0x6f, 0x41, 0x4f, 0xe1, 0x5e, 0xca, 0x63,
+	0xe4, 0xd8, 0xd4, 0x33, 0x46, 0xd4, 0x57, 0x0b, 0xcc, 0x67, 0x2b, 0xe1, 0xb3, 0x17, 0xb6, 0xa1,
+	0x2f, 0xa0, 0x3a, 0x24, 0x67, 0x8e, 0x47, 0xf4, 0xd1, 0xd4, 0xf3, 0x1d, 0x4f, 0x2d, 0x36, 0xa4,
+	0x66, 0xa5, 0xfd, 0x30, 0x7b, 0x22, 0x3c, 0x00, 0x33, 0xc6, 0x1b, 0xdc, 0x97, 0x4b, 0xe8, 0x00,
+	0x36, 0x8c, 0xb3, 0x60, 0x00, 0x22, 0x54, 0x69, 0x95, 0x50, 0x15, 0xe6, 0x2a, 0x22, 0x35, 0x60,
+	0xc3, 0xa0, 0xfa, 0x70, 0xe2, 0x8c, 0x5e, 0xe9, 0xf6, 0xd4, 0x52, 0xa1, 0x21, 0x35, 0xf3, 0x18,
+	0x0c, 0xba, 0x1b, 0xa8, 0x4e, 0xa6, 0x16, 0x7a, 0x1f, 0xee, 0x9a, 0x9e, 0x47, 0x2e, 0x89, 0xe7,
+	0x9b, 0xc3, 0x09, 0xd1, 0x1d, 0x7b, 0x72, 0xa5, 0x56, 0x1a, 0x52, 0xb3, 0x8c, 0xeb, 0xc9, 0x86,
+	0x9e, 0x3d, 0xb9, 0xd2, 0x3e, 0x03, 0x25, 0xce, 0x6c, 0x19, 0xf2, 0x27, 0xbd, 0x93, 0x6e, 0xfd,
+	0x0e, 0x52, 0xa0, 0xd0, 0x39, 0x7a, 0x79, 0xd0, 0xa9, 0x4b, 0xa8, 0x02, 0xa5, 0x83, 0xde, 0xd1,
+	0x7e, 0x17, 0xf7, 0xeb, 0x39, 0x54, 0x03, 0x38, 0xee, 0xe0, 0x17, 0xdd, 0x81, 0xbe, 0xd7, 0x79,
+	0x59, 0x97, 0xb5, 0x6f, 0x24, 0xa8, 0x85, 0x6b, 0xe7, 0xbb, 0x8e, 0xed, 0x13, 0xf4, 0x0c, 0x8a,
+	0x6c, 0x26, 0xbe, 0x2a, 0x35, 0xe4, 0x66, 0xa5, 0xfd, 0xee, 0x82, 0x49, 0x62, 0x61, 0x8e, 0x1e,
+	0x40, 0x62, 0x16, 0x6a, 0xee, 0xda, 0xbc, 0xbe, 0x07, 0x8a, 0x90, 0x0e, 0xf9, 0x56, 0x50, 0x70,
+	0xac, 0xd0, 0xfe, 0x9a, 0x83, 0x02, 0x8b, 0x87, 0xb6, 0xa1, 0x1c, 0x2e, 0x30, 0xdb, 0x90, 0x0a,
+	0x8e, 0x64, 0x74, 0x0f, 0x8a, 0xbc, 0x5c, 0x58, 0x7c, 0x05, 0x0b, 0x29, 0x88, 0xed, 0x7a, 0x64,
+	0x64, 0xfa, 0xa6, 0x63, 0xb3, 0xd8, 0x55, 0x1c, 0x2b, 0x02, 0x2f, 0xd3, 0xf7, 0xa7, 0xc4, 0x53,
+	0xf3, 0xdc, 0x8b, 0x4b, 0xe8, 0x21, 0xd4, 0x2c, 0xe3, 0xb5, 0x69, 0x4d, 0x2d, 0xdd, 0x9f, 0xba,
+	0xee, 0xe4, 0x4a, 0x2d, 0xb0, 0x51, 0x57, 0x85, 0xb6, 0xcf, 0x94, 0xe8, 0xfb, 0xb0, 0x41, 0x1d,
+	0x6a, 0x4c, 0x42, 0xa3, 0x22, 0x33, 0xaa, 0x30, 0x9d, 0x30, 0x51, 0xa1, 0x74, 0xe1, 0x4c, 0xc6,
+	0xc4, 0xf3, 0x59, 0x69, 0xe4, 0x71, 0x28, 0xa2, 0xfb, 0x00, 0x96, 0xe1, 0xbd, 0x22, 0x54, 0x1f,
+	0x19, 0x2e, 0xdb, 0xa2, 0x79, 0xac, 0x70, 0xcd, 0x9e, 0xe1, 0x06, 0x8e, 0xbf, 0x26, 0x43, 0xdf,
+	0xa4, 0x84, 0xed, 0x33, 0x05, 0x87, 0x22, 0x42, 0x90, 0x9f, 0x38, 0xe7, 0x0e, 0x2b, 0x10, 0x05,
+	0xb3, 0xef, 0x20, 0x35, 0x3e, 0x35, 0x82, 0xfd, 0x34, 0x66, 0x15, 0xa1, 0xe0, 0x48, 0xd6, 0xfe,
+	0x51, 0x82, 0xb7, 0x9f, 0x13, 0xda, 0x19, 0x8d, 0x9c, 0xa9, 0x4d, 0x77, 0x8d, 0x89, 0x61, 0x8f,
+	0x48, 0x04, 0x71, 0x2a, 0x94, 0x0c, 0xde, 0x22, 0x72, 0x1a, 0x8a, 0x31, 0xf8, 0xe5, 0xb2, 0xc1,
+	0x4f, 0x5e, 0x0b, 0xfc, 0x7e, 0x95, 0x02, 0xbf, 0x3c, 0x8b, 0xf1, 0xd9, 0x5c, 0x18, 0xb9, 0x79,
+	0xf0, 0xab, 0xa1, 0x20, 0xdc, 0x0e, 0x05, 0x9d, 0x0c, 0x14, 0xac, 0xbc, 0x89, 0xe1, 0xdf, 0x04,
+	0x87, 0xeb, 0x81, 0x5b, 0x16, 0x88, 0x16, 0x33, 0x41, 0x74, 0x00, 0x25, 0xc7, 0xa5, 0xa6, 0x63,
+	0xfb, 0xaa, 0xd2, 0x90, 0x9b, 0xb5, 0xf6, 0x27, 0xeb, 0xcc, 0xa5, 0xc7, 0x42, 0xe0, 0x30, 0x14,
+	0xea, 0xcf, 0x82, 0x2c, 0x47, 0xc6, 0x56, 0x76, 0xec, 0x74, 0xe0, 0x1b, 0xd1, 0xf6, 0x97, 0x33,
+	0x68, 0x5b, 0x5e, 0x2b, 0xe6, 0x5c, 0xd8, 0xdd, 0x58, 0x0e, 0x76, 0xab, 0x19, 0xb0, 0xfb, 0xb3,
+	0x85, 0xb0, 0x0b, 0x50, 0xec, 0x1c, 0xf7, 0x4e, 0x4f, 0x06, 0xf5, 0x1c, 0xaa, 0xc3, 0x86, 0x40,
+	0xdd, 0x2f, 0x3b, 0x47, 0xa7, 0xdd, 0xba, 0xac, 0x35, 0xa0, 0xc8, 0x33, 0x89, 0xee, 0x01, 0xea,
+	0xf6, 0xfa, 0xfa, 0xe1, 0xc9, 0xde, 0xd1, 0xe9, 0x7e, 0x57, 0xef, 0x0f, 0x3a, 0x2f, 0xba, 0xfb,
+	0xf5, 0x3b, 0xda, 0x1f, 0x25, 0x78, 0xeb, 0xda, 0x1a, 0x08, 0x88, 0xde, 0x87, 0xf2, 0x50, 0xe8,
+	0x04, 0x48, 0x37, 0x97, 0xcd, 0x0d, 0x8e, 0x3c, 0x6f, 0x89, 0xd7, 0xff, 0x2c, 0xc1, 0x5b, 0xe1,
+	0xc1, 0x3f, 0x0b, 0x36, 0x0f, 0xa1, 0x96, 0xae, 0x65, 0x81, 0x39, 0x55, 0x9a, 0x2c, 0xe2, 0xff,
+	0x22, 0xf2, 0x7c, 0x75, 0x03, 0xf2, 0x7c, 0xba, 0x98, 0xc0, 0xfc, 0x3f, 0x71, 0xc7, 0x9a, 0x8b,
+	0x3b, 0xb7, 0x1a, 0xfc, 0x2a, 0x24, 0xac, 0xb0, 0x04, 0x09, 0xe3, 0x47, 0x9b, 0x2e, 0x0e, 0x90,
+	0x10, 0x73, 0x44, 0xbc, 0x03, 0xd6, 0x28, 0x8a, 0xce, 0x47, 0x78, 0x16, 0x75, 0x7e, 0xb2, 0xfa,
+	0x4c, 0xbe, 0xc5, 0x9c, 0xff, 0x11, 0xe6, 0xfc, 0x59, 0x82, 0xef, 0xce, 0xac, 0x80, 0x40, 0x9c,
+	0xde, 0x0c, 0x29, 0x7c, 0xb6, 0x88, 0xf9, 0x8a, 0x3d, 0x3e, 0x1b, 0xe8, 0x0d, 0x91, 0xc5, 0x3f,
+	0x48, 0x70, 0x7f, 0x6e, 0x3f, 0xe8, 0x63, 0x28, 0xb0, 0x9e, 0x18, 0xf2, 0x2c, 0x41, 0x62, 0xb9,
+	0x75, 0x0a, 0x59, 0x73, 0xeb, 0x22, 0xab, 0xf6, 0x37, 0x09, 0x6a, 0xe9, 0xc6, 0x65, 0x21, 0x31,
+	0x41, 0xd3, 0x72, 0x69, 0x9a, 0x76, 0x0f, 0x8a, 0x86, 0xc5, 0x1a, 0x64, 0x96, 0x2c, 0x21, 0xa5,
+	0x99, 0x6f, 0xfe, 0x06, 0xe6, 0x2b, 0xf8, 0x72, 0x21, 0xc5, 0x97, 0x1f, 0x00, 0xc4, 0xd5, 0xc5,
+	0x08, 0x6d, 0x19, 0x27, 0x34, 0xda, 0xbf, 0x25, 0x78, 0xa7, 0x4f, 0x3d, 0x62, 0x58, 0x61, 0x7d,
+	0x5f, 0x18, 0xf6, 0x79, 0x8c, 0xf0, 0x8f, 0x60, 0x53, 0x80, 0x41, 0x84, 0x02, 0x12, 0x43, 0x81,
+	0x1a, 0x57, 0x47, 0xfb, 0x3f, 0x9b, 0xdd, 0xe4, 0xd6, 0x60, 0x37, 0x72, 0x26, 0x3a, 0x75, 0xa1,
+	0x28, 0x36, 0x6e, 0x9e, 0x2d, 0xf8, 0x07, 0xd9, 0xcb, 0x96, 0x9a, 0x91, 0xd8, 0xb7, 0xc2, 0x59,
+	0xfb, 0x46, 0x86, 0x6a, 0xaa, 0x1d, 0xfd, 0x02, 0xf2, 0xf4, 0xca, 0x25, 0x6c, 0xb9, 0x6a, 0xed,
+	0x1f, 0x2f, 0x19, 0xb6, 0x35, 0xb8, 0x72, 0x09, 0x66, 0x9e, 0x68, 0x17, 0x4a, 0xa2, 0x32, 0xd8,
+	0x9a, 0xae, 0x52, 0x52, 0xa1, 0x63, 0x5c, 0xce, 0xf2, 0x4a, 0xe5, 0xfc, 0x0e, 0x28, 0x31, 0xfc,
+	0xe4, 0x59, 0xdd, 0x94, 0x87, 0xe1, 0x16, 0x7b, 0x1b, 0xf8, 0xb7, 0x6e, 0x8e, 0x45, 0x75, 0x94,
+	0x86, 0x7c, 0x7f, 0x25, 0xb2, 0x59, 0xbc, 0x4d, 0x36, 0x3f, 0x81, 0x7c, 0x90, 0x07, 0xb4, 0x09,
+	0x95, 0x7e, 0x77, 0xa0, 0xef, 0x76, 0x8e, 0x3a, 0x27, 0x7b, 0x01, 0x66, 0x21, 0xa8, 0xe1, 0xee,
+	0x71, 0xef, 0xcb, 0x6e, 0xa4, 0x93, 0x50, 0x15, 0x94, 0xc0, 0x68, 0xd0, 0x7b, 0xd1, 0x3d, 0xa9,
+	0xe7, 0xb4, 0xdf, 0x49, 0xb0, 0x15, 0x1e, 0x08, 0x7d, 0x6a, 0xd0, 0x55, 0xc9, 0x45, 0xd6, 0x4d,
+	0xf1, 0x31, 0xdc, 0xa5, 0x8e, 0x2b, 0xce, 0x30, 0x5f, 0xe7, 0x04, 0x84, 0xdf, 0x18, 0x37, 0xa9,
+	0xe3, 0xf2, 0xe3, 0xcb, 0x3f, 0x0a, 0xd4, 0xda, 0xbf, 0x64, 0x80, 0x78, 0x00, 0xeb, 0x62, 0xca,
+	0x11, 0x54, 0xa9, 0x67, 0xd8, 0xfe, 0x59, 0xd0, 0x5f, 0xfb, 0xe9, 0x85, 0xa8, 0x82, 0x47, 0x73,
+	0xdc, 0x85, 0x39, 0x9f, 0xf7, 0x46, 0xe4, 0xdd, 0x7e, 0x7a, 0x81, 0xbe, 0x80, 0x58, 0xd6, 0x9f,
+	0x8d, 0x55, 0x79, 0xb5, 0x60, 0x95, 0xc8, 0xf9, 0xd9, 0x18, 0x7d, 0x0e, 0x95, 0x44, 0x2e, 0xd8,
+	0x03, 0xcc, 0xe2, 0x47, 0x0d, 0x9e, 0x21, 0x0c, 0x71, 0xb2, 0xd0, 0x31, 0xd4, 0xf8, 0xd5, 0x58,
+	0x1f, 0x71, 0x98, 0x60, 0x34, 0xa2, 0xd2, 0x7e, 0x6f, 0x0e, 0x19, 0x62, 0xf6, 0xbc, 0x6a, 0x70,
+	0xd5, 0x4f, 0x48, 0x3e, 0x7a, 0x0f, 0x36, 0x7d, 0xd3, 0x1e, 0x91, 0xc4, 0xd1, 0xc9, 0xaf, 0xdc,
+	0x55, 0xa6, 0x8e, 0xce, 0x88, 0xd9, 0xf3, 0xb5, 0x74, 0xed, 0x14, 0x79, 0x00, 0x95, 0xc8, 0xc2,
+	0x1c, 0xab, 0xe5, 0xd9, 0x73, 0xe4, 0x2f, 0x12, 0x54, 0x53, 0xf9, 0x09, 0x38, 0x69, 0x7c, 0x4b,
+	0xce, 0xe3, 0x42, 0x04, 0xbe, 0x97, 0xce, 0x64, 0x6a, 0x11, 0x71, 0x52, 0x09, 0x29, 0xa8, 0xc5,
+	0xa9, 0x6d, 0x7e, 0x3d, 0x25, 0xba, 0x4f, 0x6c, 0x96, 0x43, 0x0e, 0xce, 0x55, 0xae, 0xed, 0x73,
+	0x25, 0xfa, 0x11, 0xd4, 0x85, 0x99, 0x47, 0x46, 0xc4, 0xbc, 0xe4, 0xc9, 0x0e, 0x0c, 0x37, 0xb9,
+	0x1e, 0x87, 0x6a, 0xfe, 0xf8, 0x61, 0xb9, 0x13, 0x42, 0x09, 0xdb, 0x94, 0x65, 0x1c, 0xc9, 0xda,
+	0x6b, 0xa8, 0x24, 0x56, 0x20, 0x89, 0x2b, 0xd2, 0xba, 0xb8, 0xf2, 0x30, 0x5a, 0x39, 0x97, 0x78,
+	0x23, 0x22, 0x8e, 0x1d, 0x29, 0x5c, 0x91, 0x97, 0x5c, 0xa9, 0xfd, 0x29, 0x07, 0x1b, 0xc9, 0x15,
+	0x43, 0x3f, 0x4f, 0xa1, 0xe2, 0xfb, 0xcb, 0xad, 0x73, 0x12, 0x14, 0xe3, 0xe3, 0x2c, 0x97, 0x3a,
+	0xce, 0x52, 0x88, 0x25, 0xcf, 0x41, 0xac, 0x7c, 0x1a, 0xb1, 0x7e, 0x0a, 0xc0, 0x9b, 0x82, 0x07,
+	0x5f, 0x96, 0xb9, 0x4a, 0x7b, 0xbb, 0xc5, 0x5f, 0x83, 0x5b, 0xe1, 0x6b, 0x70, 0x6b, 0x10, 0xbe,
+	0x06, 0x63, 0xde, 0x4b, 0x20, 0x33, 0x40, 0x09, 0x6a, 0xc0, 0x18, 0x51, 0xd3, 0xb1, 0x83, 0xd8,
+	0x45, 0x01, 0x28, 0xb1, 0xf6, 0x70, 0xac, 0xdd, 0x17, 0x60, 0xa6, 0x40, 0xe1, 0xb0, 0xdf, 0x3f,
+	0x0d, 0x60, 0x0c, 0xa0, 0x88, 0xbb, 0x83, 0x43, 0xdc, 0xad, 0x4b, 0xda, 0x6f, 0xe0, 0xee, 0x20,
+	0xb6, 0x17, 0x0c, 0xb0, 0x0e, 0xf2, 0x25, 0xf1, 0x58, 0x96, 0x0a, 0x38, 0xf8, 0x44, 0x8f, 0xa1,
+	0x9e, 0x0c, 0x6b, 0x8f, 0xc9, 0x6b, 0x71, 0xfd, 0xb9, 0xa6, 0x47, 0x4d, 0xd8, 0x4c, 0xe8, 0x0e,
+	0x0c, 0xff, 0x42, 0x30, 0xa1, 0x59, 0xb5, 0xd6, 0x17, 0x95, 0x91, 0xd9, 0x6d, 0xf2, 0x4d, 0x2d,
+	0x97, 0xf9, 0xa6, 0x26, 0x27, 0x91, 0x52, 0xbb, 0x84, 0xad, 0x9b, 0x38, 0xee, 0x9b, 0x89, 0x9e,
+	0x64, 0x3a, 0xf9, 0x14, 0xd3, 0xd1, 0xa6, 0xf0, 0x9d, 0x1b, 0x0e, 0x95, 0x1b, 0xba, 0x4d, 0xd5,
+	0x4a, 0x6e, 0x4e, 0xad, 0xc8, 0xe9, 0x5a, 0xd9, 0x82, 0x82, 0xc9, 0x12, 0xcf, 0xe9, 0x12, 0x17,
+	0x1e, 0x3f, 0x00, 0x25, 0xbe, 0x8d, 0x95, 0x40, 0xee, 0xf4, 0xf7, 0xea, 0x77, 0x02, 0x9e, 0xbd,
+	0xdf, 0xed, 0xef, 0xd5, 0xa5, 0xf6, 0xdf, 0xf3, 0xa0, 0xb0, 0x24, 0x1f, 0x13, 0x6a, 0x20, 0x03,
+	0x94, 0xe8, 0xd9, 0x1b, 0x3d, 0x5e, 0xfe, 0x6d, 0x7c, 0xbb, 0xb9, 0x00, 0x5e, 0x63, 0x0a, 0xfb,
+	0x5b, 0x40, 0xd7, 0xdf, 0x61, 0xd0, 0x47, 0x6b, 0xbc, 0xda, 0x6c, 0x3f, 0x59, 0x16, 0x19, 0xe2,
+	0xde, 0x2f, 0xe3, 0xff, 0x24, 0x51, 0xdf, 0x4f, 0x56, 0xbe, 0xbb, 0x6d, 0xef, 0x2c, 0x98, 0xee,
+	0xb5, 0x7e, 0x5f, 0xc3, 0xd6, 0x4d, 0xc4, 0x13, 0x7d, 0x3c, 0x07, 0x63, 0xb2, 0x89, 0xea, 0xf6,
+	0xa3, 0x25, 0x99, 0xcb, 0x87, 0x12, 0x22, 0x50, 0x4d, 0x11, 0x0e, 0xd4, 0x5a, 0x3c, 0xdd, 0x24,
+	0x33, 0xd9, 0xfe, 0xe1, 0x82, 0xb9, 0x32, 0xe3, 0xdd, 0xc3, 0xaf, 0x9e, 0x9f, 0x9b, 0xf4, 0x62,
+	0x3a, 0x6c, 0x8d, 0x1c, 0x6b, 0x87, 0x79, 0x7c, 0x60, 0x3a, 0xe2, 0x83, 0xff, 0xfc, 0x72, 0x87,
+	0x3b, 0x59, 0xff, 0xc2, 0x3e, 0x75, 0x87, 0x91, 0x38, 0x2c, 0x32, 0x60, 0xfb, 0xe8, 0x3f, 0x03,
+	0x00, 0x94, 0xc0, 0x83, 0x2b, 0x3a, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1215,6 +1962,8 @@ type TokenMetaClient interface {
 	GetTokens(ctx context.Context, in *GetTokensRequest, opts ...grpc.CallOption) (*TokensResponse, error)
 	GetAccountBalances(ctx context.Context, in *GetAccountBalancesRequest, opts ...grpc.CallOption) (*AccountBalancesResponse, error)
 	GetTokenBalances(ctx context.Context, in *GetTokenBalancesRequest, opts ...grpc.CallOption) (*TokenBalancesResponse, error)
+	StreamBalanceChanges(ctx context.Context, in *StreamBalanceChangesRequest, opts ...grpc.CallOption) (TokenMeta_StreamBalanceChangesClient, error)
+	GetTokenStats(ctx context.Context, in *GetTokenStatsRequest, opts ...grpc.CallOption) (*TokenStats, error)
 }
 
 type tokenMetaClient struct {
@@ -1252,11 +2001,54 @@ func (c *tokenMetaClient) GetTokenBalances(ctx context.Context, in *GetTokenBala
 	return out, nil
 }
 
+func (c *tokenMetaClient) StreamBalanceChanges(ctx context.Context, in *StreamBalanceChangesRequest, opts ...grpc.CallOption) (TokenMeta_StreamBalanceChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TokenMeta_serviceDesc.Streams[0], "/dfuse.eosio.tokenmeta.v1.TokenMeta/StreamBalanceChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tokenMetaStreamBalanceChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TokenMeta_StreamBalanceChangesClient interface {
+	Recv() (*BalanceChange, error)
+	grpc.ClientStream
+}
+
+type tokenMetaStreamBalanceChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *tokenMetaStreamBalanceChangesClient) Recv() (*BalanceChange, error) {
+	m := new(BalanceChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tokenMetaClient) GetTokenStats(ctx context.Context, in *GetTokenStatsRequest, opts ...grpc.CallOption) (*TokenStats, error) {
+	out := new(TokenStats)
+	err := c.cc.Invoke(ctx, "/dfuse.eosio.tokenmeta.v1.TokenMeta/GetTokenStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenMetaServer is the server API for TokenMeta service.
 type TokenMetaServer interface {
 	GetTokens(context.Context, *GetTokensRequest) (*TokensResponse, error)
 	GetAccountBalances(context.Context, *GetAccountBalancesRequest) (*AccountBalancesResponse, error)
 	GetTokenBalances(context.Context, *GetTokenBalancesRequest) (*TokenBalancesResponse, error)
+	StreamBalanceChanges(*StreamBalanceChangesRequest, TokenMeta_StreamBalanceChangesServer) error
+	GetTokenStats(context.Context, *GetTokenStatsRequest) (*TokenStats, error)
 }
 
 // UnimplementedTokenMetaServer can be embedded to have forward compatible implementations.
@@ -1272,6 +2064,12 @@ func (*UnimplementedTokenMetaServer) GetAccountBalances(ctx context.Context, req
 func (*UnimplementedTokenMetaServer) GetTokenBalances(ctx context.Context, req *GetTokenBalancesRequest) (*TokenBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenBalances not implemented")
 }
+func (*UnimplementedTokenMetaServer) StreamBalanceChanges(req *StreamBalanceChangesRequest, srv TokenMeta_StreamBalanceChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBalanceChanges not implemented")
+}
+func (*UnimplementedTokenMetaServer) GetTokenStats(ctx context.Context, req *GetTokenStatsRequest) (*TokenStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenStats not implemented")
+}
 
 func RegisterTokenMetaServer(s *grpc.Server, srv TokenMetaServer) {
 	s.RegisterService(&_TokenMeta_serviceDesc, srv)
@@ -1331,6 +2129,45 @@ func _TokenMeta_GetTokenBalances_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenMeta_StreamBalanceChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBalanceChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TokenMetaServer).StreamBalanceChanges(m, &tokenMetaStreamBalanceChangesServer{stream})
+}
+
+type TokenMeta_StreamBalanceChangesServer interface {
+	Send(*BalanceChange) error
+	grpc.ServerStream
+}
+
+type tokenMetaStreamBalanceChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *tokenMetaStreamBalanceChangesServer) Send(m *BalanceChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TokenMeta_GetTokenStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenMetaServer).GetTokenStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.eosio.tokenmeta.v1.TokenMeta/GetTokenStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenMetaServer).GetTokenStats(ctx, req.(*GetTokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TokenMeta_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dfuse.eosio.tokenmeta.v1.TokenMeta",
 	HandlerType: (*TokenMetaServer)(nil),
@@ -1347,7 +2184,17 @@ var _TokenMeta_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTokenBalances",
 			Handler:    _TokenMeta_GetTokenBalances_Handler,
 		},
+		{
+			MethodName: "GetTokenStats",
+			Handler:    _TokenMeta_GetTokenStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBalanceChanges",
+			Handler:       _TokenMeta_StreamBalanceChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dfuse/eosio/tokenmeta/v1/tokenmeta.proto",
 }
@@ -0,0 +1,456 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pushtrx pushes transactions to nodeos and waits until they reach a given guarantee
+// (in a block, after a number of producer handoffs or irreversible), re-pushing them to the
+// configured nodes in the meantime.
+package pushtrx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/bstream/hub"
+	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+type Guarantee string
+
+const (
+	GuaranteeInBlock      Guarantee = "in-block"
+	GuaranteeHandoffs1    Guarantee = "handoffs:1"
+	GuaranteeHandoffs2    Guarantee = "handoffs:2"
+	GuaranteeHandoffs3    Guarantee = "handoffs:3"
+	GuaranteeIrreversible Guarantee = "irreversible"
+)
+
+// ParseGuarantee accepts the values of the `X-Eos-Push-Guarantee` header, `handoff:<n>` being
+// an alias of `handoffs:<n>`.
+func ParseGuarantee(in string) (Guarantee, error) {
+	switch in {
+	case "in-block":
+		return GuaranteeInBlock, nil
+	case "handoff:1", "handoffs:1":
+		return GuaranteeHandoffs1, nil
+	case "handoff:2", "handoffs:2":
+		return GuaranteeHandoffs2, nil
+	case "handoff:3", "handoffs:3":
+		return GuaranteeHandoffs3, nil
+	case "irreversible":
+		return GuaranteeIrreversible, nil
+	}
+
+	return "", fmt.Errorf("unknown guarantee %q, valid values are 'irreversible', 'in-block', 'handoff:1', 'handoffs:2', 'handoffs:3'", in)
+}
+
+// TimeoutError is returned when the transaction did not reach the requested guarantee in time
+type TimeoutError struct {
+	TrxID   string
+	Resends int
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("too long waiting for inclusion of %q into a block (after %d retries)", e.TrxID, e.Resends)
+}
+
+// LIBIDGetter gives the current last irreversible block ID, from which the handoffs are tracked
+type LIBIDGetter interface {
+	LibID() string
+}
+
+type Pusher struct {
+	api             *eos.API
+	extraAPIs       []*eos.API
+	subscriptionHub *hub.SubscriptionHub
+	libIDGetter     LIBIDGetter
+	retries         int
+}
+
+func NewPusher(api *eos.API, subscriptionHub *hub.SubscriptionHub, libIDGetter LIBIDGetter, retries int, extraAPIs []*eos.API) *Pusher {
+	return &Pusher{
+		api:             api,
+		subscriptionHub: subscriptionHub,
+		libIDGetter:     libIDGetter,
+		retries:         retries,
+		extraAPIs:       append(extraAPIs, api), // always include the base API in here
+	}
+}
+
+// Push pushes the transaction and returns its trace once the guarantee is met. A transaction
+// rejected by nodeos is reported as an `eos.APIError`, a transaction that did not reach the
+// guarantee in time as a `*TimeoutError`.
+func (p *Pusher) Push(ctx context.Context, tx *eos.PackedTransaction, guarantee Guarantee, useLegacyPush bool) (*pbcodec.TransactionTrace, error) {
+	trxIDCheckSum, err := tx.ID()
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute transaction ID: %w", err)
+	}
+	trxID := trxIDCheckSum.String()
+
+	liveSourceFactory := bstream.SourceFactory(func(handler bstream.Handler) bstream.Source {
+		return p.subscriptionHub.NewSource(handler, 10) // does not need joining
+	})
+
+	var trxTraceFoundChan <-chan *pbcodec.TransactionTrace
+	var shutdownFunc func(error)
+	expirationDelay := time.Minute * 2 //baseline for inblock inclusion
+	switch guarantee {
+	case GuaranteeInBlock:
+		trxTraceFoundChan, shutdownFunc = awaitTransactionInBlock(ctx, trxID, liveSourceFactory)
+	case GuaranteeHandoffs1:
+		expirationDelay += 1 * time.Minute
+		trxTraceFoundChan, shutdownFunc = awaitTransactionPassedHandoffs(ctx, p.libIDGetter.LibID(), trxID, 1, p.subscriptionHub)
+	case GuaranteeHandoffs2:
+		expirationDelay += 1 * time.Minute
+		trxTraceFoundChan, shutdownFunc = awaitTransactionPassedHandoffs(ctx, p.libIDGetter.LibID(), trxID, 2, p.subscriptionHub)
+	case GuaranteeHandoffs3:
+		expirationDelay += 1 * time.Minute
+		trxTraceFoundChan, shutdownFunc = awaitTransactionPassedHandoffs(ctx, p.libIDGetter.LibID(), trxID, 3, p.subscriptionHub)
+	case GuaranteeIrreversible:
+		expirationDelay += 6 * time.Minute
+		trxTraceFoundChan, shutdownFunc = awaitTransactionIrreversible(ctx, trxID, liveSourceFactory)
+	default:
+		return nil, fmt.Errorf("unknown guarantee %q", guarantee)
+	}
+	metrics.IncListeners("push_transaction")
+	metrics.PushTrxCount.Inc(string(guarantee))
+	defer metrics.CurrentListeners.Dec("push_transaction")
+	defer shutdownFunc(nil) // closing the "awaitTransaction" pipelines...
+
+	if err := p.pushWithRetries(ctx, tx, trxID, useLegacyPush); err != nil {
+		return nil, err
+	}
+
+	zlog.Debug("waiting for trx to appear in a block", zap.String("hexTrxID", trxID), zap.Float64("minutes", expirationDelay.Minutes()), zap.String("guarantee", string(guarantee)))
+
+	resend := 0
+	trxExpired := false
+	expiration := time.After(expirationDelay)
+	for {
+		select {
+		case <-time.After(time.Second * 8): // retries every 8 second if we haven't seen the trx yet, this means at 8, 16, 24 -- considering that most trxs have 30s deadline
+			if trxExpired {
+				continue // keep waiting for the transaction to appear in a block but we stop trying to push it
+			}
+			resend++
+			if trxExpired, err = p.resend(ctx, tx, trxID, useLegacyPush, resend); err != nil {
+				return nil, err
+			}
+
+		case <-expiration:
+			metrics.TimedOutPushTrxCount.Inc(string(guarantee))
+			return nil, &TimeoutError{TrxID: trxID, Resends: resend}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case trxTrace := <-trxTraceFoundChan:
+			metrics.SucceededPushTrxCount.Inc(string(guarantee))
+			return trxTrace, nil
+		}
+	}
+}
+
+// pushWithRetries does the initial push of the transaction, retrying on network errors and on
+// the API errors that are known to be transient.
+func (p *Pusher) pushWithRetries(ctx context.Context, tx *eos.PackedTransaction, trxID string, useLegacyPush bool) error {
+	maxAttempts := p.retries + 1
+	for attempt := 1; ; attempt++ {
+		err := p.tryPush(p.api, ctx, tx, trxID, useLegacyPush)
+		if err == nil {
+			return nil
+		}
+
+		if apiErr, ok := err.(eos.APIError); ok { // decoded nodeos API error
+			retryable := IsRetryable(apiErr)
+			zapFields := append(
+				logFieldsFromAPIErr(apiErr),
+				zap.Bool("retryable", retryable),
+				zap.Int("attempt", attempt),
+				zap.Int("max_attempts", maxAttempts),
+				zap.String("trx_id", trxID),
+			)
+			zlog.Info("push transaction API error",
+				zapFields...,
+			)
+			if attempt < maxAttempts && retryable {
+				time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+				continue
+			}
+			return apiErr
+		}
+		// other error, we couldn't reach nodeos...
+		zlog.Info("push transaction unknown error",
+			zap.Error(err),
+			zap.Int("attempt", attempt),
+			zap.Int("max_attempts", maxAttempts),
+		)
+		if attempt < maxAttempts {
+			time.Sleep(time.Duration(attempt) * 250 * time.Millisecond)
+			continue
+		}
+		return fmt.Errorf("cannot push transaction %q to Nodeos API: %w", trxID, err)
+	}
+}
+
+// resend pushes the transaction again to a random node while waiting for it to appear in a
+// block, `expired` is true once nodeos reports the transaction as expired, there is no point in
+// pushing it again after that. Only an API error of a previously accepted transaction is returned.
+func (p *Pusher) resend(ctx context.Context, tx *eos.PackedTransaction, trxID string, useLegacyPush bool, resend int) (expired bool, err error) {
+	a := p.randomAPI()
+	err = p.tryPush(a, ctx, tx, trxID, useLegacyPush)
+	zlog.Debug("retrying send transaction to push API", zap.String("random_api", a.BaseURL), zap.Error(err), zap.Int("resend", resend))
+	if err == nil {
+		return false, nil
+	}
+
+	apiErr, ok := err.(eos.APIError) // decoded nodeos API error
+	if !ok {
+		return false, nil
+	}
+
+	if isExpiredError(apiErr) {
+		zlog.Debug("trx expired error.", zap.String("trx_id", trxID))
+		return true, nil
+	}
+	if isDuplicateError(apiErr) {
+		zlog.Debug("duplicate error.", zap.String("trx_id", trxID))
+		return false, nil
+	}
+	if IsRetryable(apiErr) {
+		zlog.Debug("retryable error", zap.String("trx_id", trxID))
+		return false, nil
+	}
+
+	zapFields := append(
+		logFieldsFromAPIErr(apiErr),
+		zap.Int("resend", resend),
+		zap.String("trx_id", trxID),
+	)
+	zlog.Info("push transaction API error after earlier success",
+		zapFields...,
+	)
+
+	// if previously passing transaction now fails, we return it to the client.
+	return false, apiErr
+}
+
+func (p *Pusher) randomAPI() *eos.API {
+	return p.extraAPIs[rand.Intn(len(p.extraAPIs))]
+}
+
+func (p *Pusher) tryPush(API *eos.API, ctx context.Context, tx *eos.PackedTransaction, trxID string, useLegacyPush bool) (err error) {
+	timedoutContext, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var pushResp json.RawMessage
+
+	if useLegacyPush {
+		pushResp, err = API.PushTransactionRaw(timedoutContext, tx)
+	} else {
+		pushResp, err = API.SendTransactionRaw(timedoutContext, tx)
+	}
+	if err != nil {
+		return
+	}
+
+	idCheck := gjson.GetBytes(pushResp, "transaction_id").String()
+	if idCheck != trxID {
+		return fmt.Errorf("pushed transaction ID %q mismatch transaction ID received from API %q", trxID, idCheck)
+	}
+	return nil
+}
+
+func isExpiredError(err eos.APIError) bool {
+	return err.ErrorStruct.Code == 3040005
+}
+
+func isDuplicateError(err eos.APIError) bool {
+	return err.ErrorStruct.Code == 3040008 || err.ErrorStruct.Code == 3040009 // duplicate
+}
+
+// IsRetryable tells if a nodeos error is transient, resource related errors (i.e. CPU or deadline
+// exceeded) usually succeed on another node or a bit later.
+func IsRetryable(err eos.APIError) bool {
+	if err.ErrorStruct.Code < 3080000 || err.ErrorStruct.Code == 3080001 {
+		return false
+	}
+	// in between those are resource-related errors, like cpu or deadline, we want to retry those
+	// see https://docs.google.com/spreadsheets/d/1uHeNDLnCVygqYK-V01CFANuxUwgRkNkrmeLm9MLqu9c/edit#gid=0
+	if err.ErrorStruct.Code >= 3090000 {
+		return false
+	}
+	return true
+}
+
+func countUniqueElem(elements []string) int {
+	encountered := map[string]bool{}
+	for v := range elements {
+		encountered[elements[v]] = true
+	}
+	return len(encountered)
+}
+
+var runningPushInHandoffs int64
+
+// awaitTransactionPassedHandoffs starts a forkaware pipeline that awaits a number
+// of producer handoffs after which it sends back the transaction traces in a channel
+func awaitTransactionPassedHandoffs(ctx context.Context, libID string, trxID string, requiredHandoffs int, subscriptionHub *hub.SubscriptionHub) (<-chan *pbcodec.TransactionTrace, func(error)) {
+	trxFound := make(chan *pbcodec.TransactionTrace)
+	var done bool
+	var seenTrxTraces *pbcodec.TransactionTrace
+	var producers []string
+
+	atomic.AddInt64(&runningPushInHandoffs, 1)
+	zlog.Info("waiting for trx to live for handoffs", zap.Int("handoffs", requiredHandoffs), zap.String("trx_id", trxID), zap.Int64("count", atomic.LoadInt64(&runningPushInHandoffs)))
+
+	handle := bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		fObj := obj.(*forkable.ForkableObject)
+
+		zlog.Debug("handoff awaiting processing", zap.Stringer("block", block), zap.Stringer("step", fObj.Step))
+		if done {
+			return nil
+		}
+
+		blk := block.ToNative().(*pbcodec.Block)
+		producer := blk.Header.Producer
+
+		switch fObj.Step {
+		case forkable.StepIrreversible, forkable.StepStalled:
+			return nil
+
+		case forkable.StepNew, forkable.StepRedo:
+			if trxTraces := traceExecutedInBlock(trxID, blk); trxTraces != nil {
+				seenTrxTraces = trxTraces
+			}
+
+			if seenTrxTraces == nil {
+				break
+			}
+
+			producers = append(producers, producer) // push
+			if countUniqueElem(producers)-1 >= requiredHandoffs {
+				trxFound <- seenTrxTraces
+				done = true
+			}
+
+		case forkable.StepUndo:
+			if seenTrxTraces != nil && len(producers) > 0 {
+				producers = producers[:len(producers)-1] // pop
+			}
+			if trxTraces := traceExecutedInBlock(trxID, blk); trxTraces != nil {
+				seenTrxTraces = nil
+			}
+
+		default:
+			return fmt.Errorf("unhandled forkable step")
+		}
+
+		return nil
+	})
+
+	irrRef := bstream.NewBlockRefFromID(libID)
+	forkHandler := forkable.New(handle, forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+	forkablePostGate := bstream.NewBlockIDGate(libID, bstream.GateInclusive, forkHandler, bstream.GateOptionWithLogger(zlog))
+
+	source := subscriptionHub.NewSourceFromBlockRef(irrRef, forkablePostGate)
+	source.OnTerminating(func(e error) {
+		atomic.AddInt64(&runningPushInHandoffs, -1)
+	})
+
+	go source.Run()
+
+	return trxFound, source.Shutdown
+}
+
+var runningPushInBlock int64
+
+func awaitTransactionInBlock(ctx context.Context, trxID string, sourceFactory bstream.SourceFactory) (<-chan *pbcodec.TransactionTrace, func(error)) {
+	atomic.AddInt64(&runningPushInBlock, 1)
+	zlog.Info("waiting for trx to appear in a block", zap.String("trxID", trxID), zap.Int64("count", atomic.LoadInt64(&runningPushInBlock)))
+
+	trxTraceFoundChan := make(chan *pbcodec.TransactionTrace)
+
+	source := sourceFactory(getTransactionCatcher(ctx, trxID, trxTraceFoundChan))
+	source.OnTerminating(func(e error) {
+		atomic.AddInt64(&runningPushInBlock, -1)
+	})
+	go source.Run()
+
+	return trxTraceFoundChan, source.Shutdown
+}
+
+var runningIrreversible int64
+
+func awaitTransactionIrreversible(ctx context.Context, trxID string, sourceFactory bstream.SourceFactory) (<-chan *pbcodec.TransactionTrace, func(error)) {
+	atomic.AddInt64(&runningIrreversible, 1)
+	zlog.Info("waiting for trx to appear in an irreversible block", zap.String("trxID", trxID), zap.Int64("count", atomic.LoadInt64(&runningIrreversible)))
+
+	trxTraceFoundChan := make(chan *pbcodec.TransactionTrace)
+
+	irrForkableHandler := forkable.New(getTransactionCatcher(ctx, trxID, trxTraceFoundChan), forkable.WithLogger(zlog), forkable.WithFilters(forkable.StepIrreversible))
+	source := sourceFactory(irrForkableHandler)
+	source.OnTerminating(func(e error) {
+		atomic.AddInt64(&runningIrreversible, -1)
+	})
+
+	go source.Run()
+	return trxTraceFoundChan, source.Shutdown
+}
+
+func getTransactionCatcher(ctx context.Context, trxID string, trxTraceFoundChan chan *pbcodec.TransactionTrace) bstream.Handler {
+	return bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		blk := block.ToNative().(*pbcodec.Block)
+		trxTrace := traceExecutedInBlock(trxID, blk)
+		if trxTrace != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case trxTraceFoundChan <- trxTrace:
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+func traceExecutedInBlock(trxID string, blk *pbcodec.Block) *pbcodec.TransactionTrace {
+	for _, trxTrace := range blk.TransactionTraces() {
+		if trxTrace.Id == trxID {
+			return trxTrace
+		}
+	}
+
+	return nil
+}
+
+func logFieldsFromAPIErr(apiErr eos.APIError) []zap.Field {
+	return []zap.Field{
+		zap.String("name", apiErr.ErrorStruct.Name),
+		zap.Int("code", apiErr.Code),
+		zap.Int("errstruct_code", apiErr.ErrorStruct.Code),
+		zap.String("error_message", apiErr.Message),
+		zap.String("what", apiErr.ErrorStruct.What),
+		zap.Any("details", apiErr.ErrorStruct.Details),
+	}
+}
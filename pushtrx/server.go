@@ -0,0 +1,216 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushtrx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
+	"github.com/dfuse-io/dgrpc"
+	"github.com/dfuse-io/shutter"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server exposes the `Pusher` as the `dfuse.eosio.pushtrx.v1.TransactionPusher` gRPC service
+type Server struct {
+	*shutter.Shutter
+
+	grpcServer *grpc.Server
+	pusher     *Pusher
+}
+
+func NewServer(pusher *Pusher) *Server {
+	s := &Server{
+		Shutter:    shutter.New(),
+		grpcServer: dgrpc.NewServer(dgrpc.WithLogger(zlog)),
+		pusher:     pusher,
+	}
+
+	pbpushtrx.RegisterTransactionPusherServer(s.grpcServer, s)
+	s.OnTerminating(func(_ error) { s.grpcServer.GracefulStop() })
+
+	return s
+}
+
+func (s *Server) Serve(listenAddr string) {
+	zlog.Info("starting grpc server", zap.String("address", listenAddr))
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		s.Shutdown(fmt.Errorf("unable to listen on %q: %w", listenAddr, err))
+		return
+	}
+
+	err = s.grpcServer.Serve(listener)
+	if err == nil || err == grpc.ErrServerStopped {
+		zlog.Info("server shut down cleanly, nothing to do")
+		return
+	}
+
+	s.Shutdown(err)
+}
+
+func (s *Server) PushTransaction(ctx context.Context, in *pbpushtrx.PushTransactionRequest) (*pbpushtrx.PushTransactionResponse, error) {
+	guarantee, err := guaranteeFromProto(in.Guarantee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := packedTransactionFromProto(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction: %s", err)
+	}
+
+	trace, err := s.pusher.Push(ctx, tx, guarantee, in.UseLegacyPush)
+	if err != nil {
+		return nil, pushErrorToStatus(err)
+	}
+
+	return &pbpushtrx.PushTransactionResponse{
+		TransactionId: trace.Id,
+		BlockId:       trace.ProducerBlockId,
+		BlockNum:      trace.BlockNum,
+		Trace:         trace,
+	}, nil
+}
+
+func (s *Server) PushTransactionStatus(in *pbpushtrx.PushTransactionStatusRequest, stream pbpushtrx.TransactionPusher_PushTransactionStatusServer) error {
+	tx, err := packedTransactionFromProto(in)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid transaction: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var sendErr error
+	err = s.pusher.PushAndTrack(ctx, tx, in.UseLegacyPush, func(pushStatus *PushStatus) {
+		if sendErr != nil {
+			return
+		}
+
+		if sendErr = stream.Send(pushStatusToProto(pushStatus)); sendErr != nil {
+			cancel()
+		}
+	})
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	if err != nil {
+		return pushErrorToStatus(err)
+	}
+
+	return nil
+}
+
+func pushErrorToStatus(err error) error {
+	var apiErr eos.APIError
+	var timeoutErr *TimeoutError
+	switch {
+	case errors.As(err, &apiErr):
+		return status.Errorf(codes.FailedPrecondition, "transaction rejected by nodeos: %s (code %d, %s)", apiErr.ErrorStruct.What, apiErr.ErrorStruct.Code, apiErr.ErrorStruct.Name)
+	case errors.As(err, &timeoutErr):
+		return status.Error(codes.DeadlineExceeded, timeoutErr.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	return status.Errorf(codes.Unavailable, "unable to push transaction: %s", err)
+}
+
+var pushStageToProto = map[PushStage]pbpushtrx.PushStage{
+	PushStageAccepted:     pbpushtrx.PushStage_PUSH_STAGE_ACCEPTED,
+	PushStageInBlock:      pbpushtrx.PushStage_PUSH_STAGE_IN_BLOCK,
+	PushStageHandoff:      pbpushtrx.PushStage_PUSH_STAGE_HANDOFF,
+	PushStageForked:       pbpushtrx.PushStage_PUSH_STAGE_FORKED,
+	PushStageIrreversible: pbpushtrx.PushStage_PUSH_STAGE_IRREVERSIBLE,
+}
+
+func pushStatusToProto(in *PushStatus) *pbpushtrx.PushTransactionStatusResponse {
+	return &pbpushtrx.PushTransactionStatusResponse{
+		Stage:         pushStageToProto[in.Stage],
+		TransactionId: in.TrxID,
+		BlockId:       in.BlockID,
+		BlockNum:      in.BlockNum,
+		Handoffs:      uint32(in.Handoffs),
+		Trace:         in.Trace,
+	}
+}
+
+func guaranteeFromProto(in pbpushtrx.Guarantee) (Guarantee, error) {
+	switch in {
+	case pbpushtrx.Guarantee_GUARANTEE_IN_BLOCK:
+		return GuaranteeInBlock, nil
+	case pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_1:
+		return GuaranteeHandoffs1, nil
+	case pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_2:
+		return GuaranteeHandoffs2, nil
+	case pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_3:
+		return GuaranteeHandoffs3, nil
+	case pbpushtrx.Guarantee_GUARANTEE_IRREVERSIBLE:
+		return GuaranteeIrreversible, nil
+	}
+
+	return "", fmt.Errorf("unknown guarantee %d", in)
+}
+
+// packedTransactionProto is implemented by both push requests, which share the packed transaction fields
+type packedTransactionProto interface {
+	GetSignatures() []string
+	GetCompression() string
+	GetPackedContextFreeData() []byte
+	GetPackedTrx() []byte
+}
+
+func packedTransactionFromProto(in packedTransactionProto) (*eos.PackedTransaction, error) {
+	if len(in.GetPackedTrx()) == 0 {
+		return nil, fmt.Errorf("packed transaction is required")
+	}
+
+	out := &eos.PackedTransaction{
+		PackedContextFreeData: in.GetPackedContextFreeData(),
+		PackedTransaction:     in.GetPackedTrx(),
+	}
+
+	switch in.GetCompression() {
+	case "", "none":
+		out.Compression = eos.CompressionNone
+	case "zlib":
+		out.Compression = eos.CompressionZlib
+	default:
+		return nil, fmt.Errorf("unknown compression %q, valid values are 'none' and 'zlib'", in.GetCompression())
+	}
+
+	for i, signature := range in.GetSignatures() {
+		sig, err := ecc.NewSignature(signature)
+		if err != nil {
+			return nil, fmt.Errorf("signature #%d: %w", i, err)
+		}
+		out.Signatures = append(out.Signatures, sig)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushtrx
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	pbpushtrx "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/pushtrx/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_guaranteeFromProto(t *testing.T) {
+	tests := []struct {
+		in            pbpushtrx.Guarantee
+		expected      Guarantee
+		expectedError string
+	}{
+		{pbpushtrx.Guarantee_GUARANTEE_IN_BLOCK, GuaranteeInBlock, ""},
+		{pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_1, GuaranteeHandoffs1, ""},
+		{pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_2, GuaranteeHandoffs2, ""},
+		{pbpushtrx.Guarantee_GUARANTEE_HANDOFFS_3, GuaranteeHandoffs3, ""},
+		{pbpushtrx.Guarantee_GUARANTEE_IRREVERSIBLE, GuaranteeIrreversible, ""},
+		{pbpushtrx.Guarantee(10), "", "unknown guarantee 10"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in.String(), func(t *testing.T) {
+			guarantee, err := guaranteeFromProto(test.in)
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, guarantee)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func Test_packedTransactionFromProto(t *testing.T) {
+	key, err := ecc.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("transaction"))
+	signature, err := key.Sign(digest[:])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		in            *pbpushtrx.PushTransactionRequest
+		expected      *eos.PackedTransaction
+		expectedError string
+	}{
+		{
+			"default compression",
+			&pbpushtrx.PushTransactionRequest{PackedTrx: []byte{0x01}, Signatures: []string{signature.String()}},
+			&eos.PackedTransaction{PackedTransaction: []byte{0x01}, Compression: eos.CompressionNone, Signatures: []ecc.Signature{signature}},
+			"",
+		},
+		{
+			"zlib compression",
+			&pbpushtrx.PushTransactionRequest{PackedTrx: []byte{0x01}, PackedContextFreeData: []byte{0x02}, Compression: "zlib"},
+			&eos.PackedTransaction{PackedTransaction: []byte{0x01}, PackedContextFreeData: []byte{0x02}, Compression: eos.CompressionZlib},
+			"",
+		},
+		{"missing packed trx", &pbpushtrx.PushTransactionRequest{}, nil, "packed transaction is required"},
+		{"unknown compression", &pbpushtrx.PushTransactionRequest{PackedTrx: []byte{0x01}, Compression: "gzip"}, nil, `unknown compression "gzip", valid values are 'none' and 'zlib'`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx, err := packedTransactionFromProto(test.in)
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, tx)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+
+	_, err = packedTransactionFromProto(&pbpushtrx.PushTransactionRequest{PackedTrx: []byte{0x01}, Signatures: []string{"invalid"}})
+	assert.Error(t, err)
+}
+
+func Test_pushStatusToProto(t *testing.T) {
+	out := pushStatusToProto(&PushStatus{Stage: PushStageHandoff, TrxID: "trx.1", BlockNum: 2, BlockID: "00000002a", Handoffs: 1})
+	assert.Equal(t, &pbpushtrx.PushTransactionStatusResponse{
+		Stage:         pbpushtrx.PushStage_PUSH_STAGE_HANDOFF,
+		TransactionId: "trx.1",
+		BlockId:       "00000002a",
+		BlockNum:      2,
+		Handoffs:      1,
+	}, out)
+}
@@ -0,0 +1,230 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushtrx
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/bstream/hub"
+	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+type PushStage string
+
+const (
+	// PushStageAccepted is reported once a node accepted the transaction
+	PushStageAccepted PushStage = "accepted"
+	// PushStageInBlock is reported each time the transaction is seen in a block of the longest chain
+	PushStageInBlock PushStage = "in-block"
+	// PushStageHandoff is reported each time the block holding the transaction is followed by blocks of one more producer
+	PushStageHandoff PushStage = "handoff"
+	// PushStageForked is reported when the block holding the transaction was removed from the longest chain
+	PushStageForked PushStage = "forked"
+	// PushStageIrreversible is the last stage, the block holding the transaction became irreversible
+	PushStageIrreversible PushStage = "irreversible"
+)
+
+// PushStatus is a single update about a tracked transaction. `BlockNum` and `BlockID` refer to the
+// block holding the transaction (or the block that was forked out), `Trace` is only set on the
+// `in-block` and `irreversible` stages and `Handoffs` only on the `handoff` stage.
+type PushStatus struct {
+	Stage    PushStage
+	TrxID    string
+	BlockNum uint64
+	BlockID  string
+	Handoffs int
+	Trace    *pbcodec.TransactionTrace
+}
+
+// trackingDelay is how long a tracked transaction has to become irreversible, same as the
+// `irreversible` guarantee
+const trackingDelay = 8 * time.Minute
+
+// PushAndTrack pushes the transaction and calls `onStatus` for each stage it goes through until it
+// becomes irreversible, in which case `nil` is returned. The transaction is pushed again while it
+// is not in a block, including after being forked out. Errors are the same as `Push`.
+func (p *Pusher) PushAndTrack(ctx context.Context, tx *eos.PackedTransaction, useLegacyPush bool, onStatus func(status *PushStatus)) error {
+	trxIDCheckSum, err := tx.ID()
+	if err != nil {
+		return fmt.Errorf("cannot compute transaction ID: %w", err)
+	}
+	trxID := trxIDCheckSum.String()
+
+	statuses, shutdownFunc := awaitTransactionStatuses(ctx, p.libIDGetter.LibID(), trxID, p.subscriptionHub)
+
+	metrics.IncListeners("push_transaction")
+	metrics.PushTrxCount.Inc("tracked")
+	defer metrics.CurrentListeners.Dec("push_transaction")
+	defer shutdownFunc(nil)
+
+	if err := p.pushWithRetries(ctx, tx, trxID, useLegacyPush); err != nil {
+		return err
+	}
+	onStatus(&PushStatus{Stage: PushStageAccepted, TrxID: trxID})
+
+	resend := 0
+	inBlock := false
+	trxExpired := false
+	expiration := time.After(trackingDelay)
+	for {
+		select {
+		case <-time.After(time.Second * 8):
+			if inBlock || trxExpired {
+				continue
+			}
+			resend++
+			if trxExpired, err = p.resend(ctx, tx, trxID, useLegacyPush, resend); err != nil {
+				return err
+			}
+
+		case <-expiration:
+			metrics.TimedOutPushTrxCount.Inc("tracked")
+			return &TimeoutError{TrxID: trxID, Resends: resend}
+
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case status := <-statuses:
+			switch status.Stage {
+			case PushStageInBlock:
+				inBlock = true
+			case PushStageForked:
+				inBlock = false
+			}
+
+			onStatus(status)
+
+			if status.Stage == PushStageIrreversible {
+				metrics.SucceededPushTrxCount.Inc("tracked")
+				return nil
+			}
+		}
+	}
+}
+
+var runningPushTracked int64
+
+// awaitTransactionStatuses starts a forkaware pipeline, like `awaitTransactionPassedHandoffs`,
+// that follows the transaction until the block holding it becomes irreversible, sending back a
+// status each time the transaction goes through a new stage.
+func awaitTransactionStatuses(ctx context.Context, libID string, trxID string, subscriptionHub *hub.SubscriptionHub) (<-chan *PushStatus, func(error)) {
+	statuses := make(chan *PushStatus)
+
+	atomic.AddInt64(&runningPushTracked, 1)
+	zlog.Info("tracking trx until irreversible", zap.String("trx_id", trxID), zap.Int64("count", atomic.LoadInt64(&runningPushTracked)))
+
+	irrRef := bstream.NewBlockRefFromID(libID)
+	handler := newTransactionStatusHandler(ctx, trxID, statuses)
+	forkHandler := forkable.New(handler, forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+	forkablePostGate := bstream.NewBlockIDGate(libID, bstream.GateInclusive, forkHandler, bstream.GateOptionWithLogger(zlog))
+
+	source := subscriptionHub.NewSourceFromBlockRef(irrRef, forkablePostGate)
+	source.OnTerminating(func(e error) {
+		atomic.AddInt64(&runningPushTracked, -1)
+	})
+
+	go source.Run()
+
+	return statuses, source.Shutdown
+}
+
+func newTransactionStatusHandler(ctx context.Context, trxID string, statuses chan<- *PushStatus) bstream.Handler {
+	var done bool
+	var seenBlock bstream.BlockRef
+	var seenTrace *pbcodec.TransactionTrace
+	var producers []string
+	var handoffs int
+
+	send := func(status *PushStatus) {
+		status.TrxID = trxID
+		select {
+		case <-ctx.Done():
+		case statuses <- status:
+		}
+	}
+
+	return bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		fObj := obj.(*forkable.ForkableObject)
+		if done {
+			return nil
+		}
+
+		blk := block.ToNative().(*pbcodec.Block)
+
+		switch fObj.Step {
+		case forkable.StepNew, forkable.StepRedo:
+			if trace := traceExecutedInBlock(trxID, blk); trace != nil {
+				seenBlock = bstream.NewBlockRef(blk.ID(), blk.Num())
+				seenTrace = trace
+				producers = []string{blk.Header.Producer}
+				handoffs = 0
+
+				send(&PushStatus{Stage: PushStageInBlock, BlockNum: blk.Num(), BlockID: blk.ID(), Trace: trace})
+				return nil
+			}
+
+			if seenBlock == nil {
+				return nil
+			}
+
+			producers = append(producers, blk.Header.Producer) // push
+			if count := countUniqueElem(producers) - 1; count > handoffs {
+				handoffs = count
+				send(&PushStatus{Stage: PushStageHandoff, BlockNum: seenBlock.Num(), BlockID: seenBlock.ID(), Handoffs: handoffs})
+			}
+
+		case forkable.StepUndo:
+			if seenBlock == nil {
+				return nil
+			}
+
+			if blk.ID() == seenBlock.ID() {
+				send(&PushStatus{Stage: PushStageForked, BlockNum: seenBlock.Num(), BlockID: seenBlock.ID()})
+				seenBlock = nil
+				seenTrace = nil
+				producers = nil
+				handoffs = 0
+				return nil
+			}
+
+			if len(producers) > 0 {
+				producers = producers[:len(producers)-1] // pop
+			}
+			handoffs = countUniqueElem(producers) - 1
+
+		case forkable.StepIrreversible:
+			if seenBlock != nil && blk.ID() == seenBlock.ID() {
+				send(&PushStatus{Stage: PushStageIrreversible, BlockNum: seenBlock.Num(), BlockID: seenBlock.ID(), Trace: seenTrace})
+				done = true
+			}
+
+		case forkable.StepStalled:
+			return nil
+
+		default:
+			return fmt.Errorf("unhandled forkable step")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushtrx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_transactionStatusHandler(t *testing.T) {
+	type step struct {
+		step  forkable.StepType
+		block *bstream.Block
+	}
+
+	blk1a := txpushTestBlock(t, "00000001a", "00000000a", "eoscanadacom", "a")
+	blk2a := txpushTestBlock(t, "00000002a", "00000001a", "eoscanadacom", "expected.tx.id")
+	blk3a := txpushTestBlock(t, "00000003a", "00000002a", "eosriobrazil", "b")
+	blk4a := txpushTestBlock(t, "00000004a", "00000003a", "secondone", "c")
+	blk3b := txpushTestBlock(t, "00000003b", "00000002b", "eosswedenorg", "expected.tx.id")
+
+	steps := []step{
+		{forkable.StepNew, blk1a},
+		{forkable.StepNew, blk2a},
+		{forkable.StepNew, blk3a},
+		{forkable.StepUndo, blk3a},
+		{forkable.StepUndo, blk2a},
+		{forkable.StepNew, blk3b},
+		{forkable.StepUndo, blk3b},
+		{forkable.StepRedo, blk2a},
+		{forkable.StepRedo, blk3a},
+		{forkable.StepNew, blk4a},
+		{forkable.StepIrreversible, blk1a},
+		{forkable.StepIrreversible, blk2a},
+		{forkable.StepIrreversible, blk3a},
+	}
+
+	statuses := make(chan *PushStatus, 100)
+	handler := newTransactionStatusHandler(context.Background(), "expected.tx.id", statuses)
+	for _, s := range steps {
+		require.NoError(t, handler.ProcessBlock(s.block, &forkable.ForkableObject{Step: s.step}))
+	}
+	close(statuses)
+
+	var actual []string
+	for status := range statuses {
+		assert.Equal(t, "expected.tx.id", status.TrxID)
+		actual = append(actual, fmt.Sprintf("%s %s %d %t", status.Stage, status.BlockID, status.Handoffs, status.Trace != nil))
+	}
+
+	assert.Equal(t, []string{
+		"in-block 00000002a 0 true",
+		"handoff 00000002a 1 false",
+		"forked 00000002a 0 false",
+		"in-block 00000003b 0 true",
+		"forked 00000003b 0 false",
+		"in-block 00000002a 0 true",
+		"handoff 00000002a 1 false",
+		"handoff 00000002a 2 false",
+		"irreversible 00000002a 0 true",
+	}, actual)
+}
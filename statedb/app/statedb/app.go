@@ -61,12 +61,12 @@ func New(config *Config, modules *Modules) *App {
 			// Required dependencies
 			OnInjectMode:       app.startForInjectMode,
 			OnServerMode:       app.startForServeMode,
-			BlockMapper:        &statedb.BlockMapper{},
+			BlockMapper:        &statedb.BlockMapper{BlockFilter: modules.BlockFilter},
 			StartBlockResolver: modules.StartBlockResolver,
 
-			// Optional dependencies
-			BlockFilter: modules.BlockFilter,
-			BlockMeta:   modules.BlockMeta,
+			// Optional dependencies, the block filter is applied by the mapper so resource usage ops
+			// of excluded transactions are still mapped
+			BlockMeta: modules.BlockMeta,
 		},
 	)
 
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dfuse-io/derr"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/logging"
+	pbbstream "github.com/dfuse-io/pbgo/dfuse/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) GetCode(ctx context.Context, request *pbstatedb.GetCodeRequest) (*pbstatedb.GetCodeResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("get code",
+		zap.Uint64("block_num", request.BlockNum),
+		zap.String("account", request.Account),
+		zap.Bool("with_deployments", request.WithDeployments),
+	)
+
+	blockNum := uint64(request.BlockNum)
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
+	}
+
+	resp := &pbstatedb.GetCodeResponse{
+		UpToBlock:             &pbbstream.BlockRef{Num: upToBlock.Num(), Id: upToBlock.ID()},
+		LastIrreversibleBlock: &pbbstream.BlockRef{Num: lastWrittenBlock.Num(), Id: lastWrittenBlock.ID()},
+	}
+
+	entry, err := s.db.ReadSingletEntryAt(ctx, statedb.NewContractCodeSinglet(request.Account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read code at %d: %s", blockNum, err)
+	}
+
+	if entry != nil {
+		code, err := entry.(*statedb.ContractCodeEntry).Code()
+		if err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode code: %s", err)
+		}
+
+		resp.Code = statedb.ToCodeDeployment(entry.Height(), code)
+	}
+
+	if request.WithDeployments {
+		tabletRows, err := s.db.ReadTabletAt(ctx, actualBlockNum, statedb.NewCodeDeploymentTablet(request.Account), speculativeWrites)
+		if err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to read code deployments at %d: %s", blockNum, err)
+		}
+
+		resp.Deployments = make([]*pbstatedb.CodeDeployment, len(tabletRows))
+		for i, tabletRow := range tabletRows {
+			row := tabletRow.(*statedb.CodeDeploymentRow)
+			code, err := row.Code()
+			if err != nil {
+				return nil, derr.Statusf(codes.Internal, "unable to decode code deployment %q: %s", row, err)
+			}
+
+			resp.Deployments[i] = statedb.ToCodeDeployment(row.DeployedAt(), code)
+		}
+
+		sort.Slice(resp.Deployments, func(i, j int) bool {
+			return resp.Deployments[i].BlockNum < resp.Deployments[j].BlockNum
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dfuse-io/derr"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/dhammer"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/dfuse-io/logging"
+	pbbstream "github.com/dfuse-io/pbgo/dfuse/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) GetPermissions(ctx context.Context, request *pbstatedb.GetPermissionsRequest) (*pbstatedb.GetPermissionsResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("get permissions",
+		zap.Uint64("block_num", request.BlockNum),
+		zap.String("account", request.Account),
+	)
+
+	blockNum := uint64(request.BlockNum)
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
+	}
+
+	permissions, err := s.readPermissions(ctx, request.Account, actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read permissions at %d: %s", blockNum, err)
+	}
+
+	return &pbstatedb.GetPermissionsResponse{
+		UpToBlock:             &pbbstream.BlockRef{Num: upToBlock.Num(), Id: upToBlock.ID()},
+		LastIrreversibleBlock: &pbbstream.BlockRef{Num: lastWrittenBlock.Num(), Id: lastWrittenBlock.ID()},
+		Permissions:           permissions,
+	}, nil
+}
+
+func (s *Server) StreamPermissions(request *pbstatedb.StreamPermissionsRequest, stream pbstatedb.State_StreamPermissionsServer) error {
+	ctx := stream.Context()
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("stream permissions",
+		zap.Reflect("request", request),
+	)
+
+	blockNum := uint64(request.BlockNum)
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
+	if err != nil {
+		return derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
+	}
+
+	// Sort by account so at least, a constant order is kept across calls
+	sort.Slice(request.Accounts, func(leftIndex, rightIndex int) bool {
+		return request.Accounts[leftIndex] < request.Accounts[rightIndex]
+	})
+
+	accounts := make([]interface{}, len(request.Accounts))
+	for i, s := range request.Accounts {
+		accounts[i] = string(s)
+	}
+
+	nailer := dhammer.NewNailer(64, func(ctx context.Context, i interface{}) (interface{}, error) {
+		account := i.(string)
+
+		permissions, err := s.readPermissions(ctx, account, actualBlockNum, speculativeWrites)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read permissions of %q: %w", account, err)
+		}
+
+		return &pbstatedb.AccountPermissionsResponse{
+			Account:     account,
+			Permissions: permissions,
+		}, nil
+	}, zlog)
+
+	nailer.PushAll(ctx, accounts)
+
+	stream.SetHeader(newMetadata(upToBlock, lastWrittenBlock))
+
+	for {
+		select {
+		case <-ctx.Done():
+			zlog.Debug("stream terminated prior completion")
+			return nil
+		case next, ok := <-nailer.Out:
+			if !ok {
+				zlog.Debug("nailer completed")
+				if err := nailer.Err(); err != nil {
+					return derr.Statusf(codes.Internal, "unable to read permissions: %s", err)
+				}
+				return nil
+			}
+
+			stream.Send(next.(*pbstatedb.AccountPermissionsResponse))
+		}
+	}
+}
+
+// readPermissions reads the permissions of an account at the given block, the parent
+// of each permission is resolved by id among the account's own permissions.
+func (s *Server) readPermissions(ctx context.Context, account string, blockNum uint64, speculativeWrites []*fluxdb.WriteRequest) ([]*pbstatedb.Permission, error) {
+	tabletRows, err := s.db.ReadTabletAt(ctx, blockNum, statedb.NewPermissionTablet(account), speculativeWrites)
+	if err != nil {
+		return nil, fmt.Errorf("read tablet at: %w", err)
+	}
+
+	values := make([]*pbstatedb.PermissionValue, len(tabletRows))
+	namesByID := make(map[uint64]string, len(tabletRows))
+	for i, tabletRow := range tabletRows {
+		row := tabletRow.(*statedb.PermissionRow)
+		if values[i], err = row.Permission(); err != nil {
+			return nil, fmt.Errorf("unable to read tablet row %q value: %w", row, err)
+		}
+
+		namesByID[values[i].Id] = row.Name()
+	}
+
+	permissions := make([]*pbstatedb.Permission, len(tabletRows))
+	for i, tabletRow := range tabletRows {
+		// The `owner` permission has no parent, its parent id is 0 which is also the id of `eosio@owner`
+		parent := ""
+		if values[i].ParentId != values[i].Id {
+			parent = namesByID[values[i].ParentId]
+		}
+
+		permissions[i] = &pbstatedb.Permission{
+			Name:         tabletRow.(*statedb.PermissionRow).Name(),
+			Parent:       parent,
+			LastUpdated:  values[i].LastUpdated,
+			RequiredAuth: values[i].Authority,
+			BlockNum:     tabletRow.Height(),
+		}
+	}
+
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].Name < permissions[j].Name
+	})
+
+	return permissions, nil
+}
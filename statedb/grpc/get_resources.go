@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dfuse-io/derr"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/logging"
+	pbbstream "github.com/dfuse-io/pbgo/dfuse/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) GetAccountResources(ctx context.Context, request *pbstatedb.GetAccountResourcesRequest) (*pbstatedb.GetAccountResourcesResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("get account resources",
+		zap.Uint64("block_num", request.BlockNum),
+		zap.String("account", request.Account),
+	)
+
+	blockNum := uint64(request.BlockNum)
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
+	}
+
+	resp := &pbstatedb.GetAccountResourcesResponse{
+		UpToBlock:             &pbbstream.BlockRef{Num: upToBlock.Num(), Id: upToBlock.ID()},
+		LastIrreversibleBlock: &pbbstream.BlockRef{Num: lastWrittenBlock.Num(), Id: lastWrittenBlock.ID()},
+	}
+
+	limitsEntry, err := s.db.ReadSingletEntryAt(ctx, statedb.NewAccountLimitsSinglet(request.Account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read account limits at %d: %s", blockNum, err)
+	}
+
+	if limitsEntry != nil {
+		if resp.Limits, err = limitsEntry.(*statedb.AccountLimitsEntry).Limits(); err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode account limits: %s", err)
+		}
+		resp.LimitsBlockNum = limitsEntry.Height()
+	}
+
+	usageEntry, err := s.db.ReadSingletEntryAt(ctx, statedb.NewAccountUsageSinglet(request.Account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read account usage at %d: %s", blockNum, err)
+	}
+
+	if usageEntry != nil {
+		if resp.Usage, err = usageEntry.(*statedb.AccountUsageEntry).Usage(); err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode account usage: %s", err)
+		}
+		resp.UsageBlockNum = usageEntry.Height()
+	}
+
+	ramEntry, err := s.db.ReadSingletEntryAt(ctx, statedb.NewRAMUsageSinglet(request.Account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read ram usage at %d: %s", blockNum, err)
+	}
+
+	if ramEntry != nil {
+		if resp.RamUsage, err = ramEntry.(*statedb.RAMUsageEntry).Usage(); err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode ram usage: %s", err)
+		}
+		resp.RamUsageBlockNum = ramEntry.Height()
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetResourceLimitsState(ctx context.Context, request *pbstatedb.GetResourceLimitsStateRequest) (*pbstatedb.GetResourceLimitsStateResponse, error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("get resource limits state", zap.Uint64("block_num", request.BlockNum))
+
+	blockNum := uint64(request.BlockNum)
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
+	}
+
+	resp := &pbstatedb.GetResourceLimitsStateResponse{
+		UpToBlock:             &pbbstream.BlockRef{Num: upToBlock.Num(), Id: upToBlock.ID()},
+		LastIrreversibleBlock: &pbbstream.BlockRef{Num: lastWrittenBlock.Num(), Id: lastWrittenBlock.ID()},
+	}
+
+	configEntry, err := s.db.ReadSingletEntryAt(ctx, statedb.ResourceLimitsConfigSinglet{}, actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read resource limits config at %d: %s", blockNum, err)
+	}
+
+	if configEntry != nil {
+		if resp.Config, err = configEntry.(*statedb.ResourceLimitsConfigEntry).Config(); err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode resource limits config: %s", err)
+		}
+		resp.ConfigBlockNum = configEntry.Height()
+	}
+
+	stateEntry, err := s.db.ReadSingletEntryAt(ctx, statedb.ResourceLimitsStateSinglet{}, actualBlockNum, speculativeWrites)
+	if err != nil {
+		return nil, derr.Statusf(codes.Internal, "unable to read resource limits state at %d: %s", blockNum, err)
+	}
+
+	if stateEntry != nil {
+		if resp.State, err = stateEntry.(*statedb.ResourceLimitsStateEntry).State(); err != nil {
+			return nil, derr.Statusf(codes.Internal, "unable to decode resource limits state: %s", err)
+		}
+		resp.StateBlockNum = stateEntry.Height()
+	}
+
+	return resp, nil
+}
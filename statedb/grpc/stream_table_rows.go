@@ -1,12 +1,15 @@
 package grpc
 
 import (
+	"fmt"
+
 	"github.com/dfuse-io/derr"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
 	"github.com/dfuse-io/dfuse-eosio/statedb"
 	"github.com/dfuse-io/logging"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 func (s *Server) StreamTableRows(request *pbstatedb.StreamTableRowsRequest, stream pbstatedb.State_StreamTableRowsServer) error {
@@ -16,7 +19,12 @@ func (s *Server) StreamTableRows(request *pbstatedb.StreamTableRowsRequest, stre
 		zap.Reflect("request", request),
 	)
 
-	blockNum := uint64(request.BlockNum)
+	keyConverter := getKeyConverterForType(request.KeyType)
+	rowsRange, blockNum, err := tableRowsRange(request, keyConverter)
+	if err != nil {
+		return derr.Statusf(codes.InvalidArgument, "invalid table rows range: %s", err)
+	}
+
 	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := s.prepareRead(ctx, blockNum, request.IrreversibleOnly)
 	if err != nil {
 		return derr.Statusf(codes.Internal, "unable to prepare read: %s", err)
@@ -35,7 +43,10 @@ func (s *Server) StreamTableRows(request *pbstatedb.StreamTableRowsRequest, stre
 		return derr.Statusf(codes.Internal, "read table rows failed: %s", err)
 	}
 
-	keyConverter := getKeyConverterForType(request.KeyType)
+	rows, nextKey := rowsRange.Apply(rows)
+	if nextKey != nil {
+		stream.SetTrailer(metadata.Pairs(pbstatedb.MetdataNextCursor, (&statedb.TableRowsCursor{BlockNum: actualBlockNum, NextKey: *nextKey}).String()))
+	}
 
 	stream.SetHeader(newMetadata(upToBlock, lastWrittenBlock))
 	for _, row := range rows {
@@ -49,3 +60,35 @@ func (s *Server) StreamTableRows(request *pbstatedb.StreamTableRowsRequest, stre
 
 	return nil
 }
+
+// tableRowsRange converts the request bounds using the request's key type, when a cursor is
+// provided, it overrides both the requested block num and the lower bound.
+func tableRowsRange(request *pbstatedb.StreamTableRowsRequest, keyConverter KeyConverter) (rowsRange *statedb.TableRowsRange, blockNum uint64, err error) {
+	blockNum = request.BlockNum
+	rowsRange = statedb.NewTableRowsRange()
+	rowsRange.Limit = request.Limit
+
+	if request.LowerBound != "" {
+		if rowsRange.LowerBound, err = keyConverter.FromString(request.LowerBound); err != nil {
+			return nil, 0, fmt.Errorf("lower bound %q is not a valid %s key: %w", request.LowerBound, request.KeyType, err)
+		}
+	}
+
+	if request.UpperBound != "" {
+		if rowsRange.UpperBound, err = keyConverter.FromString(request.UpperBound); err != nil {
+			return nil, 0, fmt.Errorf("upper bound %q is not a valid %s key: %w", request.UpperBound, request.KeyType, err)
+		}
+	}
+
+	if request.Cursor != "" {
+		cursor, err := statedb.DecodeTableRowsCursor(request.Cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		blockNum = cursor.BlockNum
+		rowsRange.LowerBound = cursor.NextKey
+	}
+
+	return rowsRange, blockNum, nil
+}
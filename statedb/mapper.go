@@ -25,6 +25,9 @@ import (
 )
 
 type BlockMapper struct {
+	// BlockFilter, when set, is applied to the block by `Map` itself instead of by fluxdb, once
+	// the resource usage ops were read so the ones of the transactions it excludes are kept
+	BlockFilter func(blk *bstream.Block) error
 }
 
 func (m *BlockMapper) Map(rawBlk *bstream.Block) (*fluxdb.WriteRequest, error) {
@@ -41,6 +44,31 @@ func (m *BlockMapper) Map(rawBlk *bstream.Block) (*fluxdb.WriteRequest, error) {
 	}
 
 	blockNum := req.BlockRef.Num()
+
+	// Resource usage is chain-wide accounting, we process them all regardless of the filtering applied to the block,
+	// so they are read before it is filtered, the transactions excluded by the filter consumed resources too. A block
+	// filtered upstream (by merged-filter for example) only has the ops of the transactions it kept.
+	for _, trx := range blk.TransactionTraces() {
+		for _, ramOp := range trx.RamOps {
+			entry, err := NewRAMUsageEntry(blockNum, ramOp)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create ram usage entry for ram op: %w", err)
+			}
+
+			lastSingletEntryMap[keyForEntry(entry)] = entry
+		}
+
+		if err := addRlimitOpsToEntries(blockNum, trx.RlimitOps, lastSingletEntryMap); err != nil {
+			return nil, fmt.Errorf("unable to map transaction rlimit ops: %w", err)
+		}
+	}
+
+	if m.BlockFilter != nil {
+		if err := m.BlockFilter(rawBlk); err != nil {
+			return nil, fmt.Errorf("block filter: %w", err)
+		}
+	}
+
 	for _, trx := range blk.TransactionTraces() {
 		actionMatcher := blk.FilteringActionMatcher(trx, isRequiredSystemAction)
 
@@ -84,6 +112,13 @@ func (m *BlockMapper) Map(rawBlk *bstream.Block) (*fluxdb.WriteRequest, error) {
 			for _, row := range rows {
 				lastTabletRowMap[keyForRow(row)] = row
 			}
+
+			permissionRow, err := NewPermissionRow(blockNum, permOp)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create permission row for perm op: %w", err)
+			}
+
+			lastTabletRowMap[keyForRow(permissionRow)] = permissionRow
 		}
 
 		for _, tableOp := range trx.TableOps {
@@ -119,6 +154,23 @@ func (m *BlockMapper) Map(rawBlk *bstream.Block) (*fluxdb.WriteRequest, error) {
 
 				lastSingletEntryMap[keyForEntry(abiEntry)] = abiEntry
 
+			case "eosio:setcode":
+				codeEntry, err := NewContractCodeEntry(blockNum, act)
+				if err != nil {
+					return nil, fmt.Errorf("unable to extract code entry: %w", err)
+				}
+
+				lastSingletEntryMap[keyForEntry(codeEntry)] = codeEntry
+
+				codeDeploymentRow, err := NewCodeDeploymentRow(blockNum, act)
+				if err != nil {
+					return nil, fmt.Errorf("unable to extract code deployment row: %w", err)
+				}
+
+				if codeDeploymentRow != nil {
+					lastTabletRowMap[keyForRow(codeDeploymentRow)] = codeDeploymentRow
+				}
+
 			case "eosio:linkauth":
 				authLinkRow, err := NewInsertAuthLinkRow(blockNum, act)
 				if err != nil {
@@ -138,6 +190,12 @@ func (m *BlockMapper) Map(rawBlk *bstream.Block) (*fluxdb.WriteRequest, error) {
 		}
 	}
 
+	// Block level rlimit ops are the ones applied when the block is finalized (pending account limits,
+	// elastic limits state), they come after all the transactions' ones.
+	if err := addRlimitOpsToEntries(blockNum, blk.RlimitOps, lastSingletEntryMap); err != nil {
+		return nil, fmt.Errorf("unable to map block rlimit ops: %w", err)
+	}
+
 	addSingletEntriesToRequest(req, lastSingletEntryMap)
 	addTabletRowsToRequest(req, lastTabletRowMap)
 
@@ -150,7 +208,7 @@ func isRequiredSystemAction(actTrace *pbcodec.ActionTrace) bool {
 	}
 
 	actionName := actTrace.Action.Name
-	return actionName == "setabi" || actionName == "newaccount" || actionName == "updateauth" || actionName == "deleteauth" || actionName == "linkauth" || actionName == "unlinkauth"
+	return actionName == "setabi" || actionName == "setcode" || actionName == "newaccount" || actionName == "updateauth" || actionName == "deleteauth" || actionName == "linkauth" || actionName == "unlinkauth"
 }
 
 func addSingletEntriesToRequest(request *fluxdb.WriteRequest, singleEntriesMap map[string]fluxdb.SingletEntry) {
@@ -233,6 +291,40 @@ func permToKeyAccountRows(blockNum uint64, perm *pbcodec.PermissionObject, isDel
 	return
 }
 
+func addRlimitOpsToEntries(blockNum uint64, rlimitOps []*pbcodec.RlimitOp, entries map[string]fluxdb.SingletEntry) error {
+	for _, rlimitOp := range rlimitOps {
+		entry, err := rlimitOpToEntry(blockNum, rlimitOp)
+		if err != nil {
+			return fmt.Errorf("unable to create entry for rlimit op: %w", err)
+		}
+
+		if entry != nil {
+			entries[keyForEntry(entry)] = entry
+		}
+	}
+
+	return nil
+}
+
+func rlimitOpToEntry(blockNum uint64, rlimitOp *pbcodec.RlimitOp) (fluxdb.SingletEntry, error) {
+	switch v := rlimitOp.Kind.(type) {
+	case *pbcodec.RlimitOp_AccountLimits:
+		entry, err := NewAccountLimitsEntry(blockNum, v.AccountLimits)
+		if entry == nil || err != nil {
+			return nil, err
+		}
+		return entry, nil
+	case *pbcodec.RlimitOp_AccountUsage:
+		return NewAccountUsageEntry(blockNum, v.AccountUsage)
+	case *pbcodec.RlimitOp_Config:
+		return NewResourceLimitsConfigEntry(blockNum, v.Config)
+	case *pbcodec.RlimitOp_State:
+		return NewResourceLimitsStateEntry(blockNum, v.State)
+	}
+
+	return nil, fmt.Errorf("unknown rlimit op kind %T", rlimitOp.Kind)
+}
+
 func keyForEntry(entry fluxdb.SingletEntry) string {
 	return string(fluxdb.KeyForSingletEntry(entry))
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	"github.com/dfuse-io/dfuse-eosio/filtering"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/dfuse-io/fluxdb"
 	"github.com/dfuse-io/jsonpb"
@@ -126,6 +127,56 @@ func TestBlockMapper(t *testing.T) {
 			expectedRows: nil,
 		},
 
+		{
+			name: "new permission gives permission and key account rows",
+			input: ct.Block(t, "00000001aa", ct.TrxTrace(t,
+				ct.PermOp(t, "INS", ct.NewPerm(ct.Permission(t, "alice@active", ct.PublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")))),
+			)),
+			expectedRows: []string{
+				`ka:EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV:0000000000000001:alice => 0801`,
+				`pm:alice:0000000000000001:active => {"authority":{"keys":[{"publicKey":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","weight":1}]}}`,
+			},
+		},
+		{
+			name: "removed permission deletes permission row",
+			input: ct.Block(t, "00000001aa", ct.TrxTrace(t,
+				ct.PermOp(t, "REM", ct.OldPerm(ct.Permission(t, "alice@active"))),
+			)),
+			expectedRows: []string{
+				`pm:alice:0000000000000001:active => {}`,
+			},
+		},
+		{
+			name: "ram ops give ram usage entries, last one sticks",
+			input: ct.Block(t, "00000001aa", ct.TrxTrace(t,
+				ct.RAMOp(t, "alice", 100, 1100),
+				ct.RAMOp(t, "alice", 50, 1150),
+				ct.RAMOp(t, "bob", -200, 0),
+			)),
+			expectedEntries: []string{
+				`ram:alice:fffffffffffffffe => {"usage":"1150"}`,
+				`ram:bob:fffffffffffffffe => {}`,
+			},
+		},
+		{
+			name: "rlimit ops, pending account limits are skipped, block ones applied last",
+			input: ct.Block(t, "00000001aa",
+				ct.TrxTrace(t,
+					ct.RlimitOp(t, &pbcodec.RlimitAccountLimits{Owner: "alice", Pending: true, RamBytes: 2000}),
+					ct.RlimitOp(t, &pbcodec.RlimitAccountUsage{Owner: "alice", RamUsage: 1150}),
+					ct.RlimitOp(t, &pbcodec.RlimitState{TotalRamBytes: 10}),
+				),
+				ct.RlimitOp(t, &pbcodec.RlimitAccountLimits{Owner: "alice", RamBytes: 2000}),
+				ct.RlimitOp(t, &pbcodec.RlimitConfig{AccountCpuUsageAverageWindow: 172800}),
+				ct.RlimitOp(t, &pbcodec.RlimitState{TotalRamBytes: 20}),
+			),
+			expectedEntries: []string{
+				`rlal:alice:fffffffffffffffe => {"owner":"alice","ramBytes":"2000"}`,
+				`rlau:alice:fffffffffffffffe => {"owner":"alice","ramUsage":"1150"}`,
+				`rlcfg:fffffffffffffffe => {"accountCpuUsageAverageWindow":172800}`,
+				`rlst:fffffffffffffffe => {"totalRamBytes":"20"}`,
+			},
+		},
 		{
 			name: "valid ABI gives a singlet entry",
 			input: ct.Block(t, "00000001aa", ct.TrxTrace(t,
@@ -142,6 +193,27 @@ func TestBlockMapper(t *testing.T) {
 			)),
 			expectedEntries: nil,
 		},
+		{
+			name: "setcode",
+			input: ct.Block(t, "00000002aa", ct.TrxTrace(t,
+				ct.ActionTraceSetCode(t, "eosio.token", []byte{0x00, 0x61, 0x73, 0x6d}),
+			)),
+			expectedEntries: []string{
+				`code:eosio.token:fffffffffffffffd => {"codeHash":"cd5d4935a48c0672cb06407bb443bc0087aff947c6b864bac886982c73b3027f"}`,
+			},
+			expectedRows: []string{
+				`cd:eosio.token:0000000000000002:2 => {"codeHash":"cd5d4935a48c0672cb06407bb443bc0087aff947c6b864bac886982c73b3027f"}`,
+			},
+		},
+		{
+			name: "setcode clearing code",
+			input: ct.Block(t, "00000002aa", ct.TrxTrace(t,
+				ct.ActionTraceSetCode(t, "eosio.token", nil),
+			)),
+			expectedEntries: []string{
+				`code:eosio.token:fffffffffffffffd => {}`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -168,6 +240,49 @@ func TestBlockMapper(t *testing.T) {
 	}
 }
 
+func TestBlockMapper_FilteredBlock(t *testing.T) {
+	blockFilter, err := filtering.NewBlockFilter([]string{`receiver == "eosio.token"`}, nil, nil)
+	require.NoError(t, err)
+
+	blk := ct.ToBstreamBlock(t, ct.Block(t, "00000001aa",
+		ct.TrxTrace(t,
+			ct.ActionTrace(t, "eosio.token:eosio.token:transfer"),
+			ct.DBOp(t, "INS", "eosio.token/alice/accounts/key1", "/............1", "/d1"),
+			ct.RAMOp(t, "alice", 100, 1100),
+		),
+		ct.TrxTrace(t,
+			ct.ActionTrace(t, "bob:bob:hello"),
+			ct.DBOp(t, "INS", "bob/bob/table1/key1", "/............2", "/d2"),
+			ct.RAMOp(t, "bob", 200, 1200),
+			ct.RlimitOp(t, &pbcodec.RlimitAccountUsage{Owner: "bob", RamUsage: 1200}),
+		),
+	))
+
+	mapper := &BlockMapper{BlockFilter: blockFilter.TransformInPlace}
+	req, err := mapper.Map(blk)
+	require.NoError(t, err)
+
+	var stringEntries []string
+	for _, entry := range req.SingletEntries {
+		stringEntries = append(stringEntries, entryToString(t, entry))
+	}
+	assert.ElementsMatch(t, []string{
+		`ram:alice:fffffffffffffffe => {"usage":"1100"}`,
+		`ram:bob:fffffffffffffffe => {"usage":"1200"}`,
+		`rlau:bob:fffffffffffffffe => {"owner":"bob","ramUsage":"1200"}`,
+	}, stringEntries)
+
+	var stringRows []string
+	for _, row := range req.TabletRows {
+		stringRows = append(stringRows, rowToString(t, row))
+	}
+	assert.ElementsMatch(t, []string{
+		`cst:eosio.token:alice:accounts:0000000000000001:key1 => {"payer":"1","data":"6431"}`,
+	}, stringRows)
+
+	assert.True(t, blk.ToNative().(*pbcodec.Block).FilteringApplied)
+}
+
 func entryToString(t *testing.T, entry fluxdb.SingletEntry) string {
 	return genericElementToString(t, entry.String(), entry)
 }
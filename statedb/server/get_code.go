@@ -0,0 +1,131 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getCodeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateGetCodeRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetCodeRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("prepare read failed: %w", err))
+		return
+	}
+
+	account := string(request.Account)
+	resp := &getCodeResponse{
+		commonStateResponse: newCommonGetResponse(upToBlock, lastWrittenBlock),
+		Account:             request.Account,
+	}
+
+	entry, err := srv.db.ReadSingletEntryAt(ctx, statedb.NewContractCodeSinglet(account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read code at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if entry != nil {
+		code, err := entry.(*statedb.ContractCodeEntry).Code()
+		if err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode code: %w", err))
+			return
+		}
+
+		resp.Code = statedb.ToCodeDeployment(entry.Height(), code)
+	}
+
+	if request.WithDeployments {
+		tabletRows, err := srv.db.ReadTabletAt(ctx, actualBlockNum, statedb.NewCodeDeploymentTablet(account), speculativeWrites)
+		if err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to read code deployments at %d: %w", request.BlockNum, err))
+			return
+		}
+
+		resp.Deployments = make([]*pbstatedb.CodeDeployment, len(tabletRows))
+		for i, tabletRow := range tabletRows {
+			row := tabletRow.(*statedb.CodeDeploymentRow)
+			code, err := row.Code()
+			if err != nil {
+				writeError(ctx, w, fmt.Errorf("unable to decode code deployment %q: %w", row, err))
+				return
+			}
+
+			resp.Deployments[i] = statedb.ToCodeDeployment(row.DeployedAt(), code)
+		}
+
+		sort.Slice(resp.Deployments, func(i, j int) bool {
+			return resp.Deployments[i].BlockNum < resp.Deployments[j].BlockNum
+		})
+	}
+
+	writeResponse(ctx, w, resp)
+}
+
+type getCodeRequest struct {
+	BlockNum        uint64          `json:"block_num"`
+	Account         eos.AccountName `json:"account"`
+	WithDeployments bool            `json:"with_deployments"`
+}
+
+type getCodeResponse struct {
+	*commonStateResponse
+
+	Account     eos.AccountName             `json:"account"`
+	Code        *pbstatedb.CodeDeployment   `json:"code,omitempty"`
+	Deployments []*pbstatedb.CodeDeployment `json:"deployments,omitempty"`
+}
+
+func validateGetCodeRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num":        []string{"fluxdb.eos.blockNum"},
+		"account":          []string{"required", "fluxdb.eos.name"},
+		"with_deployments": []string{"bool"},
+	})
+}
+
+func extractGetCodeRequest(r *http.Request) *getCodeRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getCodeRequest{
+		BlockNum:        uint64(blockNum64),
+		Account:         eos.AccountName(r.FormValue("account")),
+		WithDeployments: boolInput(r.FormValue("with_deployments")),
+	}
+}
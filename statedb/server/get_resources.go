@@ -0,0 +1,214 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getAccountResourcesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateGetAccountResourcesRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetAccountResourcesRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("prepare read failed: %w", err))
+		return
+	}
+
+	account := string(request.Account)
+	resp := &getAccountResourcesResponse{
+		commonStateResponse: newCommonGetResponse(upToBlock, lastWrittenBlock),
+		Account:             request.Account,
+	}
+
+	limitsEntry, err := srv.db.ReadSingletEntryAt(ctx, statedb.NewAccountLimitsSinglet(account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read account limits at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if limitsEntry != nil {
+		if resp.Limits, err = limitsEntry.(*statedb.AccountLimitsEntry).Limits(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode account limits: %w", err))
+			return
+		}
+		resp.LimitsBlockNum = limitsEntry.Height()
+	}
+
+	usageEntry, err := srv.db.ReadSingletEntryAt(ctx, statedb.NewAccountUsageSinglet(account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read account usage at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if usageEntry != nil {
+		if resp.Usage, err = usageEntry.(*statedb.AccountUsageEntry).Usage(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode account usage: %w", err))
+			return
+		}
+		resp.UsageBlockNum = usageEntry.Height()
+	}
+
+	ramEntry, err := srv.db.ReadSingletEntryAt(ctx, statedb.NewRAMUsageSinglet(account), actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read ram usage at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if ramEntry != nil {
+		if resp.RAMUsage, err = ramEntry.(*statedb.RAMUsageEntry).Usage(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode ram usage: %w", err))
+			return
+		}
+		resp.RAMUsageBlockNum = ramEntry.Height()
+	}
+
+	writeResponse(ctx, w, resp)
+}
+
+func (srv *EOSServer) getResourceLimitsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateGetResourceLimitsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetResourceLimitsRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("prepare read failed: %w", err))
+		return
+	}
+
+	resp := &getResourceLimitsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlock, lastWrittenBlock),
+	}
+
+	configEntry, err := srv.db.ReadSingletEntryAt(ctx, statedb.ResourceLimitsConfigSinglet{}, actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read resource limits config at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if configEntry != nil {
+		if resp.Config, err = configEntry.(*statedb.ResourceLimitsConfigEntry).Config(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode resource limits config: %w", err))
+			return
+		}
+		resp.ConfigBlockNum = configEntry.Height()
+	}
+
+	stateEntry, err := srv.db.ReadSingletEntryAt(ctx, statedb.ResourceLimitsStateSinglet{}, actualBlockNum, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read resource limits state at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	if stateEntry != nil {
+		if resp.State, err = stateEntry.(*statedb.ResourceLimitsStateEntry).State(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to decode resource limits state: %w", err))
+			return
+		}
+		resp.StateBlockNum = stateEntry.Height()
+	}
+
+	writeResponse(ctx, w, resp)
+}
+
+type getAccountResourcesRequest struct {
+	BlockNum uint64          `json:"block_num"`
+	Account  eos.AccountName `json:"account"`
+}
+
+type getAccountResourcesResponse struct {
+	*commonStateResponse
+
+	Account          eos.AccountName              `json:"account"`
+	Limits           *pbcodec.RlimitAccountLimits `json:"limits,omitempty"`
+	LimitsBlockNum   uint64                       `json:"limits_block_num,omitempty"`
+	Usage            *pbcodec.RlimitAccountUsage  `json:"usage,omitempty"`
+	UsageBlockNum    uint64                       `json:"usage_block_num,omitempty"`
+	RAMUsage         uint64                       `json:"ram_usage"`
+	RAMUsageBlockNum uint64                       `json:"ram_usage_block_num,omitempty"`
+}
+
+type getResourceLimitsRequest struct {
+	BlockNum uint64 `json:"block_num"`
+}
+
+type getResourceLimitsResponse struct {
+	*commonStateResponse
+
+	Config         *pbcodec.RlimitConfig `json:"config,omitempty"`
+	ConfigBlockNum uint64                `json:"config_block_num,omitempty"`
+	State          *pbcodec.RlimitState  `json:"state,omitempty"`
+	StateBlockNum  uint64                `json:"state_block_num,omitempty"`
+}
+
+func validateGetAccountResourcesRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"account":   []string{"required", "fluxdb.eos.name"},
+	})
+}
+
+func extractGetAccountResourcesRequest(r *http.Request) *getAccountResourcesRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getAccountResourcesRequest{
+		BlockNum: uint64(blockNum64),
+		Account:  eos.AccountName(r.FormValue("account")),
+	}
+}
+
+func validateGetResourceLimitsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num": []string{"fluxdb.eos.blockNum"},
+	})
+}
+
+func extractGetResourceLimitsRequest(r *http.Request) *getResourceLimitsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getResourceLimitsRequest{
+		BlockNum: uint64(blockNum64),
+	}
+}
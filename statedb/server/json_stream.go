@@ -27,6 +27,7 @@ import (
 func (r *getTableRowsResponse) MarshalJSONObject(enc *gojay.Encoder) {
 	r.commonStateResponse.MarshalJSONObject(enc)
 	r.readTableResponse.MarshalJSONObject(enc)
+	enc.AddStringKeyOmitEmpty("next_cursor", r.NextCursor)
 }
 
 func (r *getTableRowsResponse) IsNil() bool { return r == nil }
@@ -0,0 +1,156 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/dfuse-eosio/statedb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) listPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateListPermissionsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractListPermissionsRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("prepare read failed: %w", err))
+		return
+	}
+
+	tablet := statedb.NewPermissionTablet(string(request.Account))
+	tabletRows, err := srv.db.ReadTabletAt(
+		ctx,
+		actualBlockNum,
+		tablet,
+		speculativeWrites,
+	)
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("unable to read tablet at %d: %w", request.BlockNum, err))
+		return
+	}
+
+	values := make([]*pbstatedb.PermissionValue, len(tabletRows))
+	namesByID := make(map[uint64]string, len(tabletRows))
+	for i, tabletRow := range tabletRows {
+		row := tabletRow.(*statedb.PermissionRow)
+		if values[i], err = row.Permission(); err != nil {
+			writeError(ctx, w, fmt.Errorf("unable to read tablet row %q value at %d: %w", row, request.BlockNum, err))
+			return
+		}
+
+		namesByID[values[i].Id] = row.Name()
+	}
+
+	resp := &listPermissionsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlock, lastWrittenBlock),
+		Permissions:         make([]*permission, len(tabletRows)),
+	}
+
+	for i, tabletRow := range tabletRows {
+		value := values[i]
+
+		// The `owner` permission has no parent, its parent id is 0 which is also the id of `eosio@owner`
+		parent := ""
+		if value.ParentId != value.Id {
+			parent = namesByID[value.ParentId]
+		}
+
+		perm := &permission{
+			Permission: eos.Permission{
+				PermName: tabletRow.(*statedb.PermissionRow).Name(),
+				Parent:   parent,
+			},
+			BlockNum: tabletRow.Height(),
+		}
+
+		if value.Authority != nil {
+			perm.RequiredAuth = codec.AuthoritiesToEOS(value.Authority)
+		}
+
+		if value.LastUpdated != nil {
+			lastUpdated, err := ptypes.Timestamp(value.LastUpdated)
+			if err != nil {
+				writeError(ctx, w, fmt.Errorf("invalid last updated time for permission %q: %w", perm.PermName, err))
+				return
+			}
+			perm.LastUpdated = &eos.JSONTime{Time: lastUpdated}
+		}
+
+		resp.Permissions[i] = perm
+	}
+
+	zlogger.Debug("sorting permissions")
+	sort.Slice(resp.Permissions, func(i, j int) bool {
+		return resp.Permissions[i].PermName < resp.Permissions[j].PermName
+	})
+
+	writeResponse(ctx, w, resp)
+}
+
+type listPermissionsRequest struct {
+	BlockNum uint64          `json:"block_num"`
+	Account  eos.AccountName `json:"account"`
+}
+
+type listPermissionsResponse struct {
+	*commonStateResponse
+
+	Permissions []*permission `json:"permissions"`
+}
+
+type permission struct {
+	eos.Permission
+
+	LastUpdated *eos.JSONTime `json:"last_updated,omitempty"`
+	BlockNum    uint64        `json:"block_num"`
+}
+
+func validateListPermissionsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"account":   []string{"required", "fluxdb.eos.name"},
+	})
+}
+
+func extractListPermissionsRequest(r *http.Request) *listPermissionsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &listPermissionsRequest{
+		BlockNum: uint64(blockNum64),
+		Account:  eos.AccountName(r.FormValue("account")),
+	}
+}
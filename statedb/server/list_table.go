@@ -42,6 +42,13 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 	request := extractGetTableRequest(r)
 	zlog.Debug("extracted request", zap.Reflect("request", request))
 
+	keyConverter := getKeyConverterForType(request.KeyType)
+	rowsRange, errors := request.tableRowsRange(keyConverter)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
 	actualBlockNum, lastWrittenBlock, upToBlock, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, request.IrreversibleOnly)
 	if err != nil {
 		writeError(ctx, w, fmt.Errorf("prepare read failed: %w", err))
@@ -67,6 +74,8 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 		abi = serializationInfo.abi
 	}
 
+	rows, nextKey := rowsRange.Apply(rows)
+
 	response := &getTableRowsResponse{
 		commonStateResponse: newCommonGetResponse(upToBlock, lastWrittenBlock),
 		readTableResponse: &readTableResponse{
@@ -75,7 +84,9 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 		},
 	}
 
-	keyConverter := getKeyConverterForType(request.KeyType)
+	if nextKey != nil {
+		response.NextCursor = (&statedb.TableRowsCursor{BlockNum: actualBlockNum, NextKey: *nextKey}).String()
+	}
 
 	for _, row := range rows {
 		tableRow, err := toTableRow(row.(*statedb.ContractStateRow), keyConverter, serializationInfo, request.WithBlockNum)
@@ -97,6 +108,44 @@ type listTableRowsRequest struct {
 	Account          string `json:"account"`
 	Table            string `json:"table"`
 	Scope            string `json:"scope"`
+	LowerBound       string `json:"lower_bound"`
+	UpperBound       string `json:"upper_bound"`
+	Cursor           string `json:"cursor"`
+}
+
+// tableRowsRange converts the bounds using the request's key type, when a cursor is
+// provided, it overrides both the requested block num and the lower bound.
+func (r *listTableRowsRequest) tableRowsRange(keyConverter KeyConverter) (rowsRange *statedb.TableRowsRange, errors url.Values) {
+	errors = url.Values{}
+	rowsRange = statedb.NewTableRowsRange()
+	if r.Limit > 0 {
+		rowsRange.Limit = uint64(r.Limit)
+	}
+
+	var err error
+	if r.LowerBound != "" {
+		if rowsRange.LowerBound, err = keyConverter.FromString(r.LowerBound); err != nil {
+			errors["lower_bound"] = []string{fmt.Sprintf("The lower_bound field is not a valid %s key: %s", r.KeyType, err)}
+		}
+	}
+
+	if r.UpperBound != "" {
+		if rowsRange.UpperBound, err = keyConverter.FromString(r.UpperBound); err != nil {
+			errors["upper_bound"] = []string{fmt.Sprintf("The upper_bound field is not a valid %s key: %s", r.KeyType, err)}
+		}
+	}
+
+	if r.Cursor != "" {
+		cursor, err := statedb.DecodeTableRowsCursor(r.Cursor)
+		if err != nil {
+			errors["cursor"] = []string{fmt.Sprintf("The cursor field is not a valid cursor: %s", err)}
+		} else {
+			r.BlockNum = cursor.BlockNum
+			rowsRange.LowerBound = cursor.NextKey
+		}
+	}
+
+	return rowsRange, errors
 }
 
 func validateGetTableRequest(r *http.Request) url.Values {
@@ -123,6 +172,9 @@ func extractGetTableRequest(r *http.Request) *listTableRowsRequest {
 		Table:            r.FormValue("table"),
 		Account:          r.FormValue("account"),
 		Scope:            r.FormValue("scope"),
+		LowerBound:       r.FormValue("lower_bound"),
+		UpperBound:       r.FormValue("upper_bound"),
+		Cursor:           r.FormValue("cursor"),
 		IrreversibleOnly: irreversibleOnly,
 	}
 }
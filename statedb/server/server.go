@@ -68,9 +68,13 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 	coreRouter.Use(trackingMiddleware)
 
 	coreRouter.Methods("GET").Path("/v0/state/abi").HandlerFunc(srv.getABIHandler)
+	coreRouter.Methods("GET").Path("/v0/state/account_resources").HandlerFunc(srv.getAccountResourcesHandler)
+	coreRouter.Methods("GET").Path("/v0/state/code").HandlerFunc(srv.getCodeHandler)
 	coreRouter.Methods("POST").Path("/v0/state/abi/bin_to_json").HandlerFunc(srv.decodeABIHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/key_accounts").HandlerFunc(srv.listKeyAccountsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/permission_links").HandlerFunc(srv.listLinkedPermissionsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/permissions").HandlerFunc(srv.listPermissionsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/resource_limits").HandlerFunc(srv.getResourceLimitsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/table").HandlerFunc(srv.listTableRowsHandler)
 
 	coreRouter.Methods("GET").Path("/v0/state/table/row").HandlerFunc(srv.getTableRowHandler)
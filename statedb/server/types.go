@@ -90,6 +90,8 @@ type onTheFlyABISerializer struct {
 type getTableRowsResponse struct {
 	*commonStateResponse
 	*readTableResponse
+
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type getMultiTableRowsResponse struct {
@@ -0,0 +1,208 @@
+package statedb
+
+import (
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/golang/protobuf/proto"
+)
+
+const rlalCollection = 0xA100
+const rlalName = "rlal"
+
+const rlauCollection = 0xA200
+const rlauName = "rlau"
+
+const ramCollection = 0xA300
+const ramName = "ram"
+
+func init() {
+	fluxdb.RegisterSingletFactory(rlalCollection, rlalName, func(identifier []byte) (fluxdb.Singlet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("account limits singlet identifier", 8, len(identifier))
+		}
+
+		return AccountLimitsSinglet(identifier[0:8]), nil
+	})
+
+	fluxdb.RegisterSingletFactory(rlauCollection, rlauName, func(identifier []byte) (fluxdb.Singlet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("account usage singlet identifier", 8, len(identifier))
+		}
+
+		return AccountUsageSinglet(identifier[0:8]), nil
+	})
+
+	fluxdb.RegisterSingletFactory(ramCollection, ramName, func(identifier []byte) (fluxdb.Singlet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("ram usage singlet identifier", 8, len(identifier))
+		}
+
+		return RAMUsageSinglet(identifier[0:8]), nil
+	})
+}
+
+// AccountLimitsSinglet holds the CPU, NET and RAM limits currently in effect for an account
+type AccountLimitsSinglet []byte
+
+func NewAccountLimitsSinglet(account string) AccountLimitsSinglet {
+	return AccountLimitsSinglet(standardNameToBytes(account))
+}
+
+func (s AccountLimitsSinglet) Collection() uint16 {
+	return rlalCollection
+}
+
+func (s AccountLimitsSinglet) Identifier() []byte {
+	return []byte(s)
+}
+
+func (s AccountLimitsSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &AccountLimitsEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s AccountLimitsSinglet) String() string {
+	return rlalName + ":" + bytesToName(s)
+}
+
+type AccountLimitsEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+// NewAccountLimitsEntry returns `nil` for pending limits, those are only recorded once
+// they are applied at the end of the block, through an update of the non-pending limits.
+func NewAccountLimitsEntry(blockNum uint64, limits *pbcodec.RlimitAccountLimits) (*AccountLimitsEntry, error) {
+	if limits.Pending {
+		return nil, nil
+	}
+
+	value, err := proto.Marshal(limits)
+	if err != nil {
+		return nil, fmt.Errorf("marshal proto: %w", err)
+	}
+
+	return &AccountLimitsEntry{baseEntry(NewAccountLimitsSinglet(limits.Owner), blockNum, value)}, nil
+}
+
+func (e *AccountLimitsEntry) Limits() (*pbcodec.RlimitAccountLimits, error) {
+	pb := &pbcodec.RlimitAccountLimits{}
+	if err := proto.Unmarshal(e.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func (e *AccountLimitsEntry) ToProto() (proto.Message, error) {
+	return e.Limits()
+}
+
+// AccountUsageSinglet holds the CPU, NET and RAM usage of an account
+type AccountUsageSinglet []byte
+
+func NewAccountUsageSinglet(account string) AccountUsageSinglet {
+	return AccountUsageSinglet(standardNameToBytes(account))
+}
+
+func (s AccountUsageSinglet) Collection() uint16 {
+	return rlauCollection
+}
+
+func (s AccountUsageSinglet) Identifier() []byte {
+	return []byte(s)
+}
+
+func (s AccountUsageSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &AccountUsageEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s AccountUsageSinglet) String() string {
+	return rlauName + ":" + bytesToName(s)
+}
+
+type AccountUsageEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+func NewAccountUsageEntry(blockNum uint64, usage *pbcodec.RlimitAccountUsage) (*AccountUsageEntry, error) {
+	value, err := proto.Marshal(usage)
+	if err != nil {
+		return nil, fmt.Errorf("marshal proto: %w", err)
+	}
+
+	return &AccountUsageEntry{baseEntry(NewAccountUsageSinglet(usage.Owner), blockNum, value)}, nil
+}
+
+func (e *AccountUsageEntry) Usage() (*pbcodec.RlimitAccountUsage, error) {
+	pb := &pbcodec.RlimitAccountUsage{}
+	if err := proto.Unmarshal(e.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func (e *AccountUsageEntry) ToProto() (proto.Message, error) {
+	return e.Usage()
+}
+
+// RAMUsageSinglet holds the amount of RAM bytes used by an account, as reported by the
+// RAM ops of the transactions it paid RAM for.
+type RAMUsageSinglet []byte
+
+func NewRAMUsageSinglet(account string) RAMUsageSinglet {
+	return RAMUsageSinglet(standardNameToBytes(account))
+}
+
+func (s RAMUsageSinglet) Collection() uint16 {
+	return ramCollection
+}
+
+func (s RAMUsageSinglet) Identifier() []byte {
+	return []byte(s)
+}
+
+func (s RAMUsageSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &RAMUsageEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s RAMUsageSinglet) String() string {
+	return ramName + ":" + bytesToName(s)
+}
+
+type RAMUsageEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+// NewRAMUsageEntry creates the entry for the payer of the RAM op, a usage of 0 bytes
+// results in a deletion, reading the singlet then gives no entry which also means 0 bytes.
+func NewRAMUsageEntry(blockNum uint64, ramOp *pbcodec.RAMOp) (entry *RAMUsageEntry, err error) {
+	var value []byte
+	if ramOp.Usage > 0 {
+		if value, err = proto.Marshal(&pbstatedb.RAMUsageValue{Usage: ramOp.Usage}); err != nil {
+			return nil, fmt.Errorf("marshal proto: %w", err)
+		}
+	}
+
+	return &RAMUsageEntry{baseEntry(NewRAMUsageSinglet(ramOp.Payer), blockNum, value)}, nil
+}
+
+func (e *RAMUsageEntry) Usage() (uint64, error) {
+	pb := pbstatedb.RAMUsageValue{}
+	if err := proto.Unmarshal(e.Value(), &pb); err != nil {
+		return 0, err
+	}
+
+	return pb.Usage, nil
+}
+
+func (e *RAMUsageEntry) ToProto() (proto.Message, error) {
+	pb := &pbstatedb.RAMUsageValue{}
+	if err := proto.Unmarshal(e.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
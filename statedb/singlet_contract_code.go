@@ -0,0 +1,125 @@
+package statedb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/system"
+	"github.com/golang/protobuf/proto"
+)
+
+const codeCollection = 0xA600
+const codeName = "code"
+
+func init() {
+	fluxdb.RegisterSingletFactory(codeCollection, codeName, func(identifier []byte) (fluxdb.Singlet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("code singlet identifier", 8, len(identifier))
+		}
+
+		return ContractCodeSinglet(identifier[0:8]), nil
+	})
+}
+
+// ContractCodeSinglet holds the hash, VM type and VM version of the code currently deployed
+// on a contract account
+type ContractCodeSinglet []byte
+
+func NewContractCodeSinglet(contract string) ContractCodeSinglet {
+	return ContractCodeSinglet(standardNameToBytes(contract))
+}
+
+func (s ContractCodeSinglet) Collection() uint16 {
+	return codeCollection
+}
+
+func (s ContractCodeSinglet) Identifier() []byte {
+	return []byte(s)
+}
+
+func (s ContractCodeSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &ContractCodeEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s ContractCodeSinglet) Contract() string {
+	return bytesToName(s)
+}
+
+func (s ContractCodeSinglet) String() string {
+	return codeName + ":" + bytesToName(s)
+}
+
+type ContractCodeEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+// NewContractCodeEntry extracts the code entry out of a `eosio:setcode` action trace. The
+// action is decoded from its raw data since `setcode` is a native action that is always
+// binary decodable, regardless of the ABI decoding state of the block.
+func NewContractCodeEntry(blockNum uint64, actionTrace *pbcodec.ActionTrace) (entry *ContractCodeEntry, err error) {
+	setCode, value, err := decodeSetCode(actionTrace)
+	if err != nil {
+		return nil, err
+	}
+
+	singlet := ContractCodeSinglet(nameaToBytes(setCode.Account))
+	return &ContractCodeEntry{baseEntry(singlet, blockNum, value)}, nil
+}
+
+func (r *ContractCodeEntry) Contract() string {
+	return r.Singlet().(ContractCodeSinglet).Contract()
+}
+
+func (r *ContractCodeEntry) Code() (*pbstatedb.ContractCodeValue, error) {
+	pb := &pbstatedb.ContractCodeValue{}
+	if err := proto.Unmarshal(r.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func (r *ContractCodeEntry) ToProto() (proto.Message, error) {
+	return r.Code()
+}
+
+// ToCodeDeployment turns the code value into its public representation, the code hash
+// being rendered as an hexadecimal string
+func ToCodeDeployment(blockNum uint64, code *pbstatedb.ContractCodeValue) *pbstatedb.CodeDeployment {
+	return &pbstatedb.CodeDeployment{
+		BlockNum:  blockNum,
+		CodeHash:  hex.EncodeToString(code.CodeHash),
+		VmType:    code.VmType,
+		VmVersion: code.VmVersion,
+	}
+}
+
+// decodeSetCode returns the decoded `setcode` action alongside the marshalled code value
+// to store, which is `nil` when the code is cleared from the account.
+func decodeSetCode(actionTrace *pbcodec.ActionTrace) (setCode *system.SetCode, value []byte, err error) {
+	if err := eos.UnmarshalBinary(actionTrace.Action.RawData, &setCode); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal setcode: %w", err)
+	}
+
+	if len(setCode.Code) == 0 {
+		return setCode, nil, nil
+	}
+
+	codeHash := sha256.Sum256(setCode.Code)
+	pb := pbstatedb.ContractCodeValue{
+		CodeHash:  codeHash[:],
+		VmType:    uint32(setCode.VMType),
+		VmVersion: uint32(setCode.VMVersion),
+	}
+
+	if value, err = proto.Marshal(&pb); err != nil {
+		return nil, nil, fmt.Errorf("marshal proto: %w", err)
+	}
+
+	return setCode, value, nil
+}
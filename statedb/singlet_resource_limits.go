@@ -0,0 +1,117 @@
+package statedb
+
+import (
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/golang/protobuf/proto"
+)
+
+const rlcfgCollection = 0xA400
+const rlcfgName = "rlcfg"
+
+const rlstCollection = 0xA500
+const rlstName = "rlst"
+
+func init() {
+	fluxdb.RegisterSingletFactory(rlcfgCollection, rlcfgName, func(identifier []byte) (fluxdb.Singlet, error) {
+		return ResourceLimitsConfigSinglet{}, nil
+	})
+
+	fluxdb.RegisterSingletFactory(rlstCollection, rlstName, func(identifier []byte) (fluxdb.Singlet, error) {
+		return ResourceLimitsStateSinglet{}, nil
+	})
+}
+
+// ResourceLimitsConfigSinglet holds the chain-wide resource limits configuration (elastic
+// CPU and NET limits parameters and accounts usage average windows), it has no identifier.
+type ResourceLimitsConfigSinglet struct{}
+
+func (s ResourceLimitsConfigSinglet) Collection() uint16 {
+	return rlcfgCollection
+}
+
+func (s ResourceLimitsConfigSinglet) Identifier() []byte {
+	return nil
+}
+
+func (s ResourceLimitsConfigSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &ResourceLimitsConfigEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s ResourceLimitsConfigSinglet) String() string {
+	return rlcfgName
+}
+
+type ResourceLimitsConfigEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+func NewResourceLimitsConfigEntry(blockNum uint64, config *pbcodec.RlimitConfig) (*ResourceLimitsConfigEntry, error) {
+	value, err := proto.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("marshal proto: %w", err)
+	}
+
+	return &ResourceLimitsConfigEntry{baseEntry(ResourceLimitsConfigSinglet{}, blockNum, value)}, nil
+}
+
+func (e *ResourceLimitsConfigEntry) Config() (*pbcodec.RlimitConfig, error) {
+	pb := &pbcodec.RlimitConfig{}
+	if err := proto.Unmarshal(e.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func (e *ResourceLimitsConfigEntry) ToProto() (proto.Message, error) {
+	return e.Config()
+}
+
+// ResourceLimitsStateSinglet holds the chain-wide resource limits state (average block
+// usage, total weights and virtual limits), it has no identifier.
+type ResourceLimitsStateSinglet struct{}
+
+func (s ResourceLimitsStateSinglet) Collection() uint16 {
+	return rlstCollection
+}
+
+func (s ResourceLimitsStateSinglet) Identifier() []byte {
+	return nil
+}
+
+func (s ResourceLimitsStateSinglet) Entry(height uint64, data []byte) (fluxdb.SingletEntry, error) {
+	return &ResourceLimitsStateEntry{baseEntry(s, height, data)}, nil
+}
+
+func (s ResourceLimitsStateSinglet) String() string {
+	return rlstName
+}
+
+type ResourceLimitsStateEntry struct {
+	fluxdb.BaseSingletEntry
+}
+
+func NewResourceLimitsStateEntry(blockNum uint64, state *pbcodec.RlimitState) (*ResourceLimitsStateEntry, error) {
+	value, err := proto.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshal proto: %w", err)
+	}
+
+	return &ResourceLimitsStateEntry{baseEntry(ResourceLimitsStateSinglet{}, blockNum, value)}, nil
+}
+
+func (e *ResourceLimitsStateEntry) State() (*pbcodec.RlimitState, error) {
+	pb := &pbcodec.RlimitState{}
+	if err := proto.Unmarshal(e.Value(), pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func (e *ResourceLimitsStateEntry) ToProto() (proto.Message, error) {
+	return e.State()
+}
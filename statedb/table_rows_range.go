@@ -0,0 +1,100 @@
+package statedb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/fluxdb"
+	"github.com/dfuse-io/opaque"
+)
+
+// TableRowsRange filters the rows of a contract table read to the ones whose primary
+// key is between `LowerBound` and `UpperBound` (both inclusive), keeping at most `Limit`
+// rows, a `Limit` of 0 meaning no limit.
+type TableRowsRange struct {
+	LowerBound uint64
+	UpperBound uint64
+	Limit      uint64
+}
+
+func NewTableRowsRange() *TableRowsRange {
+	return &TableRowsRange{UpperBound: math.MaxUint64}
+}
+
+func (r *TableRowsRange) IsUnbounded() bool {
+	return r.LowerBound == 0 && r.UpperBound == math.MaxUint64 && r.Limit == 0
+}
+
+// Apply returns the rows (sorted by primary key, as returned by `ReadTabletAt`) that
+// are within the range. When there is more rows in the range than the limit, `nextKey`
+// is the primary key of the first row that was left out, `nil` otherwise.
+//
+// The range is applied in memory, the whole tablet is still read from fluxdb: its row
+// keys are ordered by height before primary key, so the bounds cannot be turned into a
+// scan range, and it has no primary key range read to push them down to.
+func (r *TableRowsRange) Apply(rows []fluxdb.TabletRow) (out []fluxdb.TabletRow, nextKey *uint64) {
+	start := sort.Search(len(rows), func(i int) bool {
+		return primaryKeyToUint64(rows[i]) >= r.LowerBound
+	})
+
+	end := start
+	for end < len(rows) && primaryKeyToUint64(rows[end]) <= r.UpperBound {
+		if r.Limit != 0 && uint64(end-start) == r.Limit {
+			key := primaryKeyToUint64(rows[end])
+			return rows[start:end], &key
+		}
+
+		end++
+	}
+
+	return rows[start:end], nil
+}
+
+func primaryKeyToUint64(row fluxdb.TabletRow) uint64 {
+	return binary.BigEndian.Uint64(row.PrimaryKey())
+}
+
+// TableRowsCursor is the continuation point of a paginated table rows read, it pins the
+// read to the block at which it started so that all pages are consistent with each other.
+type TableRowsCursor struct {
+	BlockNum uint64
+	NextKey  uint64
+}
+
+func (c *TableRowsCursor) String() string {
+	out, err := opaque.ToOpaque(fmt.Sprintf("%d:%d", c.BlockNum, c.NextKey))
+	if err != nil {
+		// Encoding only fails on a misconfigured opaque library, it's a programming error
+		panic(fmt.Errorf("unable to encode table rows cursor: %w", err))
+	}
+
+	return out
+}
+
+func DecodeTableRowsCursor(cursor string) (*TableRowsCursor, error) {
+	internal, err := opaque.FromOpaque(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	parts := strings.Split(internal, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid cursor: expected 2 parts, got %d", len(parts))
+	}
+
+	blockNum, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor block num: %w", err)
+	}
+
+	nextKey, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor next key: %w", err)
+	}
+
+	return &TableRowsCursor{BlockNum: blockNum, NextKey: nextKey}, nil
+}
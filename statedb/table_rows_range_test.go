@@ -0,0 +1,67 @@
+package statedb
+
+import (
+	"testing"
+
+	"github.com/dfuse-io/fluxdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableRowsRange_Apply(t *testing.T) {
+	tablet := NewContractStateTablet("eosio.test", "rows", "s")
+	rows := []fluxdb.TabletRow{
+		&ContractStateRow{baseRow(tablet, 1, uint64ToBytes(1), []byte{0x01})},
+		&ContractStateRow{baseRow(tablet, 1, uint64ToBytes(3), []byte{0x01})},
+		&ContractStateRow{baseRow(tablet, 1, uint64ToBytes(5), []byte{0x01})},
+		&ContractStateRow{baseRow(tablet, 1, uint64ToBytes(7), []byte{0x01})},
+	}
+
+	key := func(value uint64) *uint64 { return &value }
+
+	tests := []struct {
+		name         string
+		rowsRange    *TableRowsRange
+		expectedKeys []uint64
+		expectedNext *uint64
+	}{
+		{"unbounded", NewTableRowsRange(), []uint64{1, 3, 5, 7}, nil},
+		{"lower bound inclusive", &TableRowsRange{LowerBound: 3, UpperBound: 100}, []uint64{3, 5, 7}, nil},
+		{"lower bound between keys", &TableRowsRange{LowerBound: 4, UpperBound: 100}, []uint64{5, 7}, nil},
+		{"upper bound inclusive", &TableRowsRange{LowerBound: 0, UpperBound: 5}, []uint64{1, 3, 5}, nil},
+		{"limit with more", &TableRowsRange{LowerBound: 0, UpperBound: 100, Limit: 2}, []uint64{1, 3}, key(5)},
+		{"limit exactly reached", &TableRowsRange{LowerBound: 0, UpperBound: 5, Limit: 3}, []uint64{1, 3, 5}, nil},
+		{"out of range", &TableRowsRange{LowerBound: 8, UpperBound: 100}, nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, next := test.rowsRange.Apply(rows)
+
+			var keys []uint64
+			for _, row := range out {
+				keys = append(keys, primaryKeyToUint64(row))
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+			assert.Equal(t, test.expectedNext, next)
+		})
+	}
+}
+
+func TestTableRowsCursor(t *testing.T) {
+	cursor := &TableRowsCursor{BlockNum: 120, NextKey: 5}
+
+	decoded, err := DecodeTableRowsCursor(cursor.String())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = DecodeTableRowsCursor("invalid")
+	assert.Error(t, err)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	out := make([]byte, 8)
+	bigEndian.PutUint64(out, value)
+	return out
+}
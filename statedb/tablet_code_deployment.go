@@ -0,0 +1,98 @@
+package statedb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/golang/protobuf/proto"
+)
+
+const cdCollection = 0xB500
+const cdPrefix = "cd"
+
+func init() {
+	fluxdb.RegisterTabletFactory(cdCollection, cdPrefix, func(identifier []byte) (fluxdb.Tablet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("code deployment tablet identifier", 8, len(identifier))
+		}
+
+		return CodeDeploymentTablet(identifier[0:8]), nil
+	})
+}
+
+func NewCodeDeploymentTablet(contract string) CodeDeploymentTablet {
+	return CodeDeploymentTablet(standardNameToBytes(contract))
+}
+
+// CodeDeploymentTablet tablet is composed of every code deployment ever made on a contract
+// account, keyed by the block number at which the deployment happened. It complements the
+// `ContractCodeSinglet` which only gives the code active at a given height.
+type CodeDeploymentTablet []byte
+
+func (t CodeDeploymentTablet) Collection() uint16 {
+	return cdCollection
+}
+
+func (t CodeDeploymentTablet) Identifier() []byte {
+	return t
+}
+
+func (t CodeDeploymentTablet) Row(height uint64, primaryKey []byte, data []byte) (fluxdb.TabletRow, error) {
+	if len(primaryKey) != 8 {
+		return nil, fluxdb.ErrInvalidKeyLength("code deployment primary key", 8, len(primaryKey))
+	}
+
+	return &CodeDeploymentRow{baseRow(t, height, primaryKey, data)}, nil
+}
+
+func (t CodeDeploymentTablet) String() string {
+	return cdPrefix + ":" + bytesToName(t)
+}
+
+type CodeDeploymentRow struct {
+	fluxdb.BaseTabletRow
+}
+
+// NewCodeDeploymentRow returns `nil` when the `eosio:setcode` action clears the code of the
+// account, only actual deployments are recorded, the `ContractCodeSinglet` reflecting the removal.
+func NewCodeDeploymentRow(blockNum uint64, actionTrace *pbcodec.ActionTrace) (*CodeDeploymentRow, error) {
+	setCode, value, err := decodeSetCode(actionTrace)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return nil, nil
+	}
+
+	primaryKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(primaryKey, blockNum)
+
+	tablet := CodeDeploymentTablet(nameaToBytes(setCode.Account))
+	return &CodeDeploymentRow{baseRow(tablet, blockNum, primaryKey, value)}, nil
+}
+
+func (r *CodeDeploymentRow) DeployedAt() uint64 {
+	return binary.BigEndian.Uint64(r.PrimaryKey())
+}
+
+func (r *CodeDeploymentRow) Code() (*pbstatedb.ContractCodeValue, error) {
+	pb := &pbstatedb.ContractCodeValue{}
+	if err := proto.Unmarshal(r.Value(), pb); err != nil {
+		return nil, fmt.Errorf("unmarshal proto: %w", err)
+	}
+
+	return pb, nil
+}
+
+func (r *CodeDeploymentRow) ToProto() (proto.Message, error) {
+	return r.Code()
+}
+
+func (r *CodeDeploymentRow) String() string {
+	return r.Stringify(strconv.FormatUint(r.DeployedAt(), 10))
+}
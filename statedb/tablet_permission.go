@@ -0,0 +1,104 @@
+package statedb
+
+import (
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	"github.com/dfuse-io/fluxdb"
+	"github.com/golang/protobuf/proto"
+)
+
+const pmCollection = 0xB400
+const pmPrefix = "pm"
+
+func init() {
+	fluxdb.RegisterTabletFactory(pmCollection, pmPrefix, func(identifier []byte) (fluxdb.Tablet, error) {
+		if len(identifier) < 8 {
+			return nil, fluxdb.ErrInvalidKeyLengthAtLeast("permission tablet identifier", 8, len(identifier))
+		}
+
+		return PermissionTablet(identifier[0:8]), nil
+	})
+}
+
+func NewPermissionTablet(account string) PermissionTablet {
+	return PermissionTablet(standardNameToBytes(account))
+}
+
+// PermissionTablet tablet is composed of the permission objects of an account, keyed by
+// permission name
+type PermissionTablet []byte
+
+func (t PermissionTablet) Collection() uint16 {
+	return pmCollection
+}
+
+func (t PermissionTablet) Identifier() []byte {
+	return t
+}
+
+func (t PermissionTablet) Row(height uint64, primaryKey []byte, data []byte) (fluxdb.TabletRow, error) {
+	if len(primaryKey) != 8 {
+		return nil, fluxdb.ErrInvalidKeyLength("permission primary key", 8, len(primaryKey))
+	}
+
+	return &PermissionRow{baseRow(t, height, primaryKey, data)}, nil
+}
+
+func (t PermissionTablet) String() string {
+	return pmPrefix + ":" + bytesToName(t)
+}
+
+type PermissionRow struct {
+	fluxdb.BaseTabletRow
+}
+
+func NewPermissionRow(blockNum uint64, permOp *pbcodec.PermOp) (row *PermissionRow, err error) {
+	perm := permOp.NewPerm
+	if permOp.Operation == pbcodec.PermOp_OPERATION_REMOVE {
+		perm = permOp.OldPerm
+	}
+
+	if perm == nil {
+		return nil, fmt.Errorf("perm op %s has no permission object", permOp.Operation)
+	}
+
+	var value []byte
+	if permOp.Operation != pbcodec.PermOp_OPERATION_REMOVE {
+		pb := pbstatedb.PermissionValue{
+			Id:          perm.Id,
+			ParentId:    perm.ParentId,
+			LastUpdated: perm.LastUpdated,
+			Authority:   perm.Authority,
+		}
+
+		if value, err = proto.Marshal(&pb); err != nil {
+			return nil, fmt.Errorf("marshal proto: %w", err)
+		}
+	}
+
+	tablet := NewPermissionTablet(perm.Owner)
+	return &PermissionRow{baseRow(tablet, blockNum, standardNameToBytes(perm.Name), value)}, nil
+}
+
+func (r *PermissionRow) Name() string {
+	return bytesToName(r.PrimaryKey())
+}
+
+func (r *PermissionRow) Permission() (*pbstatedb.PermissionValue, error) {
+	pb := &pbstatedb.PermissionValue{}
+	if err := proto.Unmarshal(r.Value(), pb); err != nil {
+		return nil, fmt.Errorf("unmarshal proto: %w", err)
+	}
+
+	return pb, nil
+}
+
+func (r *PermissionRow) ToProto() (proto.Message, error) {
+	return r.Permission()
+}
+
+func (r *PermissionRow) String() string {
+	return r.Stringify(bytesToName(r.PrimaryKey()))
+}
@@ -78,6 +78,8 @@ func runAll(t *testing.T, storeFactory StoreFactory) {
 			testStateTableSingleRowHistoricalJSON,
 			testStateTableMultiRowsHeadJSON,
 			testStateTableMultiRowsHistoricalJSON,
+			testStateTableBoundedRowsHeadJSON,
+			testStateTablePaginatedRowsHeadJSON,
 		},
 		"table_scope": {
 			testStateTableScopesHeadJSON,
@@ -90,6 +92,19 @@ func runAll(t *testing.T, storeFactory StoreFactory) {
 		"table_row": {
 			testStateTableRowHeadJSON,
 		},
+		"permissions": {
+			testStatePermissionsHeadJSON,
+			testStatePermissionsHistoricalJSON,
+		},
+		"resources": {
+			testStateAccountResourcesHeadJSON,
+			testStateAccountResourcesHistoricalJSON,
+			testStateResourceLimitsHeadJSON,
+		},
+		"code": {
+			testStateCodeHeadJSON,
+			testStateCodeHistoricalJSON,
+		},
 	}
 
 	for group, tests := range all {
@@ -151,6 +166,42 @@ func testStateTableMultiRowsHeadJSON(ctx context.Context, t *testing.T, feedSour
 	]`, response.Path("$.rows"))
 }
 
+func testStateTableBoundedRowsHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okQueryStateTable(e, "eosio.test/rows2/s", "json=true&lower_bound=c&upper_bound=e")
+
+	assertHeadBlockInfo(response, "00000006aa", "00000005aa")
+	jsonValueEqual(t, "table-rows", `[
+		{"key":"c","payer":"s","json":{"to":3}},
+		{"key":"d","payer":"s","json":{"to":4}},
+		{"key":"e","payer":"s","json":{"to":5}}
+	]`, response.Path("$.rows"))
+	response.NotContainsKey("next_cursor")
+}
+
+func testStateTablePaginatedRowsHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okQueryStateTable(e, "eosio.test/rows2/s", "json=true&limit=2")
+	jsonValueEqual(t, "first page", `[
+		{"key":"b","payer":"s","json":{"to":20}},
+		{"key":"c","payer":"s","json":{"to":3}}
+	]`, response.Path("$.rows"))
+
+	response = okQueryStateTable(e, "eosio.test/rows2/s", "json=true&limit=2&cursor="+response.Value("next_cursor").String().Raw())
+	jsonValueEqual(t, "second page", `[
+		{"key":"d","payer":"s","json":{"to":4}},
+		{"key":"e","payer":"s","json":{"to":5}}
+	]`, response.Path("$.rows"))
+
+	response = okQueryStateTable(e, "eosio.test/rows2/s", "json=true&limit=2&cursor="+response.Value("next_cursor").String().Raw())
+	jsonValueEqual(t, "last page", `[
+		{"key":"f","payer":"s","json":{"to":6}}
+	]`, response.Path("$.rows"))
+	response.NotContainsKey("next_cursor")
+}
+
 func testStateTableMultiRowsHistoricalJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
 	feedSourceWithBlocks(tableBlocks(t)...)
 
@@ -242,6 +293,165 @@ func testStateTableRowHeadJSON(ctx context.Context, t *testing.T, feedSourceWith
 	jsonValueEqual(t, "row", `{"key":"SOE","payer":"eosio5","json":{"balance":"5.0000 SOE"}}`, response.Path("$.row"))
 }
 
+func testStatePermissionsHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(permissionBlocks(t)...)
+
+	response := okQueryStatePermissions(e, "eosio1", "")
+
+	assertHeadBlockInfo(response, "00000004aa", "00000003aa")
+	jsonValueEqual(t, "permissions", `[
+		{"perm_name":"owner","parent":"","required_auth":{"threshold":0,"keys":[{"key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","weight":1}]},"block_num":2},
+		{"perm_name":"transfer","parent":"owner","required_auth":{"threshold":0,"keys":[{"key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","weight":1}]},"block_num":3}
+	]`, response.Path("$.permissions"))
+}
+
+func testStatePermissionsHistoricalJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(permissionBlocks(t)...)
+
+	response := okQueryStatePermissions(e, "eosio1", "block_num=2")
+
+	assertIrrBlockInfo(response, "00000003aa")
+	jsonValueEqual(t, "permissions", `[
+		{"perm_name":"active","parent":"owner","required_auth":{"threshold":0,"keys":[{"key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","weight":1}]},"block_num":2},
+		{"perm_name":"owner","parent":"","required_auth":{"threshold":0,"keys":[{"key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","weight":1}]},"block_num":2}
+	]`, response.Path("$.permissions"))
+}
+
+func permissionBlocks(t *testing.T) []*pbcodec.Block {
+	key := ct.PublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+
+	return []*pbcodec.Block{
+		// Block #2 | Creates `eosio1` with `owner` and `active` permissions
+		ct.Block(t, "00000002aa",
+			ct.TrxTrace(t,
+				ct.PermOp(t, "INS", ct.NewPerm(ct.Permission(t, "eosio1@owner", key, ct.PermissionIDs{ID: 10}))),
+				ct.PermOp(t, "INS", ct.NewPerm(ct.Permission(t, "eosio1@active", key, ct.PermissionIDs{ID: 11, ParentID: 10}))),
+			),
+		),
+
+		// Block #3 | Adds a `transfer` permission under `owner`
+		ct.Block(t, "00000003aa",
+			ct.TrxTrace(t,
+				ct.PermOp(t, "INS", ct.NewPerm(ct.Permission(t, "eosio1@transfer", key, ct.PermissionIDs{ID: 12, ParentID: 10}))),
+			),
+		),
+
+		// Block #4 | This block will be in the reversible segment, deletes `active`
+		ct.Block(t, "00000004aa",
+			ct.TrxTrace(t,
+				ct.PermOp(t, "REM", ct.OldPerm(ct.Permission(t, "eosio1@active", key, ct.PermissionIDs{ID: 11, ParentID: 10}))),
+			),
+		),
+	}
+}
+
+func testStateAccountResourcesHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(resourceBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/account_resources", "account=eosio1")
+
+	assertHeadBlockInfo(response, "00000004aa", "00000003aa")
+	jsonValueEqual(t, "limits", `{"owner":"eosio1","net_weight":10,"cpu_weight":10,"ram_bytes":8000}`, response.Path("$.limits"))
+	jsonValueEqual(t, "usage", `{"owner":"eosio1","ram_usage":3000}`, response.Path("$.usage"))
+	response.ValueEqual("ram_usage", 3000)
+	response.ValueEqual("ram_usage_block_num", 4)
+}
+
+func testStateAccountResourcesHistoricalJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(resourceBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/account_resources", "account=eosio1&block_num=2")
+
+	assertIrrBlockInfo(response, "00000003aa")
+	jsonValueEqual(t, "limits", `{"owner":"eosio1","net_weight":10,"cpu_weight":10,"ram_bytes":4000}`, response.Path("$.limits"))
+	response.ValueEqual("limits_block_num", 2)
+	response.ValueEqual("ram_usage", 2000)
+}
+
+func testStateResourceLimitsHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(resourceBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/resource_limits", "")
+
+	assertHeadBlockInfo(response, "00000004aa", "00000003aa")
+	jsonValueEqual(t, "config", `{"account_cpu_usage_average_window":172800,"account_net_usage_average_window":172800}`, response.Path("$.config"))
+	response.ValueEqual("config_block_num", 2)
+	jsonValueEqual(t, "state", `{"total_ram_bytes":12000}`, response.Path("$.state"))
+	response.ValueEqual("state_block_num", 4)
+}
+
+func resourceBlocks(t *testing.T) []*pbcodec.Block {
+	return []*pbcodec.Block{
+		// Block #2 | Sets the chain config and `eosio1` limits, `eosio1` uses some RAM
+		ct.Block(t, "00000002aa",
+			ct.TrxTrace(t,
+				ct.RAMOp(t, "eosio1", 2000, 2000),
+				ct.RlimitOp(t, &pbcodec.RlimitAccountLimits{Owner: "eosio1", Pending: true, NetWeight: 10, CpuWeight: 10, RamBytes: 4000}),
+			),
+			ct.RlimitOp(t, &pbcodec.RlimitConfig{AccountCpuUsageAverageWindow: 172800, AccountNetUsageAverageWindow: 172800}),
+			ct.RlimitOp(t, &pbcodec.RlimitAccountLimits{Owner: "eosio1", NetWeight: 10, CpuWeight: 10, RamBytes: 4000}),
+			ct.RlimitOp(t, &pbcodec.RlimitState{TotalRamBytes: 4000}),
+		),
+
+		// Block #3 | `eosio1` buys more RAM
+		ct.Block(t, "00000003aa",
+			ct.RlimitOp(t, &pbcodec.RlimitAccountLimits{Owner: "eosio1", NetWeight: 10, CpuWeight: 10, RamBytes: 8000}),
+			ct.RlimitOp(t, &pbcodec.RlimitState{TotalRamBytes: 8000}),
+		),
+
+		// Block #4 | This block will be in the reversible segment, `eosio1` uses more RAM
+		ct.Block(t, "00000004aa",
+			ct.TrxTrace(t,
+				ct.RAMOp(t, "eosio1", 1000, 3000),
+				ct.RlimitOp(t, &pbcodec.RlimitAccountUsage{Owner: "eosio1", RamUsage: 3000}),
+			),
+			ct.RlimitOp(t, &pbcodec.RlimitState{TotalRamBytes: 12000}),
+		),
+	}
+}
+
+func testStateCodeHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code", "account=eosio.test&with_deployments=true")
+
+	assertHeadBlockInfo(response, "00000004aa", "00000003aa")
+	response.NotContainsKey("code")
+	jsonValueEqual(t, "deployments", `[
+		{"block_num":2,"code_hash":"42fd6e7502a18143f0c206c99f1e0787fbcac6b1c5f9969a4cb0d2f7035d07e3"},
+		{"block_num":3,"code_hash":"82dd5278700e7ef15029beba96ea64988092e292cb0d543ce8da58dc2afad3b7"}
+	]`, response.Path("$.deployments"))
+}
+
+func testStateCodeHistoricalJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code", "account=eosio.test&block_num=2")
+
+	assertIrrBlockInfo(response, "00000003aa")
+	jsonValueEqual(t, "code", `{"block_num":2,"code_hash":"42fd6e7502a18143f0c206c99f1e0787fbcac6b1c5f9969a4cb0d2f7035d07e3"}`, response.Path("$.code"))
+	response.NotContainsKey("deployments")
+}
+
+func codeBlocks(t *testing.T) []*pbcodec.Block {
+	return []*pbcodec.Block{
+		// Block #2 | Deploys code (v1) on `eosio.test`
+		ct.Block(t, "00000002aa",
+			ct.TrxTrace(t, ct.ActionTraceSetCode(t, "eosio.test", []byte{0x00, 0x61, 0x73, 0x6d, 0x01})),
+		),
+
+		// Block #3 | Deploys code (v2) on `eosio.test`
+		ct.Block(t, "00000003aa",
+			ct.TrxTrace(t, ct.ActionTraceSetCode(t, "eosio.test", []byte{0x00, 0x61, 0x73, 0x6d, 0x02})),
+		),
+
+		// Block #4 | This block will be in the reversible segment, clears the code of `eosio.test`
+		ct.Block(t, "00000004aa",
+			ct.TrxTrace(t, ct.ActionTraceSetCode(t, "eosio.test", nil)),
+		),
+	}
+}
+
 func tableBlocks(t *testing.T) []*pbcodec.Block {
 	eosioTokenABI1 := readABI(t, "eosio.token.1.abi.json")
 	eosioTestABI1 := readABI(t, "eosio.test.1.abi.json")
@@ -419,6 +629,15 @@ func okQueryStateTableRow(e *httpexpect.Expect, table string, extraQuery string)
 	return okQuery(e, "/v0/state/table/row", queryString)
 }
 
+func okQueryStatePermissions(e *httpexpect.Expect, account string, extraQuery string) (response *httpexpect.Object) {
+	queryString := fmt.Sprintf("account=%s", account)
+	if extraQuery != "" {
+		queryString += "&" + extraQuery
+	}
+
+	return okQuery(e, "/v0/state/permissions", queryString)
+}
+
 func okQuery(e *httpexpect.Expect, path string, queryString string) (response *httpexpect.Object) {
 	return e.GET(path).
 		WithQueryString(queryString).
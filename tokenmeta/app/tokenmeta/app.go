@@ -33,23 +33,27 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/dfuse-io/dgrpc"
 	"github.com/dfuse-io/dstore"
+	"github.com/dfuse-io/kvdb/store"
 	pbhealth "github.com/dfuse-io/pbgo/grpc/health/v1"
 	"github.com/dfuse-io/shutter"
 	"go.uber.org/zap"
 )
 
 type Config struct {
-	GRPCListenAddr       string        // Address to listen for incoming gRPC requests
-	StateDBGRPCAddr      string        // StateDB gRPC URL
-	BlockStreamAddr      string        // gRPC URL to reach a stream of blocks
-	ABICodecAddr         string        // Abi Codec URL
-	ABICacheBaseURL      string        // cached ABIS base URL
-	ABICacheFileName     string        // cached ABIS filename
-	CacheFile            string        // Path to GOB file containing tokenmeta cache. will try to Load and Save to that cache file
-	SaveEveryNBlock      uint32        // Save the cache after N blocks processed
-	BlocksStoreURL       string        // GS path to read blocks archives
-	BootstrapBlockOffset uint64        // Block offset to ensure that we are not bootstrapping from StateDB on a reversible fork
-	ReadinessMaxLatency  time.Duration // we advertise as not-ready if the last processed block is older than this
+	GRPCListenAddr             string        // Address to listen for incoming gRPC requests
+	StateDBGRPCAddr            string        // StateDB gRPC URL
+	BlockStreamAddr            string        // gRPC URL to reach a stream of blocks
+	ABICodecAddr               string        // Abi Codec URL
+	ABICacheBaseURL            string        // cached ABIS base URL
+	ABICacheFileName           string        // cached ABIS filename
+	CacheFile                  string        // Path to GOB file containing tokenmeta cache. will try to Load and Save to that cache file
+	SaveEveryNBlock            uint32        // Save the cache after N blocks processed
+	BlocksStoreURL             string        // GS path to read blocks archives
+	BootstrapBlockOffset       uint64        // Block offset to ensure that we are not bootstrapping from StateDB on a reversible fork
+	ReadinessMaxLatency        time.Duration // we advertise as not-ready if the last processed block is older than this
+	HistoryDSN                 string        // kvdb DSN where balances are recorded at each block to answer `at_block_num` requests, disabled when empty
+	BalanceChangesBufferBlocks int           // Number of recent blocks (with changes) kept in memory to resume balance changes streams from a cursor
+	HeadMode                   bool          // Apply reversible blocks as they come in, undoing them on forks, instead of waiting for irreversibility
 }
 
 type Modules struct {
@@ -108,6 +112,38 @@ func (a *App) Run() error {
 		}
 	}
 
+	var tokenmetaCache cache.Cache = tokenCache
+	var history cache.History
+	var reversible cache.Reversible
+	var reversibleCache *cache.ReversibleCache
+	if a.config.HeadMode && a.config.HistoryDSN != "" {
+		return fmt.Errorf("tokenmeta head mode cannot be combined with a history store, the history only records irreversible blocks")
+	}
+
+	if a.config.HeadMode {
+		zlog.Info("running tokenmeta in head mode, applying reversible blocks")
+		reversibleCache = cache.NewReversibleCache(tokenCache)
+
+		tokenmetaCache = reversibleCache
+		reversible = reversibleCache
+	}
+
+	if a.config.HistoryDSN != "" {
+		zlog.Info("setting up tokenmeta history", zap.String("dsn", a.config.HistoryDSN))
+		kvStore, err := store.New(a.config.HistoryDSN)
+		if err != nil {
+			return fmt.Errorf("cannot create tokenmeta history store: %w", err)
+		}
+
+		historicalCache := cache.NewHistoricalCache(tokenCache, kvStore)
+		if err := setupHistory(historicalCache); err != nil {
+			return fmt.Errorf("cannot setup tokenmeta history: %w", err)
+		}
+
+		tokenmetaCache = historicalCache
+		history = historicalCache
+	}
+
 	zlog.Info("setting up blockstore")
 	blocksStore, err := dstore.NewDBinStore(a.config.BlocksStoreURL)
 	derr.Check("failed setting up blocks store", err)
@@ -122,14 +158,16 @@ func (a *App) Run() error {
 	abiCodecCli := pbabicodec.NewDecoderClient(abiCodecConn)
 
 	zlog.Info("setting tokenmeta and pipeline")
-	tmeta := tokenmeta.NewTokenMeta(tokenCache, abiCodecCli, a.config.SaveEveryNBlock, stateClient)
+	changesHub := tokenmeta.NewBalanceChangesHub(a.config.BalanceChangesBufferBlocks)
+	activity := a.loadTokenActivity(tokenCache.AtBlockRef())
+	tmeta := tokenmeta.NewTokenMeta(tokenmetaCache, abiCodecCli, a.config.SaveEveryNBlock, stateClient, reversibleCache, changesHub, activity)
 
 	tmeta.OnTerminated(a.Shutdown)
 	a.OnTerminating(tmeta.Shutdown)
 
 	tmeta.SetupPipeline(startBlock, a.modules.BlockFilter, a.config.BlockStreamAddr, blocksStore)
 
-	server := tokenmeta.NewServer(tokenCache, a.config.ReadinessMaxLatency)
+	server := tokenmeta.NewServer(tokenmetaCache, history, reversible, changesHub, activity, a.config.ReadinessMaxLatency)
 
 	server.OnTerminated(a.Shutdown)
 	a.OnTerminating(server.Shutdown)
@@ -146,6 +184,32 @@ func (a *App) Run() error {
 	return nil
 }
 
+// setupHistory records the whole cache when the history is empty or behind it, the blocks in
+// between are then never queryable. When the history is ahead (the cache file is saved every
+// N blocks), the blocks reprocessed by the cache simply record the same values again.
+func setupHistory(historicalCache *cache.HistoricalCache) error {
+	ctx := context.Background()
+	lastRecordedBlock, err := historicalCache.LastRecordedBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get last recorded block: %w", err)
+	}
+
+	cacheBlock := historicalCache.AtBlockRef()
+	if lastRecordedBlock != nil && lastRecordedBlock.Num() >= cacheBlock.Num() {
+		zlog.Info("resuming tokenmeta history", zap.Stringer("last_recorded_block", lastRecordedBlock), zap.Stringer("cache_block", cacheBlock))
+		return nil
+	}
+
+	if lastRecordedBlock != nil {
+		zlog.Warn("tokenmeta history is behind the cache, recording a snapshot, blocks in between will not be queryable",
+			zap.Stringer("last_recorded_block", lastRecordedBlock),
+			zap.Stringer("cache_block", cacheBlock),
+		)
+	}
+
+	return historicalCache.RecordSnapshot(ctx)
+}
+
 func (a *App) createTokenMetaCacheFromAbi(stateClient pbstatedb.StateClient) (*cache.DefaultCache, error) {
 	zlog.Info("tokenmeta cache not present loading cached abis",
 		zap.String("abis_base_url", a.config.ABICacheBaseURL),
@@ -167,7 +231,7 @@ func (a *App) createTokenMetaCacheFromAbi(stateClient pbstatedb.StateClient) (*c
 		return nil, err
 	}
 
-	tokens, balances, stakedEntries, startBlock, err := tokenmeta.Bootstrap(cnt, stateClient, a.config.BootstrapBlockOffset)
+	contracts, tokens, balances, stakedEntries, startBlock, err := tokenmeta.Bootstrap(cnt, stateClient, a.config.BootstrapBlockOffset)
 	if err != nil {
 		zlog.Warn("error bootstrap tokenmeta", zap.Error(err))
 		return nil, err
@@ -181,6 +245,17 @@ func (a *App) createTokenMetaCacheFromAbi(stateClient pbstatedb.StateClient) (*c
 
 	tokenCache := cache.NewDefaultCacheWithData(tokens, balances, stakedEntries, startBlock, a.config.CacheFile)
 
+	// contracts without tokens yet are tracked to catch their first token, like the ones set later on
+	contractMutations := &cache.MutationsBatch{}
+	for _, contract := range contracts {
+		if !tokenCache.IsTokenContract(contract) {
+			contractMutations.SetContract(contract)
+		}
+	}
+	if errs := tokenCache.Apply(contractMutations, startBlock); len(errs) != 0 {
+		zlog.Warn("cannot add empty token contracts to cache", zap.Errors("errors", errs))
+	}
+
 	err = tokenCache.SaveToFile()
 	if err != nil {
 		zlog.Error("cannot save token cache file", zap.Error(err), zap.String("filename", a.config.CacheFile))
@@ -215,6 +290,33 @@ func (a *App) getAbiCacheFile(store dstore.Store, abiCacheFilename string) ([]by
 		return cnt, nil
 	}
 }
+
+// loadTokenActivity loads the token statistics saved along the cache file, they are only used when
+// saved at the block the cache resumes from, the statistics start over otherwise
+func (a *App) loadTokenActivity(startBlock bstream.BlockRef) *tokenmeta.TokenActivity {
+	if a.config.CacheFile == "" {
+		return tokenmeta.NewTokenActivity()
+	}
+
+	filename := a.config.CacheFile + ".activity"
+	activity, err := tokenmeta.LoadTokenActivityFromFile(filename)
+	switch {
+	case err != nil:
+		if !isNotExits(err) {
+			zlog.Warn("cannot load token activity file, starting over", zap.Error(err))
+		}
+		activity = tokenmeta.NewTokenActivity()
+	case activity.AtBlock() == nil:
+		activity = tokenmeta.NewTokenActivity()
+	case activity.AtBlock().ID() != startBlock.ID():
+		zlog.Warn("token activity file is not at the cache's block, starting over", zap.Stringer("activity_block", activity.AtBlock()), zap.Stringer("cache_block", startBlock))
+		activity = tokenmeta.NewTokenActivity()
+	}
+
+	activity.SetFilePath(filename)
+	return activity
+}
+
 func mkdirCacheFileParents(file string) error {
 	dir := filepath.Dir(file)
 	err := os.MkdirAll(dir, os.ModePerm)
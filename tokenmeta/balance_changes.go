@@ -0,0 +1,211 @@
+package tokenmeta
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+var errCursorTooOld = errors.New("cursor block is not among the recently processed blocks anymore")
+var errSubscriberTooSlow = errors.New("subscriber too slow, could not keep up with the processed blocks")
+
+// subscriberBufferBlocks is the number of blocks a subscriber can lag behind before being dropped
+const subscriberBufferBlocks = 100
+
+type blockBalanceChanges struct {
+	block   bstream.BlockRef
+	changes []*pbtokenmeta.BalanceChange
+}
+
+// BalanceChangesHub keeps the balance changes of the last `bufferBlocks` processed blocks, to
+// resume streams from a cursor, and dispatches the changes of each new block to its subscribers.
+type BalanceChangesHub struct {
+	lock sync.RWMutex
+
+	bufferBlocks int
+	buffer       []*blockBalanceChanges
+	subscribers  map[*balanceChangesSubscription]bool
+}
+
+// balanceChangesSubscription's `blocks` is closed when the subscriber is dropped, `err` then tells why
+type balanceChangesSubscription struct {
+	blocks chan *blockBalanceChanges
+	err    error
+}
+
+func NewBalanceChangesHub(bufferBlocks int) *BalanceChangesHub {
+	return &BalanceChangesHub{
+		bufferBlocks: bufferBlocks,
+		subscribers:  map[*balanceChangesSubscription]bool{},
+	}
+}
+
+// Publish records the changes of a processed block, blocks without changes are not recorded
+func (h *BalanceChangesHub) Publish(block bstream.BlockRef, changes []*pbtokenmeta.BalanceChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	blockChanges := &blockBalanceChanges{block: block, changes: changes}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.bufferBlocks > 0 {
+		h.buffer = append(h.buffer, blockChanges)
+		if len(h.buffer) > h.bufferBlocks {
+			h.buffer = h.buffer[len(h.buffer)-h.bufferBlocks:]
+		}
+	}
+
+	for sub := range h.subscribers {
+		select {
+		case sub.blocks <- blockChanges:
+		default:
+			zlog.Info("dropping balance changes subscriber, too slow")
+			sub.err = errSubscriberTooSlow
+			h.removeSubscriber(sub)
+		}
+	}
+}
+
+// subscribe returns the subscription to the blocks to come and, when `cursor` is set, the changes
+// to replay right after it. Returns `errCursorTooOld` if the cursor's block is not buffered anymore.
+func (h *BalanceChangesHub) subscribe(cursor *pbtokenmeta.BalanceChangeCursor) (*balanceChangesSubscription, []*pbtokenmeta.BalanceChange, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	var replay []*pbtokenmeta.BalanceChange
+	if cursor != nil {
+		var err error
+		if replay, err = h.changesAfter(cursor); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	sub := &balanceChangesSubscription{blocks: make(chan *blockBalanceChanges, subscriberBufferBlocks)}
+	h.subscribers[sub] = true
+
+	return sub, replay, nil
+}
+
+func (h *BalanceChangesHub) unsubscribe(sub *balanceChangesSubscription) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.removeSubscriber(sub)
+}
+
+func (h *BalanceChangesHub) removeSubscriber(sub *balanceChangesSubscription) {
+	if h.subscribers[sub] {
+		delete(h.subscribers, sub)
+		close(sub.blocks)
+	}
+}
+
+func (h *BalanceChangesHub) changesAfter(cursor *pbtokenmeta.BalanceChangeCursor) (out []*pbtokenmeta.BalanceChange, err error) {
+	if len(h.buffer) == 0 || cursor.BlockNum < h.buffer[0].block.Num() {
+		return nil, fmt.Errorf("block #%d: %w", cursor.BlockNum, errCursorTooOld)
+	}
+
+	found := false
+	for _, blockChanges := range h.buffer {
+		if found {
+			out = append(out, blockChanges.changes...)
+			continue
+		}
+
+		if blockChanges.block.Num() != cursor.BlockNum {
+			continue
+		}
+
+		if blockChanges.block.ID() != cursor.BlockId {
+			return nil, fmt.Errorf("cursor block %s does not match processed block %s", bstream.NewBlockRef(cursor.BlockId, cursor.BlockNum), blockChanges.block)
+		}
+
+		found = true
+		if int(cursor.Index)+1 < len(blockChanges.changes) {
+			out = append(out, blockChanges.changes[cursor.Index+1:]...)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("cursor block %s was never processed", bstream.NewBlockRef(cursor.BlockId, cursor.BlockNum))
+	}
+
+	return out, nil
+}
+
+// balanceChangesFromMutations turns the applied balance and token mutations of a block into
+// changes, tokens are read back from the cache to get their holders count
+func balanceChangesFromMutations(mutations []*cache.Mutation, block bstream.BlockRef, tokenCache cache.Cache) (changes []*pbtokenmeta.BalanceChange) {
+	for _, mut := range mutations {
+		change := &pbtokenmeta.BalanceChange{}
+		switch mut.Type {
+		case cache.SetBalanceMutation:
+			change.Type = pbtokenmeta.BalanceChange_SET_BALANCE
+			change.Balance = cache.AssetToProtoAccountBalance(mut.OwnedAsset())
+		case cache.RemoveBalanceMutation:
+			change.Type = pbtokenmeta.BalanceChange_REMOVE_BALANCE
+			change.Balance = cache.AssetToProtoAccountBalance(mut.OwnedAsset())
+			change.Balance.Amount = 0
+		case cache.SetTokenMutation:
+			token := mut.Args[0].(*pbtokenmeta.Token)
+			symbolCode, err := eos.StringToSymbolCode(token.Symbol)
+			if err != nil {
+				zlog.Warn("invalid token symbol in mutation", zap.String("symbol", token.Symbol), zap.Error(err))
+				continue
+			}
+
+			change.Type = pbtokenmeta.BalanceChange_SET_TOKEN
+			change.Token = tokenCache.TokenContract(eos.AccountName(token.Contract), symbolCode)
+			if change.Token == nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		change.BlockNum = block.Num()
+		change.BlockId = block.ID()
+		change.Cursor = &pbtokenmeta.BalanceChangeCursor{
+			Ver:      1,
+			BlockNum: block.Num(),
+			BlockId:  block.ID(),
+			Index:    uint32(len(changes)),
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// balanceChangeFilter matches changes against the filters of a `StreamBalanceChangesRequest`,
+// token changes have no holder so they only match when there is no account filter
+type balanceChangeFilter struct {
+	accounts  []string
+	contracts []string
+	symbols   []string
+}
+
+func newBalanceChangeFilter(in *pbtokenmeta.StreamBalanceChangesRequest) *balanceChangeFilter {
+	return &balanceChangeFilter{
+		accounts:  in.FilterAccounts,
+		contracts: in.FilterTokenContracts,
+		symbols:   in.FilterTokenSymbols,
+	}
+}
+
+func (f *balanceChangeFilter) match(change *pbtokenmeta.BalanceChange) bool {
+	if change.Token != nil {
+		return len(f.accounts) == 0 && matchFilters(eos.AccountName(change.Token.Contract), change.Token.Symbol, f.contracts, f.symbols)
+	}
+
+	return stringInFilter(change.Balance.Account, f.accounts) && matchFilters(eos.AccountName(change.Balance.TokenContract), change.Balance.Symbol, f.contracts, f.symbols)
+}
@@ -0,0 +1,77 @@
+package tokenmeta
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceChangesHub(t *testing.T) {
+	balance := func(account string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: "EOS"}
+	}
+
+	tokenCache := cache.NewDefaultCacheWithData([]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4}}, nil, nil, bstream.NewBlockRef("00000009a", 9), "")
+	hub := NewBalanceChangesHub(2)
+
+	publish := func(block bstream.BlockRef, fill func(muts *cache.MutationsBatch)) {
+		muts := &cache.MutationsBatch{}
+		fill(muts)
+		hub.Publish(block, balanceChangesFromMutations(muts.Mutations(), block, tokenCache))
+	}
+
+	publish(bstream.NewBlockRef("0000000aa", 10), func(muts *cache.MutationsBatch) {
+		muts.SetBalance(balance("alice", 100))
+		muts.SetBalance(balance("bob", 50))
+	})
+
+	sub, replay, err := hub.subscribe(&pbtokenmeta.BalanceChangeCursor{Ver: 1, BlockNum: 10, BlockId: "0000000aa", Index: 0})
+	require.NoError(t, err)
+	defer hub.unsubscribe(sub)
+	require.Len(t, replay, 1)
+	assert.Equal(t, "bob", replay[0].Balance.Account)
+	assert.Equal(t, uint32(1), replay[0].Cursor.Index)
+
+	publish(bstream.NewBlockRef("0000000ba", 11), func(muts *cache.MutationsBatch) {
+		muts.SetContract("eosio.token")
+		muts.RemoveBalance(balance("bob", 50))
+		muts.SetToken(&pbtokenmeta.Token{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 2000})
+	})
+
+	blockChanges := <-sub.blocks
+	var actual []string
+	for _, change := range blockChanges.changes {
+		actual = append(actual, fmt.Sprintf("%s %d/%s/%d", change.Type, change.BlockNum, change.BlockId, change.Cursor.Index))
+	}
+	assert.Equal(t, []string{"REMOVE_BALANCE 11/0000000ba/0", "SET_TOKEN 11/0000000ba/1"}, actual)
+	assert.Equal(t, uint64(0), blockChanges.changes[0].Balance.Amount)
+
+	filter := newBalanceChangeFilter(&pbtokenmeta.StreamBalanceChangesRequest{FilterAccounts: []string{"bob"}})
+	assert.True(t, filter.match(blockChanges.changes[0]))
+	assert.False(t, filter.match(blockChanges.changes[1]))
+
+	filter = newBalanceChangeFilter(&pbtokenmeta.StreamBalanceChangesRequest{FilterTokenSymbols: []string{"EOS"}})
+	assert.True(t, filter.match(blockChanges.changes[1]))
+
+	publish(bstream.NewBlockRef("0000000ca", 12), func(muts *cache.MutationsBatch) {
+		muts.SetBalance(balance("carol", 10))
+	})
+
+	_, _, err = hub.subscribe(&pbtokenmeta.BalanceChangeCursor{Ver: 1, BlockNum: 10, BlockId: "0000000aa"})
+	assert.True(t, errors.Is(err, errCursorTooOld))
+
+	_, _, err = hub.subscribe(&pbtokenmeta.BalanceChangeCursor{Ver: 1, BlockNum: 11, BlockId: "0000000bb"})
+	assert.Error(t, err)
+
+	other, replay, err := hub.subscribe(&pbtokenmeta.BalanceChangeCursor{Ver: 1, BlockNum: 11, BlockId: "0000000ba", Index: 1})
+	require.NoError(t, err)
+	hub.unsubscribe(other)
+	require.Len(t, replay, 1)
+	assert.Equal(t, "carol", replay[0].Balance.Account)
+}
@@ -14,7 +14,9 @@ import (
 	"go.uber.org/zap"
 )
 
-func Bootstrap(abisFileContent []byte, stateClient pbstatedb.StateClient, bootstrapblockOffset uint64) (tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, stakeds []*cache.EOSStakeEntry, startBlock bstream.BlockRef, err error) {
+// Bootstrap reads from statedb the tokens, balances and stakes of the contracts implementing a token
+// standard, `contracts` lists all of them, including those without any token yet
+func Bootstrap(abisFileContent []byte, stateClient pbstatedb.StateClient, bootstrapblockOffset uint64) (contracts []eos.AccountName, tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, stakeds []*cache.EOSStakeEntry, startBlock bstream.BlockRef, err error) {
 	startBlock = bstream.NewBlockRef("", 0)
 	tokenContracts := parseContractFromABIs(abisFileContent)
 	abiStartBlock, err := parseCursorFromABIs(abisFileContent)
@@ -30,27 +32,31 @@ func Bootstrap(abisFileContent []byte, stateClient pbstatedb.StateClient, bootst
 
 	ctx := context.Background()
 
-	sta, err := getEOSStakedFromStateDB(ctx, stateClient, uint32(startBlock.Num()))
-	stakeds = append(stakeds, sta...)
-
 	zlog.Info("looping through valid contracts",
 		zap.Uint64("start_block_num", startBlock.Num()),
 		zap.String("start_block_id", startBlock.ID()),
 		zap.Int("valid_contracts_count", len(tokenContracts)),
 	)
 
-	for _, tokenContract := range tokenContracts {
+	for _, contract := range tokenContracts {
+		tokenContract := contract.account
 		for attempt := 1; true; attempt++ {
-			toks, bals, err := processContract(ctx, tokenContract, uint32(startBlock.Num()), stateClient)
+			toks, bals, err := contract.standard.Bootstrap(ctx, stateClient, tokenContract, uint32(startBlock.Num()))
+			var stks []*cache.EOSStakeEntry
+			if staking, ok := contract.standard.(StakingStandard); ok && err == nil {
+				stks, err = staking.BootstrapStakes(ctx, stateClient, tokenContract, uint32(startBlock.Num()))
+			}
 			if err == nil {
-				if toks == nil {
-					zlog.Info("skipping empty token contract",
+				contracts = append(contracts, tokenContract)
+				if toks == nil && stks == nil {
+					zlog.Info("empty token contract",
 						zap.String("token_contract", string(tokenContract)),
 					)
 					break
 				}
 				tokens = append(tokens, toks...)
 				balances = append(balances, bals...)
+				stakeds = append(stakeds, stks...)
 				break
 			}
 			if !isRetryableStateDBError(err) {
@@ -61,7 +67,7 @@ func Bootstrap(abisFileContent []byte, stateClient pbstatedb.StateClient, bootst
 				break
 			}
 			if attempt > 5 {
-				return nil, nil, nil, nil, fmt.Errorf("failing after 5 attempts to get symbols from token contract: %w", err)
+				return nil, nil, nil, nil, nil, fmt.Errorf("failing after 5 attempts to get symbols from token contract: %w", err)
 			}
 			zlog.Warn("unable to get symbols from token contract, retrying", zap.String("token_contract", string(tokenContract)), zap.Error(err))
 			time.Sleep(time.Duration(attempt) * time.Second)
@@ -70,11 +76,17 @@ func Bootstrap(abisFileContent []byte, stateClient pbstatedb.StateClient, bootst
 
 	}
 
-	return tokens, balances, stakeds, startBlock, nil
+	return contracts, tokens, balances, stakeds, startBlock, nil
 }
 
-func parseContractFromABIs(cnt []byte) (out []eos.AccountName) {
-	var accounts, withTableAccounts, withTableStat, tokenContracts int
+type bootstrapContract struct {
+	account  eos.AccountName
+	standard TokenStandard
+}
+
+func parseContractFromABIs(cnt []byte) (out []*bootstrapContract) {
+	var accounts int
+	standardContracts := map[string]int{}
 	gjson.GetBytes(cnt, "Abis").ForEach(func(k, v gjson.Result) bool {
 		accounts++
 		account := k.String()
@@ -93,7 +105,7 @@ func parseContractFromABIs(cnt []byte) (out []eos.AccountName) {
 			return true
 		}
 
-		contractStats, err := getTokenContractStats(account, []byte(rawABI), true)
+		abi, err := decodeABI(account, []byte(rawABI), true)
 		if err != nil {
 			zlog.Warn("failed decoding ABI in account",
 				zap.String("account", account),
@@ -103,25 +115,18 @@ func parseContractFromABIs(cnt []byte) (out []eos.AccountName) {
 			return false
 		}
 
-		if contractStats.hasStatsTable {
-			withTableStat++
-		}
-		if contractStats.hasAccountsTable {
-			withTableAccounts++
-		}
-
-		if contractStats.isTokenContract {
-			out = append(out, eos.AccountName(account))
-			tokenContracts++
+		if standard := tokenStandardForABI(abi); standard != nil {
+			out = append(out, &bootstrapContract{account: eos.AccountName(account), standard: standard})
+			standardContracts[standard.Name()]++
 		}
 		return true
 	})
-	zlog.Info("abis content stats",
-		zap.Int("accounts_count", accounts),
-		zap.Int("accounts_with_accounts_table", withTableAccounts),
-		zap.Int("accounts_with_stat_table", withTableStat),
-		zap.Int("accounts_with_stat_table_and_accounts_table", tokenContracts),
-	)
+
+	fields := []zap.Field{zap.Int("accounts_count", accounts), zap.Int("token_contracts_count", len(out))}
+	for standard, count := range standardContracts {
+		fields = append(fields, zap.Int(standard+"_contracts_count", count))
+	}
+	zlog.Info("abis content stats", fields...)
 	return
 }
 
@@ -102,6 +102,13 @@ func (c *DefaultCache) GetHeadBlockTime() time.Time {
 }
 
 func (c *DefaultCache) SaveToFile() error {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	return c.saveToFile()
+}
+
+func (c *DefaultCache) saveToFile() error {
 	if c.cacheFilePath == "" {
 		return fmt.Errorf("cannot save cache no filepath specified")
 	}
@@ -109,8 +116,6 @@ func (c *DefaultCache) SaveToFile() error {
 	tempfile := fmt.Sprintf("%s.tmp", c.cacheFilePath)
 	zlog.Info("trying to save to token cache file", zap.String("filename", c.cacheFilePath), zap.String("temp_filename", tempfile))
 
-	c.blocklevelLock.RLock()
-	defer c.blocklevelLock.RUnlock()
 	f, err := os.Create(tempfile)
 	if err != nil {
 		return err
@@ -180,6 +185,24 @@ func (c *DefaultCache) hasSymbolForContract(contract eos.AccountName, symbol str
 	return false
 }
 
+func (c *DefaultCache) token(key tokenKey) *pbtokenmeta.Token {
+	for _, t := range c.TokensInContract[key.contract] {
+		if t.Symbol == key.symbol {
+			return t
+		}
+	}
+	return nil
+}
+
+func (c *DefaultCache) balance(key balanceKey) *OwnedAsset {
+	for _, a := range c.Balances[key.contract][key.owner] {
+		if a.Asset.Asset.Symbol.Symbol == key.symbol {
+			return a
+		}
+	}
+	return nil
+}
+
 func (c *DefaultCache) AccountBalances(account eos.AccountName, opts ...AccountBalanceOption) (ownedAssets []*OwnedAsset) {
 	c.blocklevelLock.RLock()
 	defer c.blocklevelLock.RUnlock()
@@ -386,6 +409,26 @@ func (c *DefaultCache) setToken(token *pbtokenmeta.Token) error {
 	return nil
 }
 
+func (c *DefaultCache) removeToken(token *pbtokenmeta.Token) error {
+	tokens, ok := c.TokensInContract[eos.AccountName(token.Contract)]
+	if !ok {
+		return fmt.Errorf("removeToken: token contract %s not found in cache", token.Contract)
+	}
+
+	var updatedTokensInContract []*pbtokenmeta.Token
+	for _, t := range tokens {
+		if t.Symbol != token.Symbol {
+			updatedTokensInContract = append(updatedTokensInContract, t)
+		}
+	}
+	if len(updatedTokensInContract) == len(tokens) {
+		return fmt.Errorf("removeToken: token %s/%s not found", token.Contract, token.Symbol)
+	}
+
+	c.TokensInContract[eos.AccountName(token.Contract)] = updatedTokensInContract
+	return nil
+}
+
 func (c *DefaultCache) setContract(contractName eos.AccountName) error {
 	_, found := c.TokensInContract[contractName]
 	if found {
@@ -396,43 +439,55 @@ func (c *DefaultCache) setContract(contractName eos.AccountName) error {
 	return nil
 }
 
+func (c *DefaultCache) removeContract(contractName eos.AccountName) error {
+	tokens, found := c.TokensInContract[contractName]
+	if !found {
+		return fmt.Errorf("removeContract: token contract %s not found in cache", contractName)
+	}
+	if len(tokens) != 0 {
+		return fmt.Errorf("removeContract: token contract %s still has %d tokens", contractName, len(tokens))
+	}
+
+	delete(c.TokensInContract, contractName)
+	return nil
+}
+
 func (c *DefaultCache) Apply(mutationsBatch *MutationsBatch, processedBlock bstream.BlockRef) (errors []error) {
 	c.blocklevelLock.Lock()
 	defer c.blocklevelLock.Unlock()
 
 	for _, mut := range mutationsBatch.Mutations() {
-		var err error
-		switch mut.Type {
-		case SetBalanceMutation:
-			var a *OwnedAsset
-			if bal, ok := mut.Args[0].(*pbtokenmeta.AccountBalance); ok {
-				a = ProtoEOSAccountBalanceToOwnedAsset(bal)
-			} else {
-				a = mut.Args[0].(*OwnedAsset)
-			}
-			err = c.setBalance(a)
-		case RemoveBalanceMutation:
-			var a *OwnedAsset
-			if bal, ok := mut.Args[0].(*pbtokenmeta.AccountBalance); ok {
-				a = ProtoEOSAccountBalanceToOwnedAsset(bal)
-			} else {
-				a = mut.Args[0].(*OwnedAsset)
-			}
-			err = c.removeBalance(a)
-		case SetTokenMutation:
-			err = c.setToken(mut.Args[0].(*pbtokenmeta.Token))
-		case SetStakeMutation:
-			err = c.setStake(mut.Args[0].(*EOSStakeEntry))
-		case SetContractMutation:
-			err = c.setContract(mut.Args[0].(eos.AccountName))
-		}
-		if err != nil {
+		if err := c.applyMutation(mut); err != nil {
 			errors = append(errors, err)
 		}
 	}
+	c.setAtBlock(processedBlock)
+	return
+}
+
+func (c *DefaultCache) applyMutation(mut *Mutation) error {
+	switch mut.Type {
+	case SetBalanceMutation:
+		return c.setBalance(mut.OwnedAsset())
+	case RemoveBalanceMutation:
+		return c.removeBalance(mut.OwnedAsset())
+	case SetTokenMutation:
+		return c.setToken(mut.Args[0].(*pbtokenmeta.Token))
+	case RemoveTokenMutation:
+		return c.removeToken(mut.Args[0].(*pbtokenmeta.Token))
+	case SetStakeMutation:
+		return c.setStake(mut.Args[0].(*EOSStakeEntry))
+	case SetContractMutation:
+		return c.setContract(mut.Args[0].(eos.AccountName))
+	case RemoveContractMutation:
+		return c.removeContract(mut.Args[0].(eos.AccountName))
+	}
+	return nil
+}
+
+func (c *DefaultCache) setAtBlock(block bstream.BlockRef) {
 	c.AtBlock = &Block{
-		Id:  processedBlock.ID(),
-		Num: processedBlock.Num(),
+		Id:  block.ID(),
+		Num: block.Num(),
 	}
-	return
 }
@@ -0,0 +1,526 @@
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/kvdb/store"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+// ErrBlockNotInHistory is returned when querying a block that was not recorded, either because it
+// is before the first recorded block, after the last one or in a gap where recording was disabled.
+var ErrBlockNotInHistory = errors.New("block not in history")
+
+const (
+	histPrefixBlock          = 0x00 // ^blockNum -> block num, block ID
+	histPrefixAccountBalance = 0x01 // account, contract, symbol code, ^blockNum -> balance
+	histPrefixTokenBalance   = 0x02 // contract, symbol code, account, ^blockNum -> balance
+	histPrefixToken          = 0x03 // contract, symbol code, ^blockNum -> pbtokenmeta.Token
+	histPrefixStake          = 0x04 // account, ^blockNum -> total staked
+)
+
+const (
+	balanceRemoved byte = 0x00
+	balanceSet     byte = 0x01
+)
+
+// HistoricalCache is a `DefaultCache` that also records, for each applied block, the resulting
+// balances, tokens and EOS stakes that changed in a kvdb store, so they can be queried as of the
+// end of any recorded block. Only irreversible blocks are applied, so nothing is ever undone.
+//
+// Block numbers are stored inverted in the keys, the first row found at or after `^blockNum`
+// within a balance (or token, or stake) is its value at `blockNum`, using forward scans only.
+type HistoricalCache struct {
+	*DefaultCache
+
+	kvStore store.KVStore
+}
+
+func NewHistoricalCache(cache *DefaultCache, kvStore store.KVStore) *HistoricalCache {
+	return &HistoricalCache{
+		DefaultCache: cache,
+		kvStore:      kvStore,
+	}
+}
+
+func (c *HistoricalCache) Apply(mutationsBatch *MutationsBatch, processedBlock bstream.BlockRef) (errors []error) {
+	errors = c.DefaultCache.Apply(mutationsBatch, processedBlock)
+
+	if err := c.record(context.Background(), mutationsBatch, processedBlock); err != nil {
+		errors = append(errors, fmt.Errorf("unable to record history of block %s: %w", processedBlock, err))
+	}
+	return
+}
+
+// LastRecordedBlock returns the highest block recorded in the history, or `nil` if the history is empty
+func (c *HistoricalCache) LastRecordedBlock(ctx context.Context) (bstream.BlockRef, error) {
+	it := c.kvStore.Prefix(ctx, []byte{histPrefixBlock}, 1)
+	for it.Next() {
+		return unpackHistBlockValue(it.Item().Value), nil
+	}
+
+	return nil, it.Err()
+}
+
+// RecordSnapshot records the whole content of the cache at its current block, it must be called
+// once before applying blocks on an empty history, or when the cache is ahead of the history.
+func (c *HistoricalCache) RecordSnapshot(ctx context.Context) error {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	atBlock := bstream.NewBlockRef(c.AtBlock.Id, c.AtBlock.Num)
+	zlog.Info("recording tokenmeta history snapshot", zap.Stringer("block", atBlock))
+
+	for _, tokens := range c.TokensInContract {
+		for _, token := range tokens {
+			if err := c.putToken(ctx, atBlock.Num(), eos.AccountName(token.Contract), token.Symbol); err != nil {
+				return err
+			}
+		}
+	}
+
+	for contract, assetsByOwner := range c.Balances {
+		for owner, assets := range assetsByOwner {
+			for _, asset := range assets {
+				if err := c.putBalance(ctx, atBlock.Num(), contract, owner, asset.Asset.Asset.Symbol.Symbol); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for account := range c.EOSStake {
+		if err := c.putStake(ctx, atBlock.Num(), account); err != nil {
+			return err
+		}
+	}
+
+	return c.putBlock(ctx, atBlock)
+}
+
+func (c *HistoricalCache) record(ctx context.Context, mutationsBatch *MutationsBatch, processedBlock bstream.BlockRef) error {
+	type tokenRef struct {
+		contract eos.AccountName
+		symbol   string
+	}
+	type balanceRef struct {
+		tokenRef
+		owner eos.AccountName
+	}
+
+	tokens := map[tokenRef]bool{}
+	balances := map[balanceRef]bool{}
+	stakes := map[eos.AccountName]bool{}
+	for _, mut := range mutationsBatch.Mutations() {
+		switch mut.Type {
+		case SetBalanceMutation, RemoveBalanceMutation:
+			// balances also change the holders count of their token
+			asset := mut.OwnedAsset()
+			token := tokenRef{contract: asset.Asset.Contract, symbol: asset.Asset.Asset.Symbol.Symbol}
+			balances[balanceRef{tokenRef: token, owner: asset.Owner}] = true
+			tokens[token] = true
+		case SetTokenMutation:
+			token := mut.Args[0].(*pbtokenmeta.Token)
+			tokens[tokenRef{contract: eos.AccountName(token.Contract), symbol: token.Symbol}] = true
+		case SetStakeMutation:
+			stakes[mut.Args[0].(*EOSStakeEntry).From] = true
+		}
+	}
+
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	blockNum := processedBlock.Num()
+	for token := range tokens {
+		if err := c.putToken(ctx, blockNum, token.contract, token.symbol); err != nil {
+			return err
+		}
+	}
+
+	for balance := range balances {
+		if err := c.putBalance(ctx, blockNum, balance.contract, balance.owner, balance.symbol); err != nil {
+			return err
+		}
+	}
+
+	for account := range stakes {
+		if err := c.putStake(ctx, blockNum, account); err != nil {
+			return err
+		}
+	}
+
+	return c.putBlock(ctx, processedBlock)
+}
+
+// putBlock marks the block as recorded, it is written last so a block is only ever queryable
+// once all of its rows are flushed
+func (c *HistoricalCache) putBlock(ctx context.Context, block bstream.BlockRef) error {
+	if err := c.kvStore.FlushPuts(ctx); err != nil {
+		return err
+	}
+
+	if err := c.kvStore.Put(ctx, histBlockKey(block.Num()), packHistBlockValue(block)); err != nil {
+		return err
+	}
+
+	return c.kvStore.FlushPuts(ctx)
+}
+
+func (c *HistoricalCache) putToken(ctx context.Context, blockNum uint64, contract eos.AccountName, symbol string) error {
+	key, err := histTokenKey(contract, symbol, blockNum)
+	if err != nil {
+		return err
+	}
+
+	var token *pbtokenmeta.Token
+	for _, t := range c.TokensInContract[contract] {
+		if t.Symbol == symbol {
+			token = t
+			break
+		}
+	}
+	if token == nil {
+		// the mutation was rejected by the cache, nothing changed
+		return nil
+	}
+
+	value, err := proto.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("unable to marshal token %s/%s: %w", contract, symbol, err)
+	}
+
+	return c.kvStore.Put(ctx, key, value)
+}
+
+func (c *HistoricalCache) putBalance(ctx context.Context, blockNum uint64, contract eos.AccountName, owner eos.AccountName, symbol string) error {
+	accountKey, err := histAccountBalanceKey(owner, contract, symbol, blockNum)
+	if err != nil {
+		return err
+	}
+
+	tokenKey, err := histTokenBalanceKey(contract, symbol, owner, blockNum)
+	if err != nil {
+		return err
+	}
+
+	value := []byte{balanceRemoved}
+	for _, asset := range c.Balances[contract][owner] {
+		if asset.Asset.Asset.Symbol.Symbol == symbol {
+			value = packHistBalanceValue(asset.Asset.Asset)
+			break
+		}
+	}
+
+	if err := c.kvStore.Put(ctx, accountKey, value); err != nil {
+		return err
+	}
+
+	return c.kvStore.Put(ctx, tokenKey, value)
+}
+
+func (c *HistoricalCache) putStake(ctx context.Context, blockNum uint64, account eos.AccountName) error {
+	key, err := histStakeKey(account, blockNum)
+	if err != nil {
+		return err
+	}
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(c.getStakeForAccount(account)))
+
+	return c.kvStore.Put(ctx, key, value)
+}
+
+func (c *HistoricalCache) blockAt(ctx context.Context, blockNum uint64) (bstream.BlockRef, error) {
+	value, err := c.kvStore.Get(ctx, histBlockKey(blockNum))
+	if err == store.ErrNotFound {
+		return nil, fmt.Errorf("block #%d: %w", blockNum, ErrBlockNotInHistory)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return unpackHistBlockValue(value), nil
+}
+
+func (c *HistoricalCache) TokensAt(ctx context.Context, blockNum uint64) (tokens []*pbtokenmeta.Token, atBlock bstream.BlockRef, err error) {
+	atBlock, err = c.blockAt(ctx, blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = scanHistAt(ctx, c.kvStore, []byte{histPrefixToken}, blockNum, func(key, value []byte) error {
+		token := &pbtokenmeta.Token{}
+		if err := proto.Unmarshal(value, token); err != nil {
+			return fmt.Errorf("unable to unmarshal token: %w", err)
+		}
+
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, atBlock, nil
+}
+
+func (c *HistoricalCache) AccountBalancesAt(ctx context.Context, account eos.AccountName, blockNum uint64, opts ...AccountBalanceOption) (ownedAssets []*OwnedAsset, atBlock bstream.BlockRef, err error) {
+	atBlock, err = c.blockAt(ctx, blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix, err := histKeyPrefix(histPrefixAccountBalance, histName(account))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = scanHistAt(ctx, c.kvStore, prefix, blockNum, func(key, value []byte) error {
+		if value[0] == balanceRemoved {
+			return nil
+		}
+
+		contract := eos.NameToString(binary.BigEndian.Uint64(key[9:]))
+		symbolCode := eos.SymbolCode(binary.BigEndian.Uint64(key[17:]))
+		asset := unpackHistBalanceValue(symbolCode, value)
+
+		if contract == string(EOSTokenContract) && symbolCode.String() == "EOS" && hasAccountBalanceOption(opts, EOSIncludeStakedAccOpt) {
+			stake, err := c.stakeAt(ctx, account, blockNum)
+			if err != nil {
+				return err
+			}
+			asset.Amount += eos.Int64(stake)
+		}
+
+		ownedAssets = append(ownedAssets, &OwnedAsset{
+			Owner: account,
+			Asset: &eos.ExtendedAsset{Contract: eos.AccountName(contract), Asset: asset},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ownedAssets, atBlock, nil
+}
+
+func (c *HistoricalCache) TokenBalancesAt(ctx context.Context, contract eos.AccountName, blockNum uint64, opts ...TokenBalanceOption) (tokenBalances []*OwnedAsset, atBlock bstream.BlockRef, err error) {
+	atBlock, err = c.blockAt(ctx, blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix, err := histKeyPrefix(histPrefixTokenBalance, histName(contract))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stakes map[eos.AccountName]int64
+	if contract == EOSTokenContract && hasTokenBalanceOption(opts, EOSIncludeStakedTokOpt) {
+		if stakes, err = c.stakesAt(ctx, blockNum); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = scanHistAt(ctx, c.kvStore, prefix, blockNum, func(key, value []byte) error {
+		if value[0] == balanceRemoved {
+			return nil
+		}
+
+		symbolCode := eos.SymbolCode(binary.BigEndian.Uint64(key[9:]))
+		owner := eos.AccountName(eos.NameToString(binary.BigEndian.Uint64(key[17:])))
+		asset := unpackHistBalanceValue(symbolCode, value)
+
+		if stakes != nil && symbolCode.String() == "EOS" {
+			asset.Amount += eos.Int64(stakes[owner])
+		}
+
+		tokenBalances = append(tokenBalances, &OwnedAsset{
+			Owner: owner,
+			Asset: &eos.ExtendedAsset{Contract: contract, Asset: asset},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokenBalances, atBlock, nil
+}
+
+func (c *HistoricalCache) stakeAt(ctx context.Context, account eos.AccountName, blockNum uint64) (int64, error) {
+	prefix, err := histKeyPrefix(histPrefixStake, histName(account))
+	if err != nil {
+		return 0, err
+	}
+
+	var stake int64
+	err = scanHistAt(ctx, c.kvStore, prefix, blockNum, func(key, value []byte) error {
+		stake = int64(binary.BigEndian.Uint64(value))
+		return nil
+	})
+
+	return stake, err
+}
+
+func (c *HistoricalCache) stakesAt(ctx context.Context, blockNum uint64) (map[eos.AccountName]int64, error) {
+	stakes := map[eos.AccountName]int64{}
+	err := scanHistAt(ctx, c.kvStore, []byte{histPrefixStake}, blockNum, func(key, value []byte) error {
+		stakes[eos.AccountName(eos.NameToString(binary.BigEndian.Uint64(key[1:])))] = int64(binary.BigEndian.Uint64(value))
+		return nil
+	})
+
+	return stakes, err
+}
+
+// scanHistAt goes through all the rows under `prefix` and calls `f` once per row key (the key
+// without its trailing inverted block num) with its value as of the end of `blockNum`. The versions
+// of a row are never scanned, each row costs one seek to its most recent version, and a second one to
+// its version at `blockNum` when the most recent is newer.
+func scanHistAt(ctx context.Context, kvStore store.KVStore, prefix []byte, blockNum uint64, f func(key, value []byte) error) error {
+	end := store.Key(prefix).PrefixNext()
+	invertedBlockNum := make([]byte, 8)
+	binary.BigEndian.PutUint64(invertedBlockNum, ^blockNum)
+
+	start := prefix
+	for {
+		key, value, err := firstHistRow(ctx, kvStore, start, end)
+		if err != nil || key == nil {
+			return err
+		}
+
+		rowKey := key[:len(key)-8]
+		if ^binary.BigEndian.Uint64(key[len(key)-8:]) > blockNum {
+			versionKey := append(append([]byte{}, rowKey...), invertedBlockNum...)
+			if key, value, err = firstHistRow(ctx, kvStore, versionKey, store.Key(rowKey).PrefixNext()); err != nil {
+				return err
+			}
+		}
+
+		if key != nil {
+			if err := f(rowKey, value); err != nil {
+				return err
+			}
+		}
+
+		start = store.Key(rowKey).PrefixNext()
+	}
+}
+
+// firstHistRow returns the first row in [start, exclusiveEnd), a `nil` key when there is none
+func firstHistRow(ctx context.Context, kvStore store.KVStore, start, exclusiveEnd []byte) (key, value []byte, err error) {
+	it := kvStore.Scan(ctx, start, exclusiveEnd, 1)
+	for it.Next() {
+		key, value = it.Item().Key, it.Item().Value
+	}
+
+	return key, value, it.Err()
+}
+
+func histBlockKey(blockNum uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = histPrefixBlock
+	binary.BigEndian.PutUint64(key[1:], ^blockNum)
+	return key
+}
+
+func histAccountBalanceKey(account, contract eos.AccountName, symbol string, blockNum uint64) ([]byte, error) {
+	return histKey(histPrefixAccountBalance, blockNum, histName(account), histName(contract), histSymbol(symbol))
+}
+
+func histTokenBalanceKey(contract eos.AccountName, symbol string, account eos.AccountName, blockNum uint64) ([]byte, error) {
+	return histKey(histPrefixTokenBalance, blockNum, histName(contract), histSymbol(symbol), histName(account))
+}
+
+func histTokenKey(contract eos.AccountName, symbol string, blockNum uint64) ([]byte, error) {
+	return histKey(histPrefixToken, blockNum, histName(contract), histSymbol(symbol))
+}
+
+func histStakeKey(account eos.AccountName, blockNum uint64) ([]byte, error) {
+	return histKey(histPrefixStake, blockNum, histName(account))
+}
+
+// histKey packs each part on 8 bytes, followed by the inverted block num
+func histKey(prefix byte, blockNum uint64, parts ...histKeyPart) ([]byte, error) {
+	key, err := histKeyPrefix(prefix, parts...)
+	if err != nil {
+		return nil, err
+	}
+
+	inverted := make([]byte, 8)
+	binary.BigEndian.PutUint64(inverted, ^blockNum)
+	return append(key, inverted...), nil
+}
+
+func histKeyPrefix(prefix byte, parts ...histKeyPart) ([]byte, error) {
+	key := make([]byte, 1, 1+8*len(parts))
+	key[0] = prefix
+
+	for _, part := range parts {
+		value, err := part()
+		if err != nil {
+			return nil, err
+		}
+
+		packed := make([]byte, 8)
+		binary.BigEndian.PutUint64(packed, value)
+		key = append(key, packed...)
+	}
+
+	return key, nil
+}
+
+type histKeyPart func() (uint64, error)
+
+func histName(name eos.AccountName) histKeyPart {
+	return func() (uint64, error) {
+		value, err := eos.StringToName(string(name))
+		if err != nil {
+			return 0, fmt.Errorf("invalid name %q: %w", name, err)
+		}
+		return value, nil
+	}
+}
+
+func histSymbol(symbol string) histKeyPart {
+	return func() (uint64, error) {
+		symbolCode, err := eos.StringToSymbolCode(symbol)
+		if err != nil {
+			return 0, fmt.Errorf("invalid symbol code %q: %w", symbol, err)
+		}
+		return uint64(symbolCode), nil
+	}
+}
+
+func packHistBlockValue(block bstream.BlockRef) []byte {
+	value := make([]byte, 8, 8+len(block.ID()))
+	binary.BigEndian.PutUint64(value, block.Num())
+	return append(value, block.ID()...)
+}
+
+func unpackHistBlockValue(value []byte) bstream.BlockRef {
+	return bstream.NewBlockRef(string(value[8:]), binary.BigEndian.Uint64(value))
+}
+
+func packHistBalanceValue(asset eos.Asset) []byte {
+	value := make([]byte, 10)
+	value[0] = balanceSet
+	value[1] = asset.Symbol.Precision
+	binary.BigEndian.PutUint64(value[2:], uint64(asset.Amount))
+	return value
+}
+
+func unpackHistBalanceValue(symbolCode eos.SymbolCode, value []byte) eos.Asset {
+	return eos.Asset{
+		Amount: eos.Int64(binary.BigEndian.Uint64(value[2:])),
+		Symbol: eos.Symbol{Precision: value[1], Symbol: symbolCode.String()},
+	}
+}
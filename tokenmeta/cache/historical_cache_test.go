@@ -0,0 +1,210 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/kvdb/store"
+	_ "github.com/dfuse-io/kvdb/store/badger"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalCache(t *testing.T) {
+	ctx := context.Background()
+	kvStore, closer := newTestKVStore(t)
+	defer closer()
+
+	eosToken := &pbtokenmeta.Token{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 1000}
+	balance := func(account string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: "EOS"}
+	}
+
+	c := NewHistoricalCache(NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{eosToken},
+		[]*pbtokenmeta.AccountBalance{balance("alice", 100), balance("bob", 50)},
+		[]*EOSStakeEntry{{From: "alice", To: "alice", Net: 5, Cpu: 5}},
+		bstream.NewBlockRef("0000000aa", 10),
+		"",
+	), kvStore)
+
+	lastBlock, err := c.LastRecordedBlock(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, lastBlock)
+
+	require.NoError(t, c.RecordSnapshot(ctx))
+
+	muts := &MutationsBatch{}
+	muts.SetBalance(balance("alice", 80))
+	muts.SetBalance(balance("carol", 20))
+	muts.SetStake(&EOSStakeEntry{From: "alice", To: "alice", Net: 10, Cpu: 10})
+	require.Len(t, c.Apply(muts, bstream.NewBlockRef("0000000ba", 11)), 0)
+
+	muts = &MutationsBatch{}
+	muts.RemoveBalance(balance("bob", 0))
+	muts.SetToken(&pbtokenmeta.Token{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 2000})
+	require.Len(t, c.Apply(muts, bstream.NewBlockRef("0000000ca", 12)), 0)
+
+	lastBlock, err = c.LastRecordedBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000ca", lastBlock.ID())
+
+	accountBalances := func(account string, blockNum uint64, opts ...AccountBalanceOption) (out []string) {
+		assets, atBlock, err := c.AccountBalancesAt(ctx, eos.AccountName(account), blockNum, opts...)
+		require.NoError(t, err)
+		assert.Equal(t, blockNum, atBlock.Num())
+		for _, a := range assets {
+			out = append(out, fmt.Sprintf("%s %s", a.Owner, a.Asset.Asset))
+		}
+		return
+	}
+
+	assert.Equal(t, []string{"alice 0.0100 EOS"}, accountBalances("alice", 10))
+	assert.Equal(t, []string{"alice 0.0110 EOS"}, accountBalances("alice", 10, EOSIncludeStakedAccOpt))
+	assert.Equal(t, []string{"alice 0.0080 EOS"}, accountBalances("alice", 11))
+	assert.Equal(t, []string{"alice 0.0100 EOS"}, accountBalances("alice", 12, EOSIncludeStakedAccOpt))
+	assert.Equal(t, []string{"bob 0.0050 EOS"}, accountBalances("bob", 11))
+	assert.Nil(t, accountBalances("bob", 12))
+	assert.Nil(t, accountBalances("carol", 10))
+
+	tokenBalances := func(blockNum uint64, opts ...TokenBalanceOption) (out []string) {
+		assets, _, err := c.TokenBalancesAt(ctx, "eosio.token", blockNum, opts...)
+		require.NoError(t, err)
+		for _, a := range assets {
+			out = append(out, fmt.Sprintf("%s %s", a.Owner, a.Asset.Asset))
+		}
+		return
+	}
+
+	assert.ElementsMatch(t, []string{"alice 0.0100 EOS", "bob 0.0050 EOS"}, tokenBalances(10))
+	assert.ElementsMatch(t, []string{"alice 0.0080 EOS", "bob 0.0050 EOS", "carol 0.0020 EOS"}, tokenBalances(11))
+	assert.ElementsMatch(t, []string{"alice 0.0100 EOS", "carol 0.0020 EOS"}, tokenBalances(12, EOSIncludeStakedTokOpt))
+
+	tokens := func(blockNum uint64) (out []string) {
+		tokens, _, err := c.TokensAt(ctx, blockNum)
+		require.NoError(t, err)
+		for _, t := range tokens {
+			out = append(out, fmt.Sprintf("%s/%s supply=%d holders=%d", t.Contract, t.Symbol, t.TotalSupply, t.Holders))
+		}
+		return
+	}
+
+	assert.Equal(t, []string{"eosio.token/EOS supply=1000 holders=2"}, tokens(10))
+	assert.Equal(t, []string{"eosio.token/EOS supply=1000 holders=3"}, tokens(11))
+	assert.Equal(t, []string{"eosio.token/EOS supply=2000 holders=2"}, tokens(12))
+
+	_, _, err = c.AccountBalancesAt(ctx, "alice", 9)
+	assert.True(t, errors.Is(err, ErrBlockNotInHistory))
+
+	_, _, err = c.TokensAt(ctx, 13)
+	assert.True(t, errors.Is(err, ErrBlockNotInHistory))
+}
+
+func TestHistoricalCache_ReadsOneVersionPerRow(t *testing.T) {
+	ctx := context.Background()
+	testKVStore, closer := newTestKVStore(t)
+	defer closer()
+	kvStore := &countingKVStore{KVStore: testKVStore}
+
+	balance := func(account string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: "EOS"}
+	}
+
+	accounts := []string{"alice", "bob", "carol"}
+	c := NewHistoricalCache(NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 1000}},
+		nil,
+		nil,
+		bstream.NewBlockRef("00000001a", 1),
+		"",
+	), kvStore)
+	require.NoError(t, c.RecordSnapshot(ctx))
+
+	// Every balance and the token change in each block, 100 versions per row
+	for blockNum := uint64(2); blockNum <= 101; blockNum++ {
+		muts := &MutationsBatch{}
+		for _, account := range accounts {
+			muts.SetBalance(balance(account, blockNum))
+		}
+		muts.SetStake(&EOSStakeEntry{From: "alice", To: "alice", Net: eos.Int64(blockNum)})
+		require.Len(t, c.Apply(muts, bstream.NewBlockRef(fmt.Sprintf("%08xa", blockNum), blockNum)), 0)
+	}
+
+	for _, blockNum := range []uint64{1, 50, 101} {
+		atomic.StoreInt64(&kvStore.reads, 0)
+		assets, _, err := c.TokenBalancesAt(ctx, "eosio.token", blockNum, EOSIncludeStakedTokOpt)
+		require.NoError(t, err)
+
+		var balances []string
+		for _, a := range assets {
+			balances = append(balances, fmt.Sprintf("%s %d", a.Owner, a.Asset.Asset.Amount))
+		}
+
+		// at most two rows read per balance and stake, whatever the number of versions
+		assert.LessOrEqual(t, atomic.LoadInt64(&kvStore.reads), int64(2*(len(accounts)+1)), "block #%d", blockNum)
+		if blockNum == 1 {
+			assert.Nil(t, balances)
+			continue
+		}
+		assert.Equal(t, []string{fmt.Sprintf("alice %d", 2*blockNum), fmt.Sprintf("bob %d", blockNum), fmt.Sprintf("carol %d", blockNum)}, balances, "block #%d", blockNum)
+
+		atomic.StoreInt64(&kvStore.reads, 0)
+		tokens, _, err := c.TokensAt(ctx, blockNum)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, uint64(3), tokens[0].Holders)
+		assert.LessOrEqual(t, atomic.LoadInt64(&kvStore.reads), int64(2), "block #%d", blockNum)
+	}
+}
+
+// countingKVStore counts the rows read through scans
+type countingKVStore struct {
+	store.KVStore
+	reads int64
+}
+
+func (s *countingKVStore) Scan(ctx context.Context, start, exclusiveEnd []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	return s.count(ctx, s.KVStore.Scan(ctx, start, exclusiveEnd, limit, options...))
+}
+
+func (s *countingKVStore) Prefix(ctx context.Context, prefix []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	return s.count(ctx, s.KVStore.Prefix(ctx, prefix, limit, options...))
+}
+
+func (s *countingKVStore) count(ctx context.Context, it *store.Iterator) *store.Iterator {
+	out := store.NewIterator(ctx)
+	go func() {
+		for it.Next() {
+			atomic.AddInt64(&s.reads, 1)
+			if !out.PushItem(it.Item()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			out.PushError(err)
+			return
+		}
+		out.PushFinished()
+	}()
+	return out
+}
+
+func newTestKVStore(t *testing.T) (store.KVStore, func()) {
+	tmp, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	kvStore, err := store.New(fmt.Sprintf("badger://%s/test.db?createTables=true", tmp))
+	require.NoError(t, err)
+
+	return kvStore, func() {
+		kvStore.Close()
+		os.RemoveAll(tmp)
+	}
+}
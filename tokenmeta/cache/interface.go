@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"time"
 
 	"github.com/dfuse-io/bstream"
@@ -21,6 +22,23 @@ type Cache interface {
 	GetHeadBlockTime() time.Time
 }
 
+// History answers the same queries as `Cache` as of the end of a past block, see `HistoricalCache`
+type History interface {
+	TokensAt(ctx context.Context, blockNum uint64) ([]*pbtokenmeta.Token, bstream.BlockRef, error)
+	AccountBalancesAt(ctx context.Context, account eos.AccountName, blockNum uint64, opts ...AccountBalanceOption) ([]*OwnedAsset, bstream.BlockRef, error)
+	TokenBalancesAt(ctx context.Context, contract eos.AccountName, blockNum uint64, opts ...TokenBalanceOption) ([]*OwnedAsset, bstream.BlockRef, error)
+}
+
+// Reversible answers the same queries as `Cache` as of the last irreversible block while the cache
+// itself follows the head of the chain, see `ReversibleCache`
+type Reversible interface {
+	IrreversibleBlockRef() bstream.BlockRef
+	IrreversibleTokens() []*pbtokenmeta.Token
+	IrreversibleAccountBalances(account eos.AccountName, opts ...AccountBalanceOption) []*OwnedAsset
+	IrreversibleTokenBalances(contract eos.AccountName, opts ...TokenBalanceOption) []*OwnedAsset
+	IsReversible(asset *OwnedAsset) bool
+}
+
 const EOSTokenContract = eos.AccountName("eosio.token")
 
 type SortingOrder int32
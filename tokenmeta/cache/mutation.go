@@ -17,6 +17,10 @@ const (
 	SetTokenMutation
 	SetStakeMutation
 	SetContractMutation
+
+	// Only produced by `ReversibleCache` to undo the creation of a token or contract
+	RemoveTokenMutation
+	RemoveContractMutation
 )
 
 func (m *MutationsBatch) Mutations() []*Mutation {
@@ -80,3 +84,12 @@ type Mutation struct {
 	Type MutationType
 	Args []interface{}
 }
+
+// OwnedAsset returns the balance of a `SetBalanceMutation` or `RemoveBalanceMutation`, which
+// can either be a `*pbtokenmeta.AccountBalance` or an `*OwnedAsset`
+func (m *Mutation) OwnedAsset() *OwnedAsset {
+	if bal, ok := m.Args[0].(*pbtokenmeta.AccountBalance); ok {
+		return ProtoEOSAccountBalanceToOwnedAsset(bal)
+	}
+	return m.Args[0].(*OwnedAsset)
+}
@@ -0,0 +1,418 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+)
+
+// ReversibleCache is a `DefaultCache` following the head of the chain, the mutations of each new
+// block are applied right away along with an undo batch (the inverse of each mutation, computed
+// from the cache right before applying it) reverting the block when it is forked out.
+//
+// The values as of the last irreversible block are answered by overlaying, on top of the head
+// values, the oldest undo mutation of each balance, token and stake changed by reversible blocks.
+type ReversibleCache struct {
+	*DefaultCache
+
+	irreversibleBlock bstream.BlockRef
+	reversibleBlocks  []*reversibleBlock // oldest first
+	overlay           *irreversibleOverlay
+}
+
+type reversibleBlock struct {
+	block    bstream.BlockRef
+	previous bstream.BlockRef
+
+	// successfully applied mutations and, at the same index, their inverse
+	mutations []*Mutation
+	undo      []*Mutation
+}
+
+type balanceKey struct {
+	contract eos.AccountName
+	owner    eos.AccountName
+	symbol   string
+}
+
+type tokenKey struct {
+	contract eos.AccountName
+	symbol   string
+}
+
+type stakeKey struct {
+	from eos.AccountName
+	to   eos.AccountName
+}
+
+func ownedAssetKey(asset *OwnedAsset) balanceKey {
+	return balanceKey{contract: asset.Asset.Contract, owner: asset.Owner, symbol: asset.Asset.Asset.Symbol.Symbol}
+}
+
+// NewReversibleCache wraps a cache at an irreversible block, usually freshly loaded or bootstrapped
+func NewReversibleCache(cache *DefaultCache) *ReversibleCache {
+	return &ReversibleCache{
+		DefaultCache:      cache,
+		irreversibleBlock: cache.AtBlockRef(),
+		overlay:           newIrreversibleOverlay(),
+	}
+}
+
+// Apply applies the mutations of a new block, it can be called more than once for the same block
+func (c *ReversibleCache) Apply(mutationsBatch *MutationsBatch, processedBlock bstream.BlockRef) (errors []error) {
+	c.blocklevelLock.Lock()
+	defer c.blocklevelLock.Unlock()
+
+	var rblock *reversibleBlock
+	if len(c.reversibleBlocks) != 0 && c.reversibleBlocks[len(c.reversibleBlocks)-1].block.ID() == processedBlock.ID() {
+		rblock = c.reversibleBlocks[len(c.reversibleBlocks)-1]
+	} else {
+		rblock = &reversibleBlock{
+			block:    bstream.NewBlockRef(processedBlock.ID(), processedBlock.Num()),
+			previous: bstream.NewBlockRef(c.AtBlock.Id, c.AtBlock.Num),
+		}
+		c.reversibleBlocks = append(c.reversibleBlocks, rblock)
+	}
+
+	for _, mut := range mutationsBatch.Mutations() {
+		undo := c.inverseMutation(mut)
+		if err := c.applyMutation(mut); err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		if undo != nil {
+			rblock.mutations = append(rblock.mutations, mut)
+			rblock.undo = append(rblock.undo, undo)
+			c.overlay.add(undo)
+		}
+	}
+	c.setAtBlock(processedBlock)
+	return
+}
+
+// Undo reverts the last applied block, which must be `block`, when it is forked out
+func (c *ReversibleCache) Undo(block bstream.BlockRef) error {
+	c.blocklevelLock.Lock()
+	defer c.blocklevelLock.Unlock()
+
+	if len(c.reversibleBlocks) == 0 || c.reversibleBlocks[len(c.reversibleBlocks)-1].block.ID() != block.ID() {
+		return fmt.Errorf("cannot undo block %s, it is not the last applied block", block)
+	}
+
+	rblock := c.reversibleBlocks[len(c.reversibleBlocks)-1]
+	if err := c.revert(rblock); err != nil {
+		return err
+	}
+
+	c.reversibleBlocks = c.reversibleBlocks[:len(c.reversibleBlocks)-1]
+	c.rebuildOverlay()
+	return nil
+}
+
+// MarkIrreversible forgets the undo batches of the blocks up to `block`, they cannot be forked out anymore
+func (c *ReversibleCache) MarkIrreversible(block bstream.BlockRef) {
+	c.blocklevelLock.Lock()
+	defer c.blocklevelLock.Unlock()
+
+	c.irreversibleBlock = bstream.NewBlockRef(block.ID(), block.Num())
+
+	irreversibleCount := 0
+	for irreversibleCount < len(c.reversibleBlocks) && c.reversibleBlocks[irreversibleCount].block.Num() <= block.Num() {
+		irreversibleCount++
+	}
+	if irreversibleCount == 0 {
+		return
+	}
+
+	c.reversibleBlocks = c.reversibleBlocks[irreversibleCount:]
+	c.rebuildOverlay()
+}
+
+// SaveToFile saves the cache as of the last irreversible block, which is where the next start
+// resumes from. Reversible blocks are reverted for the time of the save, then applied again.
+func (c *ReversibleCache) SaveToFile() error {
+	c.blocklevelLock.Lock()
+	defer c.blocklevelLock.Unlock()
+
+	for i := len(c.reversibleBlocks) - 1; i >= 0; i-- {
+		if err := c.revert(c.reversibleBlocks[i]); err != nil {
+			return err
+		}
+	}
+
+	saveErr := c.saveToFile()
+
+	for _, rblock := range c.reversibleBlocks {
+		for _, mut := range rblock.mutations {
+			if err := c.applyMutation(mut); err != nil {
+				return fmt.Errorf("unable to apply again block %s after saving: %w", rblock.block, err)
+			}
+		}
+		c.setAtBlock(rblock.block)
+	}
+
+	return saveErr
+}
+
+func (c *ReversibleCache) revert(rblock *reversibleBlock) error {
+	for i := len(rblock.undo) - 1; i >= 0; i-- {
+		if err := c.applyMutation(rblock.undo[i]); err != nil {
+			return fmt.Errorf("unable to revert block %s: %w", rblock.block, err)
+		}
+	}
+	c.setAtBlock(rblock.previous)
+	return nil
+}
+
+// inverseMutation returns the mutation reverting `mut` from the current content of the cache, or
+// `nil` when `mut` cannot be applied. The previous balances, tokens and stakes are replaced (and
+// never modified) by the mutations, so they are used as is.
+func (c *ReversibleCache) inverseMutation(mut *Mutation) *Mutation {
+	switch mut.Type {
+	case SetBalanceMutation, RemoveBalanceMutation:
+		asset := mut.OwnedAsset()
+		if previous := c.balance(ownedAssetKey(asset)); previous != nil {
+			// `setBalance` replaces the asset of the existing balance, copying it keeps the current one
+			return &Mutation{Type: SetBalanceMutation, Args: []interface{}{&OwnedAsset{Owner: previous.Owner, Asset: previous.Asset}}}
+		}
+		if mut.Type == RemoveBalanceMutation {
+			return nil
+		}
+		return &Mutation{Type: RemoveBalanceMutation, Args: []interface{}{asset}}
+	case SetTokenMutation:
+		token := mut.Args[0].(*pbtokenmeta.Token)
+		if previous := c.token(tokenKey{contract: eos.AccountName(token.Contract), symbol: token.Symbol}); previous != nil {
+			return &Mutation{Type: SetTokenMutation, Args: []interface{}{previous}}
+		}
+		return &Mutation{Type: RemoveTokenMutation, Args: []interface{}{token}}
+	case SetStakeMutation:
+		stake := mut.Args[0].(*EOSStakeEntry)
+		if eosStake, ok := c.EOSStake[stake.From]; ok {
+			if previous, ok := eosStake.Entries[stake.To]; ok {
+				return &Mutation{Type: SetStakeMutation, Args: []interface{}{previous}}
+			}
+		}
+		return &Mutation{Type: SetStakeMutation, Args: []interface{}{&EOSStakeEntry{From: stake.From, To: stake.To}}}
+	case SetContractMutation:
+		contract := mut.Args[0].(eos.AccountName)
+		if _, found := c.TokensInContract[contract]; found {
+			return nil
+		}
+		return &Mutation{Type: RemoveContractMutation, Args: []interface{}{contract}}
+	}
+	return nil
+}
+
+func (c *ReversibleCache) rebuildOverlay() {
+	c.overlay = newIrreversibleOverlay()
+	for _, rblock := range c.reversibleBlocks {
+		for _, undo := range rblock.undo {
+			c.overlay.add(undo)
+		}
+	}
+}
+
+// IrreversibleBlockRef returns the last irreversible block, `AtBlockRef` being the head block
+func (c *ReversibleCache) IrreversibleBlockRef() bstream.BlockRef {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	return c.irreversibleBlock
+}
+
+// IsReversible returns whether the balance was changed by a block that is not irreversible yet
+func (c *ReversibleCache) IsReversible(asset *OwnedAsset) bool {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	_, found := c.overlay.balances[ownedAssetKey(asset)]
+	return found
+}
+
+func (c *ReversibleCache) IrreversibleTokens() (tokens []*pbtokenmeta.Token) {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	holdersDelta := c.overlay.holdersDelta(c.DefaultCache)
+	for contract, contractTokens := range c.TokensInContract {
+		for _, token := range contractTokens {
+			key := tokenKey{contract: contract, symbol: token.Symbol}
+
+			irreversibleToken := token
+			if previous, found := c.overlay.tokens[key]; found {
+				if previous == nil {
+					continue
+				}
+				irreversibleToken = previous
+			}
+
+			// holders are only maintained on the tokens in the cache, from their balances
+			if irreversibleToken != token || holdersDelta[key] != 0 {
+				irreversibleToken = proto.Clone(irreversibleToken).(*pbtokenmeta.Token)
+				irreversibleToken.Holders = uint64(int64(token.Holders) + holdersDelta[key])
+			}
+			tokens = append(tokens, irreversibleToken)
+		}
+	}
+	return
+}
+
+func (c *ReversibleCache) IrreversibleAccountBalances(account eos.AccountName, opts ...AccountBalanceOption) []*OwnedAsset {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	var headAssets []*OwnedAsset
+	for _, assetsByOwner := range c.Balances {
+		headAssets = append(headAssets, assetsByOwner[account]...)
+	}
+
+	assets := c.overlay.irreversibleAssets(headAssets, func(key balanceKey) bool { return key.owner == account })
+	if hasAccountBalanceOption(opts, EOSIncludeStakedAccOpt) {
+		c.includeIrreversibleStakes(assets)
+	}
+	return assets
+}
+
+func (c *ReversibleCache) IrreversibleTokenBalances(contract eos.AccountName, opts ...TokenBalanceOption) []*OwnedAsset {
+	c.blocklevelLock.RLock()
+	defer c.blocklevelLock.RUnlock()
+
+	var headAssets []*OwnedAsset
+	for _, accAssets := range c.Balances[contract] {
+		headAssets = append(headAssets, accAssets...)
+	}
+
+	assets := c.overlay.irreversibleAssets(headAssets, func(key balanceKey) bool { return key.contract == contract })
+	if hasTokenBalanceOption(opts, EOSIncludeStakedTokOpt) {
+		c.includeIrreversibleStakes(assets)
+	}
+	return assets
+}
+
+// includeIrreversibleStakes replaces, in place, the EOS balances by their amount including the
+// irreversible stakes of their owner
+func (c *ReversibleCache) includeIrreversibleStakes(assets []*OwnedAsset) {
+	stakeDeltas := c.overlay.stakeDeltas(c.DefaultCache)
+	for i, ass := range assets {
+		if ass.Asset.Contract != EOSTokenContract || ass.Asset.Asset.Symbol.MustSymbolCode().String() != "EOS" {
+			continue
+		}
+
+		assets[i] = &OwnedAsset{
+			Owner: ass.Owner,
+			Asset: &eos.ExtendedAsset{
+				Contract: ass.Asset.Contract,
+				Asset:    eos.NewEOSAsset(int64(ass.Asset.Asset.Amount) + c.getStakeForAccount(ass.Owner) + stakeDeltas[ass.Owner]),
+			},
+		}
+	}
+}
+
+// irreversibleOverlay holds the values, as of the last irreversible block, of everything changed
+// by reversible blocks, a `nil` value meaning that it did not exist
+type irreversibleOverlay struct {
+	balances map[balanceKey]*OwnedAsset
+	tokens   map[tokenKey]*pbtokenmeta.Token
+	stakes   map[stakeKey]*EOSStakeEntry
+}
+
+func newIrreversibleOverlay() *irreversibleOverlay {
+	return &irreversibleOverlay{
+		balances: map[balanceKey]*OwnedAsset{},
+		tokens:   map[tokenKey]*pbtokenmeta.Token{},
+		stakes:   map[stakeKey]*EOSStakeEntry{},
+	}
+}
+
+// add records the value reverted by an undo mutation, undo mutations must be added from the oldest
+// one so that only the first one of each balance, token or stake is kept
+func (o *irreversibleOverlay) add(undo *Mutation) {
+	switch undo.Type {
+	case SetBalanceMutation, RemoveBalanceMutation:
+		asset := undo.OwnedAsset()
+		key := ownedAssetKey(asset)
+		if _, found := o.balances[key]; !found {
+			if undo.Type == RemoveBalanceMutation {
+				asset = nil
+			}
+			o.balances[key] = asset
+		}
+	case SetTokenMutation, RemoveTokenMutation:
+		token := undo.Args[0].(*pbtokenmeta.Token)
+		key := tokenKey{contract: eos.AccountName(token.Contract), symbol: token.Symbol}
+		if _, found := o.tokens[key]; !found {
+			if undo.Type == RemoveTokenMutation {
+				token = nil
+			}
+			o.tokens[key] = token
+		}
+	case SetStakeMutation:
+		stake := undo.Args[0].(*EOSStakeEntry)
+		key := stakeKey{from: stake.From, to: stake.To}
+		if _, found := o.stakes[key]; !found {
+			o.stakes[key] = stake
+		}
+	}
+}
+
+// irreversibleAssets replaces the `headAssets` changed by reversible blocks by their irreversible
+// value, and adds the irreversible balances matching `include` that do not exist at head anymore
+func (o *irreversibleOverlay) irreversibleAssets(headAssets []*OwnedAsset, include func(key balanceKey) bool) (assets []*OwnedAsset) {
+	seen := map[balanceKey]bool{}
+	for _, ass := range headAssets {
+		key := ownedAssetKey(ass)
+		seen[key] = true
+
+		if previous, found := o.balances[key]; found {
+			if previous != nil {
+				assets = append(assets, previous)
+			}
+			continue
+		}
+		assets = append(assets, ass)
+	}
+
+	for key, previous := range o.balances {
+		if previous != nil && !seen[key] && include(key) {
+			assets = append(assets, previous)
+		}
+	}
+	return
+}
+
+func (o *irreversibleOverlay) holdersDelta(c *DefaultCache) map[tokenKey]int64 {
+	deltas := map[tokenKey]int64{}
+	for key, previous := range o.balances {
+		atHead := c.balance(key) != nil
+		atIrreversible := previous != nil
+		if atHead == atIrreversible {
+			continue
+		}
+
+		token := tokenKey{contract: key.contract, symbol: key.symbol}
+		if atIrreversible {
+			deltas[token]++
+		} else {
+			deltas[token]--
+		}
+	}
+	return deltas
+}
+
+func (o *irreversibleOverlay) stakeDeltas(c *DefaultCache) map[eos.AccountName]int64 {
+	deltas := map[eos.AccountName]int64{}
+	for key, previous := range o.stakes {
+		delta := int64(previous.Net + previous.Cpu)
+		if eosStake, ok := c.EOSStake[key.from]; ok {
+			if entry, ok := eosStake.Entries[key.to]; ok {
+				delta -= int64(entry.Net + entry.Cpu)
+			}
+		}
+		deltas[key.from] += delta
+	}
+	return deltas
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReversibleCache(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "tokenmeta")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	cacheFile := filepath.Join(tmp, "token-cache.gob")
+
+	balance := func(account string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: "EOS"}
+	}
+
+	c := NewReversibleCache(NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 1000}},
+		[]*pbtokenmeta.AccountBalance{balance("alice", 100), balance("bob", 50)},
+		[]*EOSStakeEntry{{From: "alice", To: "alice", Net: 5, Cpu: 5}},
+		bstream.NewBlockRef("0000000aa", 10),
+		cacheFile,
+	))
+
+	muts := &MutationsBatch{}
+	muts.SetBalance(balance("alice", 80))
+	muts.SetBalance(balance("carol", 20))
+	muts.SetStake(&EOSStakeEntry{From: "alice", To: "alice", Net: 10, Cpu: 10})
+	muts.SetToken(&pbtokenmeta.Token{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 2000})
+	muts.SetContract("new.token")
+	muts.SetToken(&pbtokenmeta.Token{Contract: "new.token", Symbol: "NEW", Precision: 4})
+	require.Len(t, c.Apply(muts, bstream.NewBlockRef("0000000ba", 11)), 0)
+
+	muts = &MutationsBatch{}
+	muts.RemoveBalance(balance("bob", 0))
+	require.Len(t, c.Apply(muts, bstream.NewBlockRef("0000000ca", 12)), 0)
+
+	balances := func(assets []*OwnedAsset) (out []string) {
+		for _, a := range assets {
+			out = append(out, fmt.Sprintf("%s %s", a.Owner, a.Asset.Asset))
+		}
+		return
+	}
+	tokens := func(tokens []*pbtokenmeta.Token) (out []string) {
+		for _, t := range tokens {
+			out = append(out, fmt.Sprintf("%s/%s supply=%d holders=%d", t.Contract, t.Symbol, t.TotalSupply, t.Holders))
+		}
+		return
+	}
+	isReversible := func(account string) bool {
+		return c.IsReversible(ProtoEOSAccountBalanceToOwnedAsset(balance(account, 0)))
+	}
+
+	assert.Equal(t, "0000000ca", c.AtBlockRef().ID())
+	assert.Equal(t, "0000000aa", c.IrreversibleBlockRef().ID())
+	assert.ElementsMatch(t, []string{"alice 0.0080 EOS", "carol 0.0020 EOS"}, balances(c.TokenBalances("eosio.token")))
+	assert.ElementsMatch(t, []string{"alice 0.0100 EOS", "bob 0.0050 EOS"}, balances(c.IrreversibleTokenBalances("eosio.token")))
+	assert.Equal(t, []string{"alice 0.0110 EOS"}, balances(c.IrreversibleAccountBalances("alice", EOSIncludeStakedAccOpt)))
+	assert.Equal(t, []string{"bob 0.0050 EOS"}, balances(c.IrreversibleAccountBalances("bob")))
+	assert.ElementsMatch(t, []string{"eosio.token/EOS supply=2000 holders=2", "new.token/NEW supply=0 holders=0"}, tokens(c.Tokens()))
+	assert.Equal(t, []string{"eosio.token/EOS supply=1000 holders=2"}, tokens(c.IrreversibleTokens()))
+	assert.True(t, isReversible("alice"))
+	assert.True(t, isReversible("bob"))
+
+	require.NoError(t, c.Undo(bstream.NewBlockRef("0000000ca", 12)))
+	assert.Error(t, c.Undo(bstream.NewBlockRef("0000000ca", 12)))
+	assert.Equal(t, "0000000ba", c.AtBlockRef().ID())
+	assert.Equal(t, []string{"bob 0.0050 EOS"}, balances(c.AccountBalances("bob")))
+	assert.False(t, isReversible("bob"))
+
+	muts = &MutationsBatch{}
+	muts.SetBalance(balance("bob", 40))
+	require.Len(t, c.Apply(muts, bstream.NewBlockRef("0000000cb", 12)), 0)
+
+	c.MarkIrreversible(bstream.NewBlockRef("0000000ba", 11))
+	assert.Equal(t, "0000000ba", c.IrreversibleBlockRef().ID())
+	assert.ElementsMatch(t, []string{"alice 0.0080 EOS", "bob 0.0050 EOS", "carol 0.0020 EOS"}, balances(c.IrreversibleTokenBalances("eosio.token")))
+	assert.Equal(t, []string{"alice 0.0100 EOS"}, balances(c.IrreversibleAccountBalances("alice", EOSIncludeStakedAccOpt)))
+	assert.False(t, isReversible("alice"))
+	assert.True(t, isReversible("bob"))
+
+	require.NoError(t, c.SaveToFile())
+	assert.Equal(t, "0000000cb", c.AtBlockRef().ID())
+	assert.Equal(t, []string{"bob 0.0040 EOS"}, balances(c.AccountBalances("bob")))
+
+	saved, err := LoadDefaultCacheFromFile(cacheFile)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000ba", saved.AtBlockRef().ID())
+	assert.Equal(t, []string{"bob 0.0050 EOS"}, balances(saved.AccountBalances("bob")))
+	assert.ElementsMatch(t, []string{"eosio.token/EOS supply=2000 holders=3", "new.token/NEW supply=0 holders=0"}, tokens(saved.Tokens()))
+}
@@ -5,21 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 )
 
-type contractStats struct {
-	hasAccountsTable bool
-	hasStatsTable    bool
-	isTokenContract  bool
-}
-
-func getTokenContractStats(account string, rawABI []byte, isJsonEncoded bool) (*contractStats, error) {
-	var abi *eos.ABI
-	var err error
+func decodeABI(account string, rawABI []byte, isJsonEncoded bool) (abi *eos.ABI, err error) {
 	if isJsonEncoded {
 		err = json.Unmarshal(rawABI, &abi)
 	} else {
@@ -29,27 +23,30 @@ func getTokenContractStats(account string, rawABI []byte, isJsonEncoded bool) (*
 	if err != nil {
 		return nil, fmt.Errorf("failed decoding ABI in account %q: %w", account, err)
 	}
+	return abi, nil
+}
 
+// EOSIOTokenStandard is the standard of `eosio.token` and its many copies: a `stat` table scoped by
+// symbol code holding each token and an `accounts` table scoped by holder holding its balances
+type EOSIOTokenStandard struct{}
+
+func (s *EOSIOTokenStandard) Name() string { return "eosio.token" }
+
+func (s *EOSIOTokenStandard) IsTokenContract(abi *eos.ABI) bool {
 	var hasStat, hasAccounts bool
 	var statStruct, accountStruct string
 	for _, tbl := range abi.Tables {
-		if tbl.Name == "stat" {
+		if tbl.Name == StatTable {
 			statStruct = tbl.Type
 			hasStat = true
-		} else if tbl.Name == "accounts" {
+		} else if tbl.Name == AccountsTable {
 			accountStruct = tbl.Type
 			hasAccounts = true
 		}
 	}
 
 	if !hasStat || !hasAccounts {
-		zlog.Debug("contract does not have either stats or accounts table not a token contract",
-			zap.String("account", account),
-		)
-		return &contractStats{
-			hasAccountsTable: hasAccounts,
-			hasStatsTable:    hasStat,
-		}, nil
+		return false
 	}
 
 	var hasStatFields, hasAccountFields bool
@@ -64,21 +61,131 @@ func getTokenContractStats(account string, rawABI []byte, isJsonEncoded bool) (*
 				s.Fields[1].Name == "max_supply" &&
 				s.Fields[2].Name == "issuer" {
 				hasStatFields = true
-			} else {
-				zlog.Debug("stat failed for", zap.String("account", account))
 			}
 		}
 	}
 
-	return &contractStats{
-		hasAccountsTable: hasAccounts,
-		hasStatsTable:    hasStat,
-		isTokenContract:  (hasStatFields && hasAccountFields),
-	}, nil
+	return hasStatFields && hasAccountFields
+}
+
+func (s *EOSIOTokenStandard) IsStandardTable(table eos.TableName) bool {
+	return table == AccountsTable || table == StatTable
+}
+
+func (s *EOSIOTokenStandard) ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	return processTokenDBOp(s.Name(), muts, tokens, dbop, row)
+}
+
+func (s *EOSIOTokenStandard) Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, tokenContract eos.AccountName, startBlockNum uint32) ([]*pbtokenmeta.Token, []*pbtokenmeta.AccountBalance, error) {
+	return bootstrapTokens(ctx, s.Name(), stateClient, tokenContract, startBlockNum)
+}
+
+// WrappedTokenStandard is the standard of the tokens wrapping an asset of another chain, like the
+// pNetwork `*.ptokens` contracts: the tables of `eosio.token` and a `redeem` action burning tokens to
+// release the wrapped asset
+type WrappedTokenStandard struct{}
+
+func (s *WrappedTokenStandard) Name() string { return "wrapped" }
+
+func (s *WrappedTokenStandard) IsTokenContract(abi *eos.ABI) bool {
+	if !(&EOSIOTokenStandard{}).IsTokenContract(abi) {
+		return false
+	}
+
+	for _, action := range abi.Actions {
+		if action.Name == RedeemAction {
+			return hasStructFields(abi, action.Type, "quantity")
+		}
+	}
+	return false
+}
+
+func (s *WrappedTokenStandard) IsStandardTable(table eos.TableName) bool {
+	return table == AccountsTable || table == StatTable
+}
+
+func (s *WrappedTokenStandard) ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	return processTokenDBOp(s.Name(), muts, tokens, dbop, row)
+}
+
+func (s *WrappedTokenStandard) Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, tokenContract eos.AccountName, startBlockNum uint32) ([]*pbtokenmeta.Token, []*pbtokenmeta.AccountBalance, error) {
+	return bootstrapTokens(ctx, s.Name(), stateClient, tokenContract, startBlockNum)
+}
+
+// hasStructFields returns whether a struct of the ABI has all the fields, its base is not followed
+func hasStructFields(abi *eos.ABI, structName string, fields ...string) bool {
+	for _, s := range abi.Structs {
+		if s.Name != structName {
+			continue
+		}
+
+		for _, field := range fields {
+			var found bool
+			for _, f := range s.Fields {
+				if f.Name == field {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// processTokenDBOp handles the `stat` and `accounts` tables of the standards sharing the layout of
+// `eosio.token`
+func processTokenDBOp(standard string, muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	tokenContract := eos.AccountName(dbop.Code)
+	symbolCode, err := eos.NameToSymbolCode(eos.Name(dbop.PrimaryKey))
+	if err != nil {
+		return fmt.Errorf("unable to decode primary key %q to symbol: %w", dbop.PrimaryKey, err)
+	}
+
+	switch eos.TableName(dbop.TableName) {
+	case AccountsTable:
+		eosToken := tokens.TokenContract(tokenContract, symbolCode)
+		if eosToken == nil {
+			return fmt.Errorf("unsupported token %s for contract", symbolCode)
+		}
+
+		accountBalance, err := getAccountBalanceFromDBRow(tokenContract, TokenToEOSSymbol(eosToken), dbop.Scope, row)
+		if err != nil {
+			return fmt.Errorf("could not create account balance from dbop row: %w", err)
+		}
+
+		if dbop.NewData == nil {
+			// if the db operation has no new data so it removed it
+			muts.RemoveBalance(accountBalance)
+		} else {
+			muts.SetBalance(accountBalance)
+		}
+	case StatTable:
+		var symbol *eos.Symbol
+		eosToken := tokens.TokenContract(tokenContract, symbolCode)
+		if eosToken == nil {
+			zlog.Debug("new token contract",
+				zap.String("token_contract", string(tokenContract)),
+				zap.String("symbol", symbolCode.String()),
+			)
+		} else {
+			symbol = TokenToEOSSymbol(eosToken)
+		}
 
+		token, err := getTokenFromDBRow(tokenContract, symbol, row)
+		if err != nil {
+			return fmt.Errorf("could not create token from dbop row: %w", err)
+		}
+		token.Standard = standard
+		muts.SetToken(token)
+	}
+	return nil
 }
 
-func processContract(ctx context.Context, tokenContract eos.AccountName, startBlockNum uint32, stateClient pbstatedb.StateClient) (tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, err error) {
+func bootstrapTokens(ctx context.Context, standard string, stateClient pbstatedb.StateClient, tokenContract eos.AccountName, startBlockNum uint32) (tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, err error) {
 	var symcodes []eos.SymbolCode
 	symcodes, err = getSymbolFromStateDB(ctx, stateClient, tokenContract, startBlockNum)
 	if err != nil {
@@ -94,6 +201,9 @@ func processContract(ctx context.Context, tokenContract eos.AccountName, startBl
 	if err != nil {
 		return
 	}
+	for _, token := range tokens {
+		token.Standard = standard
+	}
 
 	balances, err = getTokenBalancesFromStateDB(ctx, stateClient, tokenContract, startBlockNum)
 	return
@@ -0,0 +1,130 @@
+package tokenmeta
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEOSIOTokenStandard_IsTokenContract(t *testing.T) {
+	tokenABI := `{
+		"structs": [
+			{"name": "account", "fields": [{"name": "balance", "type": "asset"}]},
+			{"name": "currency_stats", "fields": [{"name": "supply", "type": "asset"}, {"name": "max_supply", "type": "asset"}, {"name": "issuer", "type": "name"}]}
+		],
+		"tables": [
+			{"name": "accounts", "type": "account"},
+			{"name": "stat", "type": "currency_stats"}
+		]
+	}`
+
+	tests := []struct {
+		name        string
+		abi         string
+		expectValue bool
+	}{
+		{"token contract", tokenABI, true},
+		{"missing stat table", strings.Replace(tokenABI, `"name": "stat"`, `"name": "stats"`, 1), false},
+		{"stat fields mismatch", strings.Replace(tokenABI, `"name": "max_supply"`, `"name": "maximum"`, 1), false},
+		{"account balance not an asset", strings.Replace(tokenABI, `"name": "balance", "type": "asset"`, `"name": "balance", "type": "uint64"`, 1), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			abi, err := decodeABI("eosio.token", []byte(test.abi), true)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectValue, (&EOSIOTokenStandard{}).IsTokenContract(abi))
+			if test.expectValue {
+				assert.IsType(t, &EOSIOTokenStandard{}, tokenStandardForABI(abi))
+			} else {
+				assert.Nil(t, tokenStandardForABI(abi))
+			}
+		})
+	}
+}
+
+func TestEOSIOTokenStandard_ProcessDBOp(t *testing.T) {
+	standard := &EOSIOTokenStandard{}
+	tokens := cache.NewDefaultCacheWithData([]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4}}, nil, nil, bstream.NewBlockRef("0000000aa", 10), "")
+	primaryKey := func(symbol string) string {
+		symbolCode, err := eos.StringToSymbolCode(symbol)
+		require.NoError(t, err)
+		return symbolCode.ToName()
+	}
+
+	muts := &cache.MutationsBatch{}
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio.token", TableName: "stat", Scope: primaryKey("WAX"), PrimaryKey: primaryKey("WAX"), NewData: []byte{0x01}},
+		[]byte(`{"supply":"10.00000000 WAX","max_supply":"100.00000000 WAX","issuer":"eosio"}`)))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio.token", TableName: "accounts", Scope: "alice", PrimaryKey: primaryKey("EOS")},
+		[]byte(`{"balance":"1.0000 EOS"}`)))
+	assert.Error(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio.token", TableName: "accounts", Scope: "alice", PrimaryKey: primaryKey("ABC"), NewData: []byte{0x01}},
+		[]byte(`{"balance":"1.0000 ABC"}`)))
+
+	mutations := muts.Mutations()
+	require.Len(t, mutations, 2)
+	assert.Equal(t, cache.SetTokenMutation, mutations[0].Type)
+	assert.Equal(t, &pbtokenmeta.Token{Contract: "eosio.token", Symbol: "WAX", Precision: 8, Issuer: "eosio", MaximumSupply: 10000000000, TotalSupply: 1000000000, Standard: "eosio.token"}, mutations[0].Args[0])
+	assert.Equal(t, cache.RemoveBalanceMutation, mutations[1].Type)
+}
+
+func TestWrappedTokenStandard_IsTokenContract(t *testing.T) {
+	wrappedABI := `{
+		"structs": [
+			{"name": "account", "fields": [{"name": "balance", "type": "asset"}]},
+			{"name": "currency_stats", "fields": [{"name": "supply", "type": "asset"}, {"name": "max_supply", "type": "asset"}, {"name": "issuer", "type": "name"}]},
+			{"name": "redeem", "fields": [{"name": "sender", "type": "name"}, {"name": "quantity", "type": "asset"}, {"name": "memo", "type": "string"}]}
+		],
+		"actions": [
+			{"name": "redeem", "type": "redeem"}
+		],
+		"tables": [
+			{"name": "accounts", "type": "account"},
+			{"name": "stat", "type": "currency_stats"}
+		]
+	}`
+
+	tests := []struct {
+		name           string
+		abi            string
+		expectStandard TokenStandard
+	}{
+		{"wrapped token contract", wrappedABI, &WrappedTokenStandard{}},
+		{"redeem without quantity", strings.Replace(wrappedABI, `"name": "quantity"`, `"name": "amount"`, 1), &EOSIOTokenStandard{}},
+		{"missing redeem action", strings.Replace(wrappedABI, `{"name": "redeem", "type": "redeem"}`, ``, 1), &EOSIOTokenStandard{}},
+		{"missing stat table", strings.Replace(wrappedABI, `"name": "stat"`, `"name": "stats"`, 1), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			abi, err := decodeABI("btc.ptokens", []byte(test.abi), true)
+			require.NoError(t, err)
+
+			if test.expectStandard == nil {
+				assert.Nil(t, tokenStandardForABI(abi))
+			} else {
+				assert.IsType(t, test.expectStandard, tokenStandardForABI(abi))
+			}
+		})
+	}
+}
+
+func TestWrappedTokenStandard_ProcessDBOp(t *testing.T) {
+	symbolCode, err := eos.StringToSymbolCode("PBTC")
+	require.NoError(t, err)
+
+	muts := &cache.MutationsBatch{}
+	require.NoError(t, (&WrappedTokenStandard{}).ProcessDBOp(muts, cache.NewDefaultCache(""), &pbcodec.DBOp{Code: "btc.ptokens", TableName: "stat", Scope: symbolCode.ToName(), PrimaryKey: symbolCode.ToName(), NewData: []byte{0x01}},
+		[]byte(`{"supply":"1.00000000 PBTC","max_supply":"100.00000000 PBTC","issuer":"btc.ptokens"}`)))
+
+	mutations := muts.Mutations()
+	require.Len(t, mutations, 1)
+	assert.Equal(t, &pbtokenmeta.Token{Contract: "btc.ptokens", Symbol: "PBTC", Precision: 8, Issuer: "btc.ptokens", MaximumSupply: 10000000000, TotalSupply: 100000000, Standard: "wrapped"}, mutations[0].Args[0])
+}
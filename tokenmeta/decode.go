@@ -7,7 +7,6 @@ import (
 
 	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
-	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 )
@@ -40,6 +39,23 @@ func (a *EOSStakeDbRow) valid() bool {
 	return true
 }
 
+type rexPoolDbRow struct {
+	TotalREX eos.Asset `json:"total_rex"`
+}
+
+func (r *rexPoolDbRow) valid() bool {
+	return r.TotalREX.Symbol.Symbol == REXSymbol
+}
+
+type rexBalDbRow struct {
+	Owner      eos.AccountName `json:"owner"`
+	REXBalance eos.Asset       `json:"rex_balance"`
+}
+
+func (r *rexBalDbRow) valid() bool {
+	return r.Owner != "" && r.REXBalance.Symbol.Symbol == REXSymbol
+}
+
 type statDbRow struct {
 	Issuer    eos.AccountName `json:"issuer"`
 	MaxSupply eos.Asset       `json:"max_supply"`
@@ -56,9 +72,30 @@ func (s *statDbRow) valid() bool {
 	return true
 }
 
+// transferActionData, issueActionData and retireActionData are the standard `eosio.token` actions,
+// which every token contract implements
+type transferActionData struct {
+	From     eos.AccountName `json:"from"`
+	To       eos.AccountName `json:"to"`
+	Quantity eos.Asset       `json:"quantity"`
+	Memo     string          `json:"memo"`
+}
+
+type issueActionData struct {
+	To       eos.AccountName `json:"to"`
+	Quantity eos.Asset       `json:"quantity"`
+	Memo     string          `json:"memo"`
+}
+
+type retireActionData struct {
+	Quantity eos.Asset `json:"quantity"`
+	Memo     string    `json:"memo"`
+}
+
 type abiItem struct {
 	abi      *eos.ABI
 	blockNum uint32
+	standard TokenStandard
 }
 
 func (t *TokenMeta) getABI(contract eos.AccountName, blockNum uint32) (*eos.ABI, error) {
@@ -90,29 +127,21 @@ func (t *TokenMeta) getABI(contract eos.AccountName, blockNum uint32) (*eos.ABI,
 	return abi, nil
 }
 
-func getStakeEntryFromDBRow(contract eos.AccountName, scope string, dbRow json.RawMessage) (*cache.EOSStakeEntry, error) {
-	stakeRow := &EOSStakeDbRow{}
-	err := json.Unmarshal(dbRow, &stakeRow)
+// getTokenStandard returns the standard a known token contract implements, detected from its ABI
+func (t *TokenMeta) getTokenStandard(contract eos.AccountName, blockNum uint32) (TokenStandard, error) {
+	abi, err := t.getABI(contract, blockNum)
 	if err != nil {
-		return nil, fmt.Errorf("cannot unmarsal EOS stake db row: %s", string(dbRow))
-	}
-	if !stakeRow.valid() {
-		return nil, fmt.Errorf("invalid stake row: %s", string(dbRow))
-	}
-	if stakeRow.From != eos.AccountName(scope) {
-		zlog.Warn("failed assumption: EOS stake FROM is not == scope",
-			zap.String("contract", string(contract)),
-			zap.String("scope", scope),
-			zap.String("from", string(stakeRow.From)),
-		)
+		return nil, err
 	}
 
-	return &cache.EOSStakeEntry{
-		From: stakeRow.From,
-		To:   stakeRow.To,
-		Net:  stakeRow.NetWeight.Amount,
-		Cpu:  stakeRow.CPUWeight.Amount,
-	}, nil
+	item := t.abisCache[string(contract)]
+	if item.standard == nil {
+		item.standard = tokenStandardForABI(abi)
+		if item.standard == nil {
+			return nil, fmt.Errorf("contract %q does not implement any known token standard", string(contract))
+		}
+	}
+	return item.standard, nil
 }
 
 func getAccountBalanceFromDBRow(contract eos.AccountName, symbol *eos.Symbol, scope string, dbRow json.RawMessage) (*pbtokenmeta.AccountBalance, error) {
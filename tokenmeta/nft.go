@@ -0,0 +1,153 @@
+package tokenmeta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+)
+
+// NFTSymbol is the symbol of the token tracking the assets of an NFT contract, it has no decimals
+// and the balance of an account is the number of assets it owns
+const NFTSymbol = "NFT"
+
+// SimpleAssetsStandard is the NFT standard of `simpleassets`, each asset is a row of the `sassets`
+// table scoped by its owner
+type SimpleAssetsStandard struct{}
+
+func (s *SimpleAssetsStandard) Name() string { return "simpleassets" }
+
+func (s *SimpleAssetsStandard) IsTokenContract(abi *eos.ABI) bool {
+	return hasTableFields(abi, SimpleAssetsTable, "id", "owner", "author", "category")
+}
+
+func (s *SimpleAssetsStandard) IsStandardTable(table eos.TableName) bool {
+	return table == SimpleAssetsTable
+}
+
+func (s *SimpleAssetsStandard) ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	return processNFTDBOp(s.Name(), muts, tokens, dbop)
+}
+
+func (s *SimpleAssetsStandard) Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) ([]*pbtokenmeta.Token, []*pbtokenmeta.AccountBalance, error) {
+	return bootstrapNFTs(ctx, s.Name(), stateClient, contract, SimpleAssetsTable, startBlockNum)
+}
+
+// AtomicAssetsStandard is the NFT standard of `atomicassets`, each asset is a row of the `assets`
+// table scoped by its owner
+type AtomicAssetsStandard struct{}
+
+func (s *AtomicAssetsStandard) Name() string { return "atomicassets" }
+
+func (s *AtomicAssetsStandard) IsTokenContract(abi *eos.ABI) bool {
+	return hasTableFields(abi, AtomicAssetsTable, "asset_id", "collection_name", "schema_name", "template_id")
+}
+
+func (s *AtomicAssetsStandard) IsStandardTable(table eos.TableName) bool {
+	return table == AtomicAssetsTable
+}
+
+func (s *AtomicAssetsStandard) ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	return processNFTDBOp(s.Name(), muts, tokens, dbop)
+}
+
+func (s *AtomicAssetsStandard) Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) ([]*pbtokenmeta.Token, []*pbtokenmeta.AccountBalance, error) {
+	return bootstrapNFTs(ctx, s.Name(), stateClient, contract, AtomicAssetsTable, startBlockNum)
+}
+
+// hasTableFields returns whether the ABI has the table and its rows have all the fields
+func hasTableFields(abi *eos.ABI, table eos.TableName, fields ...string) bool {
+	for _, tbl := range abi.Tables {
+		if tbl.Name == table {
+			return hasStructFields(abi, tbl.Type, fields...)
+		}
+	}
+	return false
+}
+
+// processNFTDBOp counts the assets created and removed in the table of an NFT standard, the content
+// of the rows does not matter, a transfer removes the asset from the scope of its previous owner and
+// inserts it in the scope of the new one
+func processNFTDBOp(standard string, muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp) error {
+	var delta int64
+	switch dbop.Operation {
+	case pbcodec.DBOp_OPERATION_INSERT:
+		delta = 1
+	case pbcodec.DBOp_OPERATION_REMOVE:
+		delta = -1
+	default:
+		return nil
+	}
+
+	contract := eos.AccountName(dbop.Code)
+	owner := eos.AccountName(dbop.Scope)
+	symbolCode, err := eos.StringToSymbolCode(NFTSymbol)
+	if err != nil {
+		return err
+	}
+
+	token := &pbtokenmeta.Token{
+		Contract: string(contract),
+		Symbol:   NFTSymbol,
+		Issuer:   string(contract),
+		Standard: standard,
+	}
+	if previous := pendingToken(muts, tokens, contract, symbolCode); previous != nil {
+		token.TotalSupply = previous.TotalSupply
+	}
+
+	amount, _ := pendingBalance(muts, tokens, contract, symbolCode, owner)
+	if delta < 0 && (amount == 0 || token.TotalSupply == 0) {
+		return fmt.Errorf("asset %s removed from %s which holds none", dbop.PrimaryKey, owner)
+	}
+
+	token.TotalSupply = uint64(int64(token.TotalSupply) + delta)
+	muts.SetToken(token)
+
+	balance := &pbtokenmeta.AccountBalance{
+		TokenContract: string(contract),
+		Account:       string(owner),
+		Amount:        uint64(int64(amount) + delta),
+		Symbol:        NFTSymbol,
+	}
+	if balance.Amount == 0 {
+		muts.RemoveBalance(balance)
+	} else {
+		muts.SetBalance(balance)
+	}
+	return nil
+}
+
+func bootstrapNFTs(ctx context.Context, standard string, stateClient pbstatedb.StateClient, contract eos.AccountName, table eos.TableName, startBlockNum uint32) (tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, err error) {
+	counts, err := getRowCountsFromStateDB(ctx, stateClient, contract, table, startBlockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(counts) == 0 {
+		// skip this contract no asset was found
+		return nil, nil, nil
+	}
+
+	token := &pbtokenmeta.Token{
+		Contract: string(contract),
+		Symbol:   NFTSymbol,
+		Issuer:   string(contract),
+		Standard: standard,
+	}
+	for owner, count := range counts {
+		token.TotalSupply += count
+		balances = append(balances, &pbtokenmeta.AccountBalance{
+			TokenContract: string(contract),
+			Account:       owner,
+			Amount:        count,
+			Symbol:        NFTSymbol,
+		})
+	}
+	return []*pbtokenmeta.Token{token}, balances, nil
+}
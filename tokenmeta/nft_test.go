@@ -0,0 +1,104 @@
+package tokenmeta
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNFTStandards_IsTokenContract(t *testing.T) {
+	simpleAssetsABI := `{
+		"structs": [
+			{"name": "sasset", "fields": [{"name": "id", "type": "uint64"}, {"name": "owner", "type": "name"}, {"name": "author", "type": "name"}, {"name": "category", "type": "name"}, {"name": "idata", "type": "string"}]}
+		],
+		"tables": [
+			{"name": "sassets", "type": "sasset"}
+		]
+	}`
+	atomicAssetsABI := `{
+		"structs": [
+			{"name": "assets_s", "fields": [{"name": "asset_id", "type": "uint64"}, {"name": "collection_name", "type": "name"}, {"name": "schema_name", "type": "name"}, {"name": "template_id", "type": "int32"}]}
+		],
+		"tables": [
+			{"name": "assets", "type": "assets_s"}
+		]
+	}`
+
+	tests := []struct {
+		name           string
+		abi            string
+		expectStandard TokenStandard
+	}{
+		{"simpleassets contract", simpleAssetsABI, &SimpleAssetsStandard{}},
+		{"simpleassets missing author", strings.Replace(simpleAssetsABI, `"name": "author"`, `"name": "creator"`, 1), nil},
+		{"atomicassets contract", atomicAssetsABI, &AtomicAssetsStandard{}},
+		{"atomicassets missing table", strings.Replace(atomicAssetsABI, `"name": "assets"`, `"name": "templates"`, 1), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			abi, err := decodeABI("nft", []byte(test.abi), true)
+			require.NoError(t, err)
+
+			if test.expectStandard == nil {
+				assert.Nil(t, tokenStandardForABI(abi))
+			} else {
+				assert.IsType(t, test.expectStandard, tokenStandardForABI(abi))
+			}
+		})
+	}
+}
+
+func TestNFTStandards_ProcessDBOp(t *testing.T) {
+	standard := &AtomicAssetsStandard{}
+	tokens := cache.NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "atomicassets", Symbol: NFTSymbol, Issuer: "atomicassets", TotalSupply: 2, Standard: "atomicassets"}},
+		[]*pbtokenmeta.AccountBalance{{TokenContract: "atomicassets", Account: "alice", Amount: 2, Symbol: NFTSymbol}},
+		nil, bstream.NewBlockRef("0000000aa", 10), "",
+	)
+	assetOp := func(operation pbcodec.DBOp_Operation, owner, assetID string) *pbcodec.DBOp {
+		return &pbcodec.DBOp{Operation: operation, Code: "atomicassets", TableName: "assets", Scope: owner, PrimaryKey: assetID}
+	}
+
+	muts := &cache.MutationsBatch{}
+	// alice transfers both her assets to bob, then mints a new one
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_REMOVE, "alice", "1"), nil))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_INSERT, "bob", "1"), nil))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_REMOVE, "alice", "2"), nil))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_INSERT, "bob", "2"), nil))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_UPDATE, "bob", "2"), nil))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_INSERT, "alice", "3"), nil))
+	assert.Error(t, standard.ProcessDBOp(muts, tokens, assetOp(pbcodec.DBOp_OPERATION_REMOVE, "carol", "4"), nil))
+
+	require.Empty(t, tokens.Apply(muts, bstream.NewBlockRef("0000000ba", 11)))
+
+	symbolCode, err := eos.StringToSymbolCode(NFTSymbol)
+	require.NoError(t, err)
+	token := tokens.TokenContract("atomicassets", symbolCode)
+	assert.Equal(t, uint64(3), token.TotalSupply)
+	assert.Equal(t, uint64(2), token.Holders)
+	assert.Equal(t, "atomicassets", token.Standard)
+
+	balances := map[eos.AccountName]int64{}
+	for _, asset := range tokens.TokenBalances("atomicassets") {
+		balances[asset.Owner] = int64(asset.Asset.Asset.Amount)
+	}
+	assert.Equal(t, map[eos.AccountName]int64{"alice": 1, "bob": 2}, balances)
+}
+
+func TestNFTStandards_ProcessDBOp_FirstAsset(t *testing.T) {
+	muts := &cache.MutationsBatch{}
+	require.NoError(t, (&SimpleAssetsStandard{}).ProcessDBOp(muts, cache.NewDefaultCache(""), &pbcodec.DBOp{Operation: pbcodec.DBOp_OPERATION_INSERT, Code: "simpleassets", TableName: "sassets", Scope: "alice", PrimaryKey: "1"}, nil))
+
+	mutations := muts.Mutations()
+	require.Len(t, mutations, 2)
+	assert.Equal(t, &pbtokenmeta.Token{Contract: "simpleassets", Symbol: NFTSymbol, Issuer: "simpleassets", TotalSupply: 1, Standard: "simpleassets"}, mutations[0].Args[0])
+	assert.Equal(t, &pbtokenmeta.AccountBalance{TokenContract: "simpleassets", Account: "alice", Amount: 1, Symbol: NFTSymbol}, mutations[1].Args[0])
+}
@@ -51,9 +51,14 @@ func (t *TokenMeta) SetupPipeline(startBlock bstream.BlockRef, blockFilter func(
 		return js
 	})
 
+	filterSteps := forkable.StepIrreversible
+	if t.reversibleCache != nil {
+		filterSteps = forkable.StepNew | forkable.StepUndo | forkable.StepRedo | forkable.StepIrreversible
+	}
+
 	forkOptions := []forkable.Option{
 		forkable.WithLogger(zlog),
-		forkable.WithFilters(forkable.StepIrreversible),
+		forkable.WithFilters(filterSteps),
 	}
 	if startBlock.ID() != "" {
 		forkOptions = append(forkOptions, forkable.WithExclusiveLIB(startBlock))
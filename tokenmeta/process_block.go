@@ -2,8 +2,10 @@ package tokenmeta
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/eoscanada/eos-go"
@@ -11,7 +13,18 @@ import (
 )
 
 func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
-	// forkable setup will only yield irreversible blocks
+	// forkable setup will only yield irreversible blocks, unless in head mode where new blocks are applied
+	// and undone on forks
+	if t.reversibleCache != nil {
+		switch obj.(*forkable.ForkableObject).Step {
+		case forkable.StepUndo:
+			return t.undoBlock(block)
+		case forkable.StepIrreversible:
+			t.irreversibleBlock(block)
+			return nil
+		}
+	}
+
 	muts := &cache.MutationsBatch{}
 	blk := block.ToNative().(*pbcodec.Block)
 
@@ -56,7 +69,7 @@ func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
 					continue
 				}
 
-				contractStats, err := getTokenContractStats(string(account), abiData, false)
+				abi, err := decodeABI(string(account), abiData, false)
 				if err != nil {
 					zlogger.Info("failed to get token contract info",
 						zap.String("account", account),
@@ -66,13 +79,13 @@ func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
 					continue
 				}
 
-				if contractStats.isTokenContract {
+				if standard := tokenStandardForABI(abi); standard != nil {
 					if t.cache.IsTokenContract(eos.AN(account)) {
 						zlogger.Info("skipping already known token contract", zap.String("account", account))
 						continue
 					}
 
-					zlogger.Info("adding new token contract", zap.String("account", account))
+					zlogger.Info("adding new token contract", zap.String("account", account), zap.String("standard", standard.Name()))
 					mutations := &cache.MutationsBatch{}
 					mutations.SetContract(eos.AccountName(account))
 					errs := t.cache.Apply(mutations, blk)
@@ -99,24 +112,23 @@ func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
 				zap.String("primary_key", dbop.PrimaryKey),
 			)
 
-			isEOSStake := dbop.Code == "eosio" && dbop.TableName == string(EOSStakeTable)
-
 			tokenContract := eos.AccountName(dbop.Code)
-			if !t.cache.IsTokenContract(tokenContract) && !isEOSStake {
+			if !t.cache.IsTokenContract(tokenContract) {
 				continue
 			}
 
-			symbolCode, err := eos.NameToSymbolCode(eos.Name(dbop.PrimaryKey))
+			standard, err := t.getTokenStandard(tokenContract, uint32(block.Number))
 			if err != nil {
-				zlogger.Warn("unable to decode primary key to symbol",
+				zlogger.Error("cannot get token standard",
 					zap.String("contract", string(tokenContract)),
-					zap.String("table", dbop.TableName),
-					zap.String("scope", dbop.Scope),
-					zap.String("primary_key", dbop.PrimaryKey),
 					zap.Error(err))
 				continue
 			}
 
+			if !standard.IsStandardTable(eos.TableName(dbop.TableName)) {
+				continue
+			}
+
 			rowData := dbop.NewData
 			if rowData == nil {
 				zlogger.Debug("using db row old data")
@@ -132,72 +144,15 @@ func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
 				continue
 			}
 
-			switch dbop.TableName {
-			case string(EOSStakeTable):
-				if !isEOSStake {
-					zlogger.Error("something terribly wrong happened: table eosio stake but not eosio stake",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()))
-					continue
-				}
-				eosStakeEntry, err := getStakeEntryFromDBRow(tokenContract, dbop.Scope, row)
-				if err != nil {
-					zlogger.Error("cannot apply stake entry",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()),
-						zap.Error(err))
-					continue
-				}
-				muts.SetStake(eosStakeEntry)
-			case string(AccountsTable):
-
-				eosToken := t.cache.TokenContract(tokenContract, symbolCode)
-				if eosToken == nil {
-					zlogger.Warn("unsupported token for contract",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()))
-					continue
-				}
-
-				accountBalance, err := getAccountBalanceFromDBRow(tokenContract, TokenToEOSSymbol(eosToken), dbop.Scope, row)
-				if err != nil {
-					zlogger.Warn("could not create account balance from dbop row",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()),
-						zap.String("scope", dbop.Scope),
-						zap.String("dbop_row", string(row)))
-					continue
-				}
-
-				if dbop.NewData == nil {
-					// if the db operation has no new data so it removed it
-					muts.RemoveBalance(accountBalance)
-				} else {
-					muts.SetBalance(accountBalance)
-				}
-			case string(StatTable):
-				var symbol *eos.Symbol
-				eosToken := t.cache.TokenContract(tokenContract, symbolCode)
-				if eosToken == nil {
-					zlogger.Debug("new token contract",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()),
-					)
-				} else {
-					symbol = TokenToEOSSymbol(eosToken)
-				}
-
-				token, err := getTokenFromDBRow(tokenContract, symbol, row)
-				if err != nil {
-					zlogger.Warn("could not create token from dbop row",
-						zap.String("token_contract", string(tokenContract)),
-						zap.String("symbol", symbolCode.String()),
-						zap.String("scope", dbop.Scope),
-						zap.String("dbop_row", string(row)))
-					continue
-
-				}
-				muts.SetToken(token)
+			if err := standard.ProcessDBOp(muts, t.cache, dbop, row); err != nil {
+				zlogger.Warn("could not process token contract db operation",
+					zap.String("token_contract", string(tokenContract)),
+					zap.String("standard", standard.Name()),
+					zap.String("table", dbop.TableName),
+					zap.String("scope", dbop.Scope),
+					zap.String("primary_key", dbop.PrimaryKey),
+					zap.String("dbop_row", string(row)),
+					zap.Error(err))
 			}
 		}
 	}
@@ -210,9 +165,69 @@ func (t *TokenMeta) ProcessBlock(block *bstream.Block, obj interface{}) error {
 			zap.Errors("errors", errs),
 		)
 	}
+	if t.changesHub != nil {
+		changes := balanceChangesFromMutations(muts.Mutations(), block, t.cache)
+		if t.reversibleCache != nil {
+			// only streamed once irreversible
+			t.reversibleChanges[block.ID()] = changes
+		} else {
+			t.changesHub.Publish(block, changes)
+		}
+	}
+	if t.activity != nil && t.reversibleCache == nil {
+		t.recordActivity(block, blk)
+	}
 	if t.saveEveryNBlock != 0 && blk.Number%t.saveEveryNBlock == 0 {
 		// TODO Should this be done async? if so we would need to add locks
 		t.cache.SaveToFile()
+		t.saveActivity()
+	}
+	return nil
+}
+
+func (t *TokenMeta) undoBlock(block *bstream.Block) error {
+	zlog.Debug("undoing block", zap.Stringer("block", block))
+	delete(t.reversibleChanges, block.ID())
+
+	if err := t.reversibleCache.Undo(block); err != nil {
+		return fmt.Errorf("unable to undo block %s: %w", block, err)
 	}
 	return nil
 }
+
+func (t *TokenMeta) irreversibleBlock(block *bstream.Block) {
+	t.reversibleCache.MarkIrreversible(block)
+	if t.activity != nil {
+		t.recordActivity(block, block.ToNative().(*pbcodec.Block))
+	}
+
+	if changes, ok := t.reversibleChanges[block.ID()]; ok {
+		delete(t.reversibleChanges, block.ID())
+		t.changesHub.Publish(block, changes)
+	}
+}
+
+// recordActivity aggregates the token statistics of an irreversible block, it must be called after
+// the block was applied to the cache so tokens created by it are known
+func (t *TokenMeta) recordActivity(block bstream.BlockRef, blk *pbcodec.Block) {
+	// the actions are decoded with the `eosio.token` layouts, which wrapped tokens share, the other
+	// standards have their own
+	isEOSIOTokenContract := func(contract eos.AccountName) bool {
+		if !t.cache.IsTokenContract(contract) {
+			return false
+		}
+
+		standard, err := t.getTokenStandard(contract, blk.Number)
+		if err != nil {
+			return false
+		}
+		switch standard.(type) {
+		case *EOSIOTokenStandard, *WrappedTokenStandard:
+			return true
+		}
+		return false
+	}
+
+	transfers, supplyChanges := tokenActivityFromBlock(blk, isEOSIOTokenContract)
+	t.activity.Record(block, blk.MustTime(), transfers, supplyChanges)
+}
@@ -2,10 +2,12 @@ package tokenmeta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
 
+	"github.com/dfuse-io/bstream"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
 	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/dfuse-io/dgrpc"
@@ -14,6 +16,8 @@ import (
 	"github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
@@ -21,14 +25,25 @@ type Server struct {
 
 	grpcServer          *grpc.Server
 	cache               cache.Cache
+	history             cache.History
+	reversible          cache.Reversible
+	changesHub          *BalanceChangesHub
+	activity            *TokenActivity
 	readinessMaxLatency time.Duration
 }
 
-func NewServer(cache cache.Cache, readinessMaxLatency time.Duration) *Server {
+// NewServer serves the tokenmeta cache, `history` is optional and only used to answer requests
+// with an `at_block_num`, `reversible` is only set in head mode to answer `irreversible_only`
+// requests, `changesHub` feeds the balance changes streams and `activity` the token statistics
+func NewServer(cache cache.Cache, history cache.History, reversible cache.Reversible, changesHub *BalanceChangesHub, activity *TokenActivity, readinessMaxLatency time.Duration) *Server {
 	s := &Server{
 		readinessMaxLatency: readinessMaxLatency,
 		Shutter:             shutter.New(),
 		cache:               cache,
+		history:             history,
+		reversible:          reversible,
+		changesHub:          changesHub,
+		activity:            activity,
 		grpcServer:          dgrpc.NewServer(dgrpc.WithLogger(zlog)),
 	}
 
@@ -90,10 +105,17 @@ func (s *Server) GetTokens(ctx context.Context, in *pbtokenmeta.GetTokensRequest
 		zap.Uint32("limit", in.Limit),
 		zap.String("order", in.SortOrder.String()),
 		zap.String("filed", in.SortField.String()),
+		zap.Uint64("at_block_num", in.AtBlockNum),
+		zap.Bool("irreversible_only", in.IrreversibleOnly),
 	)
 
+	allTokens, blockRef, err := s.tokensAt(ctx, in.AtBlockNum, in.IrreversibleOnly)
+	if err != nil {
+		return nil, err
+	}
+
 	tokens := []*pbtokenmeta.Token{}
-	for _, t := range s.cache.Tokens() {
+	for _, t := range allTokens {
 		if matchFilters(eos.AccountName(t.Contract), t.Symbol, in.FilterTokenContracts, in.FilterTokenSymbols) {
 			tokens = append(tokens, t)
 		}
@@ -101,7 +123,6 @@ func (s *Server) GetTokens(ctx context.Context, in *pbtokenmeta.GetTokensRequest
 
 	tokens = sortGetTokens(tokens, in.SortField, in.SortOrder)
 	tokens = limitTokenResults(tokens, in.Limit)
-	blockRef := s.cache.AtBlockRef()
 
 	out := &pbtokenmeta.TokensResponse{
 		Tokens:     []*pbtokenmeta.Token{},
@@ -123,6 +144,8 @@ func (s *Server) GetAccountBalances(ctx context.Context, in *pbtokenmeta.GetAcco
 		zap.Any("options", in.Options),
 		zap.String("order", in.SortOrder.String()),
 		zap.String("account_holder", in.Account),
+		zap.Uint64("at_block_num", in.AtBlockNum),
+		zap.Bool("irreversible_only", in.IrreversibleOnly),
 	)
 
 	options := []cache.AccountBalanceOption{}
@@ -130,15 +153,19 @@ func (s *Server) GetAccountBalances(ctx context.Context, in *pbtokenmeta.GetAcco
 		options = append(options, cache.EOSIncludeStakedAccOpt)
 	}
 
+	accountBalances, blockRef, err := s.accountBalancesAt(ctx, eos.AccountName(in.Account), in.AtBlockNum, in.IrreversibleOnly, options)
+	if err != nil {
+		return nil, err
+	}
+
 	assets := []*cache.OwnedAsset{}
-	for _, a := range s.cache.AccountBalances(eos.AccountName(in.Account), options...) {
+	for _, a := range accountBalances {
 		if matchFilters(a.Asset.Contract, a.Asset.Asset.Symbol.Symbol, in.FilterTokenContracts, in.FilterTokenSymbols) {
 			assets = append(assets, a)
 		}
 	}
 	assets = sortAccountBalances(assets, in.SortField, in.SortOrder)
 	assets = limitAssetsResults(assets, in.Limit)
-	blockRef := s.cache.AtBlockRef()
 
 	out := &pbtokenmeta.AccountBalancesResponse{
 		Balances:   []*pbtokenmeta.AccountBalance{},
@@ -147,7 +174,7 @@ func (s *Server) GetAccountBalances(ctx context.Context, in *pbtokenmeta.GetAcco
 	}
 
 	for _, a := range assets {
-		out.Balances = append(out.Balances, cache.AssetToProtoAccountBalance(a))
+		out.Balances = append(out.Balances, s.toProtoAccountBalance(a, in.IrreversibleOnly))
 	}
 
 	return out, nil
@@ -178,14 +205,21 @@ func (s *Server) GetTokenBalances(ctx context.Context, in *pbtokenmeta.GetTokenB
 		zap.Uint32("limit", in.Limit),
 		zap.String("order", in.SortOrder.String()),
 		zap.String("token_contract", in.TokenContract),
+		zap.Uint64("at_block_num", in.AtBlockNum),
+		zap.Bool("irreversible_only", in.IrreversibleOnly),
 	)
 
 	options := []cache.TokenBalanceOption{}
 	if hasTokenOption(in.Options, pbtokenmeta.GetTokenBalancesRequest_EOS_INCLUDE_STAKED) {
 		options = append(options, cache.EOSIncludeStakedTokOpt)
 	}
+	tokenBalances, blockRef, err := s.tokenBalancesAt(ctx, eos.AccountName(in.TokenContract), in.AtBlockNum, in.IrreversibleOnly, options)
+	if err != nil {
+		return nil, err
+	}
+
 	assets := []*cache.OwnedAsset{}
-	for _, a := range s.cache.TokenBalances(eos.AccountName(in.TokenContract), options...) {
+	for _, a := range tokenBalances {
 		if matchFilters(a.Asset.Contract, a.Asset.Asset.Symbol.Symbol, []string{}, in.FilterTokenSymbols) {
 			if stringInFilter(string(a.Owner), in.FilterHolderAccounts) {
 				assets = append(assets, a)
@@ -195,7 +229,6 @@ func (s *Server) GetTokenBalances(ctx context.Context, in *pbtokenmeta.GetTokenB
 	assets = sortTokenBalances(assets, in.SortField, in.SortOrder)
 	// Limit by token? the full list
 	assets = limitAssetsResults(assets, in.Limit)
-	blockRef := s.cache.AtBlockRef()
 
 	out := &pbtokenmeta.TokenBalancesResponse{
 		Tokens:     []*pbtokenmeta.TokenContractBalancesResponse{},
@@ -206,7 +239,7 @@ func (s *Server) GetTokenBalances(ctx context.Context, in *pbtokenmeta.GetTokenB
 	symbolIndex := map[string]int{}
 	for _, a := range assets {
 		if index, ok := symbolIndex[a.Asset.Asset.Symbol.Symbol]; ok {
-			out.Tokens[index].Balances = append(out.Tokens[index].Balances, cache.AssetToProtoAccountBalance(a))
+			out.Tokens[index].Balances = append(out.Tokens[index].Balances, s.toProtoAccountBalance(a, in.IrreversibleOnly))
 		} else {
 			out.Tokens = append(out.Tokens, &pbtokenmeta.TokenContractBalancesResponse{
 				Token: &pbtokenmeta.Token{
@@ -214,7 +247,7 @@ func (s *Server) GetTokenBalances(ctx context.Context, in *pbtokenmeta.GetTokenB
 					Symbol:    a.Asset.Asset.Symbol.Symbol,
 					Precision: uint32(a.Asset.Asset.Symbol.Precision),
 				},
-				Balances: []*pbtokenmeta.AccountBalance{cache.AssetToProtoAccountBalance(a)},
+				Balances: []*pbtokenmeta.AccountBalance{s.toProtoAccountBalance(a, in.IrreversibleOnly)},
 			})
 			symbolIndex[a.Asset.Asset.Symbol.Symbol] = len(out.Tokens) - 1
 		}
@@ -222,6 +255,197 @@ func (s *Server) GetTokenBalances(ctx context.Context, in *pbtokenmeta.GetTokenB
 	return out, nil
 }
 
+func (s *Server) StreamBalanceChanges(in *pbtokenmeta.StreamBalanceChangesRequest, stream pbtokenmeta.TokenMeta_StreamBalanceChangesServer) error {
+	zlog.Debug("stream balance changes",
+		zap.Strings("filter_accounts", in.FilterAccounts),
+		zap.Strings("filter_token_contracts", in.FilterTokenContracts),
+		zap.Strings("filter_token_symbols", in.FilterTokenSymbols),
+		zap.Bool("with_cursor", in.Cursor != nil),
+	)
+
+	if s.changesHub == nil {
+		return status.Error(codes.Unimplemented, "balance changes are not streamed by this tokenmeta instance")
+	}
+
+	sub, replay, err := s.changesHub.subscribe(in.Cursor)
+	if err != nil {
+		if errors.Is(err, errCursorTooOld) {
+			return status.Errorf(codes.OutOfRange, "cannot resume from cursor: %s", err)
+		}
+		return status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err)
+	}
+	defer s.changesHub.unsubscribe(sub)
+
+	filter := newBalanceChangeFilter(in)
+	send := func(changes []*pbtokenmeta.BalanceChange) error {
+		for _, change := range changes {
+			if !filter.match(change) {
+				continue
+			}
+
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := send(replay); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case blockChanges, ok := <-sub.blocks:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "balance changes stream interrupted: %s", sub.err)
+			}
+
+			if err := send(blockChanges.changes); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) GetTokenStats(ctx context.Context, in *pbtokenmeta.GetTokenStatsRequest) (*pbtokenmeta.TokenStats, error) {
+	zlog.Debug("get token stats",
+		zap.String("token_contract", in.TokenContract),
+		zap.String("symbol", in.Symbol),
+		zap.Uint32("top_holders_limit", in.TopHoldersLimit),
+	)
+
+	if s.activity == nil {
+		return nil, status.Error(codes.Unimplemented, "token statistics are not aggregated by this tokenmeta instance")
+	}
+
+	symbolCode, err := eos.StringToSymbolCode(in.Symbol)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid symbol %q: %s", in.Symbol, err)
+	}
+
+	contract := eos.AccountName(in.TokenContract)
+	token := s.cache.TokenContract(contract, symbolCode)
+	if token == nil {
+		return nil, status.Errorf(codes.NotFound, "token %s in contract %s not found", in.Symbol, in.TokenContract)
+	}
+
+	out, sinceBlock, atBlock := s.activity.Stats(contract, in.Symbol)
+	out.Token = token
+	if sinceBlock != nil {
+		out.SinceBlockNum = sinceBlock.Num()
+		out.AtBlockNum = atBlock.Num()
+		out.AtBlockId = atBlock.ID()
+	}
+
+	limit := in.TopHoldersLimit
+	if limit == 0 {
+		limit = 10
+	}
+
+	// the statistics only cover irreversible blocks, so do the holders
+	balances, _, err := s.tokenBalancesAt(ctx, contract, 0, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var holders []*cache.OwnedAsset
+	for _, balance := range balances {
+		if balance.Asset.Asset.Symbol.Symbol == in.Symbol {
+			holders = append(holders, balance)
+		}
+	}
+
+	for _, holder := range limitAssetsResults(cache.SortOwnedAssetByTokenAmount(holders, cache.DESC), limit) {
+		topHolder := &pbtokenmeta.TokenHolder{Balance: s.toProtoAccountBalance(holder, true)}
+		if token.TotalSupply != 0 {
+			topHolder.SupplyPercent = float64(holder.Asset.Asset.Amount) / float64(token.TotalSupply) * 100
+		}
+		out.TopHolders = append(out.TopHolders, topHolder)
+	}
+
+	return out, nil
+}
+
+func (s *Server) tokensAt(ctx context.Context, blockNum uint64, irreversibleOnly bool) ([]*pbtokenmeta.Token, bstream.BlockRef, error) {
+	if blockNum == 0 {
+		if irreversibleOnly && s.reversible != nil {
+			return s.reversible.IrreversibleTokens(), s.reversible.IrreversibleBlockRef(), nil
+		}
+		return s.cache.Tokens(), s.cache.AtBlockRef(), nil
+	}
+
+	if s.history == nil {
+		return nil, nil, errHistoryDisabled
+	}
+
+	tokens, blockRef, err := s.history.TokensAt(ctx, blockNum)
+	if err != nil {
+		return nil, nil, historyErrorToStatus(err)
+	}
+	return tokens, blockRef, nil
+}
+
+func (s *Server) accountBalancesAt(ctx context.Context, account eos.AccountName, blockNum uint64, irreversibleOnly bool, options []cache.AccountBalanceOption) ([]*cache.OwnedAsset, bstream.BlockRef, error) {
+	if blockNum == 0 {
+		if irreversibleOnly && s.reversible != nil {
+			return s.reversible.IrreversibleAccountBalances(account, options...), s.reversible.IrreversibleBlockRef(), nil
+		}
+		return s.cache.AccountBalances(account, options...), s.cache.AtBlockRef(), nil
+	}
+
+	if s.history == nil {
+		return nil, nil, errHistoryDisabled
+	}
+
+	assets, blockRef, err := s.history.AccountBalancesAt(ctx, account, blockNum, options...)
+	if err != nil {
+		return nil, nil, historyErrorToStatus(err)
+	}
+	return assets, blockRef, nil
+}
+
+func (s *Server) tokenBalancesAt(ctx context.Context, contract eos.AccountName, blockNum uint64, irreversibleOnly bool, options []cache.TokenBalanceOption) ([]*cache.OwnedAsset, bstream.BlockRef, error) {
+	if blockNum == 0 {
+		if irreversibleOnly && s.reversible != nil {
+			return s.reversible.IrreversibleTokenBalances(contract, options...), s.reversible.IrreversibleBlockRef(), nil
+		}
+		return s.cache.TokenBalances(contract, options...), s.cache.AtBlockRef(), nil
+	}
+
+	if s.history == nil {
+		return nil, nil, errHistoryDisabled
+	}
+
+	assets, blockRef, err := s.history.TokenBalancesAt(ctx, contract, blockNum, options...)
+	if err != nil {
+		return nil, nil, historyErrorToStatus(err)
+	}
+	return assets, blockRef, nil
+}
+
+// toProtoAccountBalance flags, in head mode, the balances changed by blocks that can still be forked out
+func (s *Server) toProtoAccountBalance(asset *cache.OwnedAsset, irreversibleOnly bool) *pbtokenmeta.AccountBalance {
+	balance := cache.AssetToProtoAccountBalance(asset)
+	if s.reversible != nil && !irreversibleOnly {
+		balance.Reversible = s.reversible.IsReversible(asset)
+	}
+	return balance
+}
+
+var errHistoryDisabled = status.Error(codes.FailedPrecondition, "at_block_num is not supported, this tokenmeta instance does not record history")
+
+func historyErrorToStatus(err error) error {
+	if errors.Is(err, cache.ErrBlockNotInHistory) {
+		return status.Errorf(codes.OutOfRange, "%s, only blocks processed while recording history can be queried", err)
+	}
+
+	zlog.Error("unable to read tokenmeta history", zap.Error(err))
+	return status.Errorf(codes.Internal, "unable to read history: %s", err)
+}
+
 func matchFilters(contract eos.AccountName, symbol string, contractFilter []string, symbolFilter []string) bool {
 	if !stringInFilter(symbol, symbolFilter) {
 		return false
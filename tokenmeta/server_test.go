@@ -1,12 +1,18 @@
 package tokenmeta
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/dfuse-io/bstream"
 	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
 	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
 	"github.com/eoscanada/eos-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_tokenMatchFilters(t *testing.T) {
@@ -216,3 +222,87 @@ func generateTestAsset(amount eos.Int64, symbol string) eos.Asset {
 		Symbol: *generateTestSymbol(symbol),
 	}
 }
+
+func TestServer_AtBlockNumWithoutHistory(t *testing.T) {
+	server := NewServer(cache.NewDefaultCache(""), nil, nil, nil, nil, 0)
+
+	_, err := server.GetTokens(context.Background(), &pbtokenmeta.GetTokensRequest{AtBlockNum: 10})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.GetAccountBalances(context.Background(), &pbtokenmeta.GetAccountBalancesRequest{Account: "eoscanadadad", AtBlockNum: 10})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.GetTokenBalances(context.Background(), &pbtokenmeta.GetTokenBalancesRequest{TokenContract: "eosio.token", AtBlockNum: 10})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_IrreversibleOnly(t *testing.T) {
+	ctx := context.Background()
+	balance := func(account string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: "EOS"}
+	}
+
+	reversibleCache := cache.NewReversibleCache(cache.NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4}},
+		[]*pbtokenmeta.AccountBalance{balance("alice", 100), balance("bob", 50)},
+		nil,
+		bstream.NewBlockRef("0000000aa", 10),
+		"",
+	))
+	muts := &cache.MutationsBatch{}
+	muts.SetBalance(balance("alice", 80))
+	require.Len(t, reversibleCache.Apply(muts, bstream.NewBlockRef("0000000ba", 11)), 0)
+
+	server := NewServer(reversibleCache, nil, reversibleCache, nil, nil, 0)
+	balances := func(irreversibleOnly bool) (out []string) {
+		resp, err := server.GetTokenBalances(ctx, &pbtokenmeta.GetTokenBalancesRequest{TokenContract: "eosio.token", SortField: pbtokenmeta.GetTokenBalancesRequest_ALPHA, IrreversibleOnly: irreversibleOnly})
+		require.NoError(t, err)
+		for _, b := range resp.Tokens[0].Balances {
+			out = append(out, fmt.Sprintf("%s %d %t @ %d", b.Account, b.Amount, b.Reversible, resp.AtBlockNum))
+		}
+		return
+	}
+
+	assert.Equal(t, []string{"alice 80 true @ 11", "bob 50 false @ 11"}, balances(false))
+	assert.Equal(t, []string{"alice 100 false @ 10", "bob 50 false @ 10"}, balances(true))
+}
+
+func TestServer_GetTokenStats(t *testing.T) {
+	ctx := context.Background()
+	balance := func(account string, symbol string, amount uint64) *pbtokenmeta.AccountBalance {
+		return &pbtokenmeta.AccountBalance{TokenContract: "eosio.token", Account: account, Amount: amount, Precision: 4, Symbol: symbol}
+	}
+
+	tokenCache := cache.NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4, TotalSupply: 1000}, {Contract: "eosio.token", Symbol: "WAX", Precision: 4, TotalSupply: 1000}},
+		[]*pbtokenmeta.AccountBalance{balance("alice", "EOS", 100), balance("bob", "EOS", 500), balance("carol", "EOS", 400), balance("dave", "WAX", 1000)},
+		nil,
+		bstream.NewBlockRef("0000000aa", 10),
+		"",
+	)
+
+	_, err := NewServer(tokenCache, nil, nil, nil, nil, 0).GetTokenStats(ctx, &pbtokenmeta.GetTokenStatsRequest{TokenContract: "eosio.token", Symbol: "EOS"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	server := NewServer(tokenCache, nil, nil, nil, NewTokenActivity(), 0)
+	_, err = server.GetTokenStats(ctx, &pbtokenmeta.GetTokenStatsRequest{TokenContract: "eosio.token", Symbol: "ABC"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stats, err := server.GetTokenStats(ctx, &pbtokenmeta.GetTokenStatsRequest{TokenContract: "eosio.token", Symbol: "EOS", TopHoldersLimit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "EOS", stats.Token.Symbol)
+	assert.Equal(t, uint64(0), stats.SinceBlockNum)
+	assert.False(t, stats.Transfers_24H.Complete)
+
+	var holders []string
+	for _, holder := range stats.TopHolders {
+		holders = append(holders, fmt.Sprintf("%s %d %.0f%%", holder.Balance.Account, holder.Balance.Amount, holder.SupplyPercent))
+	}
+	assert.Equal(t, []string{"bob 500 50%", "carol 400 40%"}, holders)
+}
+
+func Test_historyErrorToStatus(t *testing.T) {
+	assert.Equal(t, codes.OutOfRange, status.Code(historyErrorToStatus(fmt.Errorf("block #10: %w", cache.ErrBlockNotInHistory))))
+	assert.Equal(t, codes.Internal, status.Code(historyErrorToStatus(fmt.Errorf("unable to read"))))
+}
@@ -0,0 +1,121 @@
+package tokenmeta
+
+import (
+	"context"
+	"encoding/json"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+)
+
+// TokenStandard recognizes the contracts implementing a token standard and turns the rows of their
+// tables into the tokens and balances tracked by tokenmeta, see `EOSIOTokenStandard`, `WrappedTokenStandard`,
+// `SimpleAssetsStandard`, `AtomicAssetsStandard` and `EOSIOSystemStandard`
+type TokenStandard interface {
+	// Name identifies the standard, it is set as the `standard` of the tokens it produces
+	Name() string
+
+	// IsTokenContract returns whether a contract with this ABI implements the standard
+	IsTokenContract(abi *eos.ABI) bool
+
+	// IsStandardTable returns whether the rows of this table hold tokens or balances, only those
+	// are decoded and passed to `ProcessDBOp`
+	IsStandardTable(table eos.TableName) bool
+
+	// ProcessDBOp adds to `muts` the token and balance changes of a database operation on one of the
+	// standard's tables, `row` is the decoded new row or the old one when the row was removed
+	ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error
+
+	// Bootstrap reads from statedb the tokens and balances of a contract as of `startBlockNum`
+	Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) ([]*pbtokenmeta.Token, []*pbtokenmeta.AccountBalance, error)
+}
+
+// StakingStandard is implemented by the standards also tracking the EOS staked by accounts, which
+// `cache.EOSIncludeStakedAccOpt` and `cache.EOSIncludeStakedTokOpt` add to their `eosio.token` balance
+type StakingStandard interface {
+	TokenStandard
+
+	// BootstrapStakes reads from statedb the stakes held by a contract as of `startBlockNum`
+	BootstrapStakes(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) ([]*cache.EOSStakeEntry, error)
+}
+
+var tokenStandards = []TokenStandard{
+	// wrapped tokens have the tables of `eosio.token`, so they must be tried first
+	&WrappedTokenStandard{},
+	&EOSIOTokenStandard{},
+	&SimpleAssetsStandard{},
+	&AtomicAssetsStandard{},
+	&EOSIOSystemStandard{},
+}
+
+// RegisterTokenStandard adds a standard to detect token contracts with, it must be called before
+// tokenmeta is bootstrapped. Standards are tried in order, the first one matching a contract's ABI
+// is used for it, the standards shipped with tokenmeta are always tried first.
+func RegisterTokenStandard(standard TokenStandard) {
+	tokenStandards = append(tokenStandards, standard)
+}
+
+// tokenStandardForABI returns `nil` when the contract does not implement any registered standard
+func tokenStandardForABI(abi *eos.ABI) TokenStandard {
+	for _, standard := range tokenStandards {
+		if standard.IsTokenContract(abi) {
+			return standard
+		}
+	}
+	return nil
+}
+
+func isStandardTable(table eos.TableName) bool {
+	for _, standard := range tokenStandards {
+		if standard.IsStandardTable(table) {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingToken returns a token as set by the latest mutation of `muts` or, when the batch does not
+// change it, as known by the cache, which only sees the batch once the whole block is processed
+func pendingToken(muts *cache.MutationsBatch, tokens cache.Cache, contract eos.AccountName, symbol eos.SymbolCode) *pbtokenmeta.Token {
+	mutations := muts.Mutations()
+	for i := len(mutations) - 1; i >= 0; i-- {
+		if mutations[i].Type != cache.SetTokenMutation {
+			continue
+		}
+
+		token := mutations[i].Args[0].(*pbtokenmeta.Token)
+		if token.Contract == string(contract) && token.Symbol == symbol.String() {
+			return token
+		}
+	}
+	return tokens.TokenContract(contract, symbol)
+}
+
+// pendingBalance returns the balance of an account like `pendingToken` returns a token, and whether
+// the account holds the token at all
+func pendingBalance(muts *cache.MutationsBatch, tokens cache.Cache, contract eos.AccountName, symbol eos.SymbolCode, account eos.AccountName) (amount uint64, found bool) {
+	mutations := muts.Mutations()
+	for i := len(mutations) - 1; i >= 0; i-- {
+		if mutations[i].Type != cache.SetBalanceMutation && mutations[i].Type != cache.RemoveBalanceMutation {
+			continue
+		}
+
+		balance := mutations[i].Args[0].(*pbtokenmeta.AccountBalance)
+		if balance.TokenContract == string(contract) && balance.Symbol == symbol.String() && balance.Account == string(account) {
+			if mutations[i].Type == cache.RemoveBalanceMutation {
+				return 0, false
+			}
+			return balance.Amount, true
+		}
+	}
+
+	for _, asset := range tokens.AccountBalances(account) {
+		if asset.Asset.Contract == contract && asset.Asset.Asset.Symbol.Symbol == symbol.String() {
+			return uint64(asset.Asset.Asset.Amount), true
+		}
+	}
+	return 0, false
+}
@@ -196,12 +196,12 @@ func getTokensFromStateDB(ctx context.Context, stateClient pbstatedb.StateClient
 	return out, nil
 }
 
-func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateClient, startBlockNum uint32) (out []*cache.EOSStakeEntry, err error) {
-	zlog.Debug("getting EOSStaked token", zap.Uint32("start_block_num", startBlockNum))
+func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) (out []*cache.EOSStakeEntry, err error) {
+	zlog.Debug("getting EOSStaked token", zap.String("contract", string(contract)), zap.Uint32("start_block_num", startBlockNum))
 
-	tableScopes, err := pbstatedb.FetchTableScopes(ctx, stateClient, uint64(startBlockNum), "eosio", "delband")
+	tableScopes, err := pbstatedb.FetchTableScopes(ctx, stateClient, uint64(startBlockNum), string(contract), string(EOSStakeTable))
 	if err != nil {
-		zlog.Info("cannot get table scope", zap.String("account", "eosio"), zap.String("table", "delband"), zap.Error(err))
+		zlog.Info("cannot get table scope", zap.String("account", string(contract)), zap.String("table", string(EOSStakeTable)), zap.Error(err))
 		return nil, err
 	}
 
@@ -209,8 +209,8 @@ func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateCli
 		//zlog.Debug("batching scope stakes for delband", zap.Int("len", len(inScopes)))
 		getBalancesReq := &pbstatedb.StreamMultiScopesTableRowsRequest{
 			BlockNum: uint64(startBlockNum),
-			Contract: "eosio",
-			Table:    "delband",
+			Contract: string(contract),
+			Table:    string(EOSStakeTable),
 			KeyType:  "name",
 			ToJson:   true,
 		}
@@ -226,8 +226,8 @@ func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateCli
 			err = json.Unmarshal([]byte(response.Json), &row)
 			if err != nil {
 				zlog.Warn("unable to decode stake rows",
-					zap.String("contract", "eosio"),
-					zap.String("table", "delband"),
+					zap.String("contract", string(contract)),
+					zap.String("table", string(EOSStakeTable)),
 					zap.String("scope", scope),
 				)
 				return pbstatedb.SkipTable
@@ -256,7 +256,7 @@ func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateCli
 		return out, nil
 	})
 
-	zlog.Info("starting dhammer", zap.String("account", "eosio"), zap.String("table", "delband"), zap.Int("scope_count", len(tableScopes)))
+	zlog.Info("starting dhammer", zap.String("account", string(contract)), zap.String("table", string(EOSStakeTable)), zap.Int("scope_count", len(tableScopes)))
 	ham.Start(ctx)
 
 	// scopes -> hammer
@@ -273,7 +273,7 @@ func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateCli
 		select {
 		case v, ok := <-ham.Out:
 			if !ok {
-				zlog.Info("get eos stakes finished", zap.String("account", "eosio"), zap.String("table", "delband"), zap.Int("stakes", len(out)), zap.Int("scope_count", len(tableScopes)))
+				zlog.Info("get eos stakes finished", zap.String("account", string(contract)), zap.String("table", string(EOSStakeTable)), zap.Int("stakes", len(out)), zap.Int("scope_count", len(tableScopes)))
 				if ham.Err() != nil && ham.Err() != context.Canceled {
 					zlog.Error("hammer error", zap.Error(ham.Err()))
 					return nil, ham.Err()
@@ -286,3 +286,85 @@ func getEOSStakedFromStateDB(ctx context.Context, stateClient pbstatedb.StateCli
 		}
 	}
 }
+
+type scopeRowCount struct {
+	scope string
+	count uint64
+}
+
+// getRowCountsFromStateDB returns the number of rows of each scope of a table
+func getRowCountsFromStateDB(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, table eos.TableName, startBlockNum uint32) (out map[string]uint64, err error) {
+	zlog.Debug("counting table rows from statedb",
+		zap.String("contract", string(contract)),
+		zap.String("table", string(table)),
+		zap.Uint32("start_block_num", startBlockNum),
+	)
+	tableScopes, err := pbstatedb.FetchTableScopes(ctx, stateClient, uint64(startBlockNum), string(contract), string(table))
+	if err != nil {
+		zlog.Info("cannot get table scope", zap.String("account", string(contract)), zap.String("table", string(table)), zap.Error(err))
+		return nil, err
+	}
+
+	ham := dhammer.NewHammer(1500, 2, func(ctx context.Context, inScopes []interface{}) ([]interface{}, error) {
+		getRowsReq := &pbstatedb.StreamMultiScopesTableRowsRequest{
+			BlockNum: uint64(startBlockNum),
+			Contract: string(contract),
+			Table:    string(table),
+		}
+
+		getRowsReq.Scopes = make([]string, len(inScopes))
+		for i, scope := range inScopes {
+			getRowsReq.Scopes[i] = scope.(string)
+		}
+
+		counts := map[string]uint64{}
+		_, err := pbstatedb.ForEachMultiScopesTableRows(ctx, stateClient, getRowsReq, func(scope string, response *pbstatedb.TableRowResponse) error {
+			counts[scope]++
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to stream multi scopes table rows: %w", err)
+		}
+
+		var out []interface{}
+		for scope, count := range counts {
+			out = append(out, &scopeRowCount{scope: scope, count: count})
+		}
+		return out, nil
+	})
+
+	zlog.Info("starting dhammer", zap.String("contract", string(contract)), zap.String("table", string(table)), zap.Int("scope_count", len(tableScopes)))
+	ham.Start(ctx)
+
+	// scopes -> hammer
+	go func() {
+		defer ham.Close()
+		for _, s := range tableScopes {
+			select {
+			case <-ctx.Done():
+				return
+			case ham.In <- s:
+			}
+		}
+	}()
+
+	// hammer -> tokenmeta
+	out = map[string]uint64{}
+	for {
+		select {
+		case v, ok := <-ham.Out:
+			if !ok {
+				zlog.Info("count table rows finished", zap.String("contract", string(contract)), zap.String("table", string(table)), zap.Int("scope_count", len(tableScopes)))
+				if ham.Err() != nil && ham.Err() != context.Canceled {
+					zlog.Error("hammer error", zap.Error(ham.Err()))
+					return nil, ham.Err()
+				}
+				return
+			}
+			rowCount := v.(*scopeRowCount)
+			out[rowCount.scope] += rowCount.count
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
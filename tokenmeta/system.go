@@ -0,0 +1,201 @@
+package tokenmeta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbstatedb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/statedb/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+// REXSymbol is the symbol of the REX token, its supply is the `total_rex` of the `rexpool` table
+const REXSymbol = "REX"
+
+// EOSIOSystemStandard is the standard of the `eosio.system` contract: the EOS delegated by an account
+// to another are rows of the `delband` table scoped by the delegating account, they are tracked as
+// stakes, and the REX owned by an account is a row of the `rexbal` table tracked as a `REX` token
+// balance, chains without REX only have stakes
+type EOSIOSystemStandard struct{}
+
+func (s *EOSIOSystemStandard) Name() string { return "eosio.system" }
+
+func (s *EOSIOSystemStandard) IsTokenContract(abi *eos.ABI) bool {
+	return hasTableFields(abi, EOSStakeTable, "from", "to", "net_weight", "cpu_weight")
+}
+
+func (s *EOSIOSystemStandard) IsStandardTable(table eos.TableName) bool {
+	return table == EOSStakeTable || table == REXPoolTable || table == REXBalanceTable
+}
+
+func (s *EOSIOSystemStandard) ProcessDBOp(muts *cache.MutationsBatch, tokens cache.Cache, dbop *pbcodec.DBOp, row json.RawMessage) error {
+	contract := eos.AccountName(dbop.Code)
+
+	switch eos.TableName(dbop.TableName) {
+	case EOSStakeTable:
+		stake, err := getStakeEntryFromDBRow(contract, dbop.Scope, row)
+		if err != nil {
+			return fmt.Errorf("could not create stake entry from dbop row: %w", err)
+		}
+
+		if dbop.NewData == nil {
+			// the whole stake was undelegated
+			stake.Net = 0
+			stake.Cpu = 0
+		}
+		muts.SetStake(stake)
+	case REXPoolTable:
+		if dbop.NewData == nil {
+			return nil
+		}
+
+		token, err := getREXTokenFromDBRow(contract, row)
+		if err != nil {
+			return fmt.Errorf("could not create token from dbop row: %w", err)
+		}
+		token.Standard = s.Name()
+		muts.SetToken(token)
+	case REXBalanceTable:
+		symbolCode, err := eos.StringToSymbolCode(REXSymbol)
+		if err != nil {
+			return err
+		}
+
+		if pendingToken(muts, tokens, contract, symbolCode) == nil {
+			return fmt.Errorf("unsupported token %s for contract", symbolCode)
+		}
+
+		accountBalance, err := getREXBalanceFromDBRow(contract, row)
+		if err != nil {
+			return fmt.Errorf("could not create account balance from dbop row: %w", err)
+		}
+
+		if dbop.NewData == nil {
+			muts.RemoveBalance(accountBalance)
+		} else {
+			muts.SetBalance(accountBalance)
+		}
+	}
+	return nil
+}
+
+func (s *EOSIOSystemStandard) Bootstrap(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) (tokens []*pbtokenmeta.Token, balances []*pbtokenmeta.AccountBalance, err error) {
+	getPoolReq := &pbstatedb.StreamTableRowsRequest{
+		BlockNum: uint64(startBlockNum),
+		Contract: string(contract),
+		Table:    string(REXPoolTable),
+		Scope:    string(contract),
+		KeyType:  "uint64",
+		ToJson:   true,
+	}
+	_, err = pbstatedb.ForEachTableRows(ctx, stateClient, getPoolReq, func(response *pbstatedb.TableRowResponse) error {
+		token, err := getREXTokenFromDBRow(contract, json.RawMessage(response.Json))
+		if err != nil {
+			return err
+		}
+		token.Standard = s.Name()
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot stream REX pool from statedb for contract %q: %w", string(contract), err)
+	}
+
+	if len(tokens) == 0 {
+		// REX is not initialized on this chain
+		return nil, nil, nil
+	}
+
+	getBalancesReq := &pbstatedb.StreamTableRowsRequest{
+		BlockNum: uint64(startBlockNum),
+		Contract: string(contract),
+		Table:    string(REXBalanceTable),
+		Scope:    string(contract),
+		KeyType:  "name",
+		ToJson:   true,
+	}
+	_, err = pbstatedb.ForEachTableRows(ctx, stateClient, getBalancesReq, func(response *pbstatedb.TableRowResponse) error {
+		balance, err := getREXBalanceFromDBRow(contract, json.RawMessage(response.Json))
+		if err != nil {
+			zlog.Debug("REX balance row is not valid", zap.String("contract", string(contract)), zap.Error(err))
+			return nil
+		}
+		balances = append(balances, balance)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot stream REX balances from statedb for contract %q: %w", string(contract), err)
+	}
+	return tokens, balances, nil
+}
+
+func (s *EOSIOSystemStandard) BootstrapStakes(ctx context.Context, stateClient pbstatedb.StateClient, contract eos.AccountName, startBlockNum uint32) ([]*cache.EOSStakeEntry, error) {
+	return getEOSStakedFromStateDB(ctx, stateClient, contract, startBlockNum)
+}
+
+func getStakeEntryFromDBRow(contract eos.AccountName, scope string, dbRow json.RawMessage) (*cache.EOSStakeEntry, error) {
+	stakeRow := &EOSStakeDbRow{}
+	err := json.Unmarshal(dbRow, &stakeRow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarsal EOS stake db row: %s", string(dbRow))
+	}
+	if !stakeRow.valid() {
+		return nil, fmt.Errorf("invalid stake row: %s", string(dbRow))
+	}
+	if stakeRow.From != eos.AccountName(scope) {
+		zlog.Warn("failed assumption: EOS stake FROM is not == scope",
+			zap.String("contract", string(contract)),
+			zap.String("scope", scope),
+			zap.String("from", string(stakeRow.From)),
+		)
+	}
+
+	return &cache.EOSStakeEntry{
+		From: stakeRow.From,
+		To:   stakeRow.To,
+		Net:  stakeRow.NetWeight.Amount,
+		Cpu:  stakeRow.CPUWeight.Amount,
+	}, nil
+}
+
+func getREXTokenFromDBRow(contract eos.AccountName, dbRow json.RawMessage) (*pbtokenmeta.Token, error) {
+	poolRow := &rexPoolDbRow{}
+	err := json.Unmarshal(dbRow, &poolRow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarsal REX pool row: %s", string(dbRow))
+	}
+	if !poolRow.valid() {
+		return nil, fmt.Errorf("invalid REX pool row: %s", string(dbRow))
+	}
+
+	return &pbtokenmeta.Token{
+		Contract:    string(contract),
+		Symbol:      REXSymbol,
+		Precision:   uint32(poolRow.TotalREX.Precision),
+		Issuer:      string(contract),
+		TotalSupply: uint64(poolRow.TotalREX.Amount),
+	}, nil
+}
+
+func getREXBalanceFromDBRow(contract eos.AccountName, dbRow json.RawMessage) (*pbtokenmeta.AccountBalance, error) {
+	balanceRow := &rexBalDbRow{}
+	err := json.Unmarshal(dbRow, &balanceRow)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarsal REX balance row: %s", string(dbRow))
+	}
+	if !balanceRow.valid() {
+		return nil, fmt.Errorf("invalid REX balance row: %s", string(dbRow))
+	}
+
+	return &pbtokenmeta.AccountBalance{
+		TokenContract: string(contract),
+		Account:       string(balanceRow.Owner),
+		Amount:        uint64(balanceRow.REXBalance.Amount),
+		Precision:     uint32(balanceRow.REXBalance.Precision),
+		Symbol:        REXSymbol,
+	}, nil
+}
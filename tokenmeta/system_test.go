@@ -0,0 +1,65 @@
+package tokenmeta
+
+import (
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/dfuse-io/dfuse-eosio/tokenmeta/cache"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEOSIOSystemStandard_IsTokenContract(t *testing.T) {
+	abi, err := decodeABI("eosio", []byte(`{
+		"structs": [
+			{"name": "delegated_bandwidth", "fields": [{"name": "from", "type": "name"}, {"name": "to", "type": "name"}, {"name": "net_weight", "type": "asset"}, {"name": "cpu_weight", "type": "asset"}]}
+		],
+		"tables": [
+			{"name": "delband", "type": "delegated_bandwidth"}
+		]
+	}`), true)
+	require.NoError(t, err)
+
+	standard := tokenStandardForABI(abi)
+	assert.IsType(t, &EOSIOSystemStandard{}, standard)
+	assert.Implements(t, (*StakingStandard)(nil), standard)
+}
+
+func TestEOSIOSystemStandard_ProcessDBOp(t *testing.T) {
+	standard := &EOSIOSystemStandard{}
+	tokens := cache.NewDefaultCacheWithData(
+		[]*pbtokenmeta.Token{{Contract: "eosio.token", Symbol: "EOS", Precision: 4}},
+		[]*pbtokenmeta.AccountBalance{{TokenContract: "eosio.token", Account: "alice", Amount: 10000, Precision: 4, Symbol: "EOS"}},
+		nil, bstream.NewBlockRef("0000000aa", 10), "",
+	)
+
+	muts := &cache.MutationsBatch{}
+	assert.Error(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "rexbal", Scope: "eosio", PrimaryKey: "alice", NewData: []byte{0x01}},
+		[]byte(`{"owner":"alice","vote_stake":"1.0000 EOS","rex_balance":"10000.0000 REX"}`)))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "rexpool", Scope: "eosio", PrimaryKey: "0", NewData: []byte{0x01}},
+		[]byte(`{"total_lent":"0.0000 EOS","total_rex":"10000.0000 REX"}`)))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "rexbal", Scope: "eosio", PrimaryKey: "alice", NewData: []byte{0x01}},
+		[]byte(`{"owner":"alice","vote_stake":"1.0000 EOS","rex_balance":"10000.0000 REX"}`)))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "delband", Scope: "alice", PrimaryKey: "bob", NewData: []byte{0x01}},
+		[]byte(`{"from":"alice","to":"bob","net_weight":"1.0000 EOS","cpu_weight":"2.0000 EOS"}`)))
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "delband", Scope: "alice", PrimaryKey: "alice", NewData: []byte{0x01}},
+		[]byte(`{"from":"alice","to":"alice","net_weight":"3.0000 EOS","cpu_weight":"4.0000 EOS"}`)))
+	// alice undelegates everything she staked to bob
+	require.NoError(t, standard.ProcessDBOp(muts, tokens, &pbcodec.DBOp{Code: "eosio", TableName: "delband", Scope: "alice", PrimaryKey: "bob"},
+		[]byte(`{"from":"alice","to":"bob","net_weight":"1.0000 EOS","cpu_weight":"2.0000 EOS"}`)))
+
+	require.Empty(t, tokens.Apply(muts, bstream.NewBlockRef("0000000ba", 11)))
+
+	symbolCode, err := eos.StringToSymbolCode(REXSymbol)
+	require.NoError(t, err)
+	assert.Equal(t, &pbtokenmeta.Token{Contract: "eosio", Symbol: "REX", Precision: 4, Issuer: "eosio", TotalSupply: 100000000, Holders: 1, Standard: "eosio.system"}, tokens.TokenContract("eosio", symbolCode))
+
+	balances := map[string]string{}
+	for _, asset := range tokens.AccountBalances("alice", cache.EOSIncludeStakedAccOpt) {
+		balances[string(asset.Asset.Contract)] = asset.Asset.Asset.String()
+	}
+	assert.Equal(t, map[string]string{"eosio": "10000.0000 REX", "eosio.token": "8.0000 EOS"}, balances)
+}
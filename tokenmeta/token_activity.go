@@ -0,0 +1,445 @@
+package tokenmeta
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+const transferStatsWindow = 7 * 24 * time.Hour
+
+// maxSupplyChanges is the number of most recent supply changes kept per token
+const maxSupplyChanges = 100
+
+type tokenRef struct {
+	contract eos.AccountName
+	symbol   string
+}
+
+type tokenTransfer struct {
+	token  tokenRef
+	from   eos.AccountName
+	to     eos.AccountName
+	amount uint64
+}
+
+type tokenSupplyChange struct {
+	token  tokenRef
+	change *pbtokenmeta.SupplyChange
+}
+
+// TokenActivity aggregates, per token, the transfers of the last 7 days and the most recent supply
+// changes of the irreversible blocks processed. It is saved along the tokenmeta cache file, so the
+// aggregates survive restarts, the windows are only complete once 7 days of blocks were processed,
+// the stats flag the windows that are not.
+type TokenActivity struct {
+	lock     sync.RWMutex
+	filePath string
+
+	sinceBlock bstream.BlockRef
+	sinceTime  time.Time
+	atBlock    bstream.BlockRef
+	atTime     time.Time
+	tokens     map[tokenRef]*tokenActivity
+}
+
+type tokenActivity struct {
+	hourlyTransfers []*hourlyTransfers // oldest first
+
+	// last time each account sent or received the token
+	senders   map[eos.AccountName]time.Time
+	receivers map[eos.AccountName]time.Time
+
+	supplyChanges []*pbtokenmeta.SupplyChange // oldest first
+}
+
+type hourlyTransfers struct {
+	hour   time.Time
+	count  uint64
+	volume uint64
+}
+
+func NewTokenActivity() *TokenActivity {
+	return &TokenActivity{
+		tokens: map[tokenRef]*tokenActivity{},
+	}
+}
+
+// LoadTokenActivityFromFile loads the aggregates saved in `filename` by `SaveToFile`, later saves
+// go to the same file.
+func LoadTokenActivityFromFile(filename string) (*TokenActivity, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file: %w", err)
+	}
+	defer f.Close()
+
+	saved := &savedTokenActivity{}
+	if err := gob.NewDecoder(f).Decode(saved); err != nil {
+		return nil, fmt.Errorf("unable to decode token activity: %w", err)
+	}
+
+	a, err := saved.toTokenActivity()
+	if err != nil {
+		return nil, err
+	}
+
+	a.filePath = filename
+	return a, nil
+}
+
+// SetFilePath sets the file `SaveToFile` writes to
+func (a *TokenActivity) SetFilePath(filename string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.filePath = filename
+}
+
+// AtBlock returns the last block recorded, `nil` when nothing was recorded yet
+func (a *TokenActivity) AtBlock() bstream.BlockRef {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.atBlock
+}
+
+// SaveToFile saves the aggregates, it does nothing when no file path is set
+func (a *TokenActivity) SaveToFile() error {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if a.filePath == "" {
+		return nil
+	}
+
+	tempfile := fmt.Sprintf("%s.tmp", a.filePath)
+	zlog.Info("trying to save token activity file", zap.String("filename", a.filePath), zap.String("temp_filename", tempfile))
+
+	saved, err := newSavedTokenActivity(a)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(tempfile)
+	if err != nil {
+		return err
+	}
+	err = gob.NewEncoder(f).Encode(saved)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempfile, a.filePath)
+}
+
+// Record aggregates the transfers and supply changes of an irreversible block
+func (a *TokenActivity) Record(block bstream.BlockRef, blockTime time.Time, transfers []*tokenTransfer, supplyChanges []*tokenSupplyChange) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.sinceBlock == nil {
+		a.sinceBlock = bstream.NewBlockRef(block.ID(), block.Num())
+		a.sinceTime = blockTime
+	}
+	a.atBlock = bstream.NewBlockRef(block.ID(), block.Num())
+	a.atTime = blockTime
+
+	for _, transfer := range transfers {
+		a.token(transfer.token).addTransfer(transfer, blockTime)
+	}
+
+	for _, supplyChange := range supplyChanges {
+		activity := a.token(supplyChange.token)
+		activity.supplyChanges = append(activity.supplyChanges, supplyChange.change)
+		if len(activity.supplyChanges) > maxSupplyChanges {
+			activity.supplyChanges = activity.supplyChanges[len(activity.supplyChanges)-maxSupplyChanges:]
+		}
+	}
+}
+
+func (a *TokenActivity) token(token tokenRef) *tokenActivity {
+	activity, found := a.tokens[token]
+	if !found {
+		activity = &tokenActivity{
+			senders:   map[eos.AccountName]time.Time{},
+			receivers: map[eos.AccountName]time.Time{},
+		}
+		a.tokens[token] = activity
+	}
+	return activity
+}
+
+func (t *tokenActivity) addTransfer(transfer *tokenTransfer, blockTime time.Time) {
+	hour := blockTime.Truncate(time.Hour)
+	if len(t.hourlyTransfers) == 0 || !t.hourlyTransfers[len(t.hourlyTransfers)-1].hour.Equal(hour) {
+		t.hourlyTransfers = append(t.hourlyTransfers, &hourlyTransfers{hour: hour})
+		t.prune(blockTime.Add(-transferStatsWindow))
+	}
+
+	bucket := t.hourlyTransfers[len(t.hourlyTransfers)-1]
+	bucket.count++
+	bucket.volume += transfer.amount
+
+	t.senders[transfer.from] = blockTime
+	t.receivers[transfer.to] = blockTime
+}
+
+// prune drops the transfers older than `before`, once per hour of activity
+func (t *tokenActivity) prune(before time.Time) {
+	for len(t.hourlyTransfers) > 0 && t.hourlyTransfers[0].hour.Before(before.Truncate(time.Hour)) {
+		t.hourlyTransfers = t.hourlyTransfers[1:]
+	}
+
+	for account, lastSeen := range t.senders {
+		if lastSeen.Before(before) {
+			delete(t.senders, account)
+		}
+	}
+	for account, lastSeen := range t.receivers {
+		if lastSeen.Before(before) {
+			delete(t.receivers, account)
+		}
+	}
+}
+
+// Stats returns the transfers of the last 24 hours and 7 days, the supply changes and the
+// block range they were aggregated from, the blocks are `nil` when nothing was recorded yet
+func (a *TokenActivity) Stats(contract eos.AccountName, symbol string) (out *pbtokenmeta.TokenStats, sinceBlock, atBlock bstream.BlockRef) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	out = &pbtokenmeta.TokenStats{
+		Transfers_24H: &pbtokenmeta.TransferStats{},
+		Transfers_7D:  &pbtokenmeta.TransferStats{},
+	}
+
+	if activity, found := a.tokens[tokenRef{contract: contract, symbol: symbol}]; found {
+		out.Transfers_24H = activity.transferStats(a.atTime, 24*time.Hour)
+		out.Transfers_7D = activity.transferStats(a.atTime, transferStatsWindow)
+		out.SupplyChanges = append([]*pbtokenmeta.SupplyChange{}, activity.supplyChanges...)
+	}
+
+	out.Transfers_24H.Complete = a.windowComplete(24 * time.Hour)
+	out.Transfers_7D.Complete = a.windowComplete(transferStatsWindow)
+	return out, a.sinceBlock, a.atBlock
+}
+
+// windowComplete tells if the blocks recorded span the whole window before the last one
+func (a *TokenActivity) windowComplete(window time.Duration) bool {
+	return a.sinceBlock != nil && !a.sinceTime.After(a.atTime.Add(-window))
+}
+
+func (t *tokenActivity) transferStats(atTime time.Time, window time.Duration) *pbtokenmeta.TransferStats {
+	stats := &pbtokenmeta.TransferStats{}
+
+	// the hour `atTime` is in counts as one of the window's hours
+	firstHour := atTime.Truncate(time.Hour).Add(-window + time.Hour)
+	for _, bucket := range t.hourlyTransfers {
+		if bucket.hour.Before(firstHour) {
+			continue
+		}
+		stats.Count += bucket.count
+		stats.Volume += bucket.volume
+	}
+
+	since := atTime.Add(-window)
+	for _, lastSeen := range t.senders {
+		if lastSeen.After(since) {
+			stats.UniqueSenders++
+		}
+	}
+	for _, lastSeen := range t.receivers {
+		if lastSeen.After(since) {
+			stats.UniqueReceivers++
+		}
+	}
+	return stats
+}
+
+// tokenActivityFromBlock extracts the transfers and supply changes from the executed `transfer`, `issue`
+// and `retire` actions of the token contracts, only the action on the contract itself is considered
+// (not its notifications)
+func tokenActivityFromBlock(blk *pbcodec.Block, isTokenContract func(contract eos.AccountName) bool) (transfers []*tokenTransfer, supplyChanges []*tokenSupplyChange) {
+	for _, trx := range blk.TransactionTraces() {
+		if trx.HasBeenReverted() {
+			continue
+		}
+
+		actionMatcher := blk.FilteringActionMatcher(trx)
+		for _, actTrace := range trx.ActionTraces {
+			if !actionMatcher.Matched(actTrace.ExecutionIndex) || actTrace.Receiver != actTrace.Account() {
+				continue
+			}
+
+			actionName := actTrace.Name()
+			if actionName != "transfer" && actionName != "issue" && actionName != "retire" {
+				continue
+			}
+
+			contract := eos.AccountName(actTrace.Account())
+			if !isTokenContract(contract) {
+				continue
+			}
+
+			zlogger := zlog.With(zap.String("trx_id", trx.Id), zap.String("contract", string(contract)), zap.String("action", actionName))
+			switch actionName {
+			case "transfer":
+				data := &transferActionData{}
+				if err := eos.UnmarshalBinary(actTrace.Action.RawData, data); err != nil {
+					zlogger.Debug("cannot decode token action", zap.Error(err))
+					continue
+				}
+
+				transfers = append(transfers, &tokenTransfer{
+					token:  tokenRef{contract: contract, symbol: data.Quantity.Symbol.Symbol},
+					from:   data.From,
+					to:     data.To,
+					amount: uint64(data.Quantity.Amount),
+				})
+			case "issue", "retire":
+				quantity, err := supplyChangeQuantity(actionName, actTrace.Action.RawData)
+				if err != nil {
+					zlogger.Debug("cannot decode token action", zap.Error(err))
+					continue
+				}
+
+				changeType := pbtokenmeta.SupplyChange_ISSUE
+				if actionName == "retire" {
+					changeType = pbtokenmeta.SupplyChange_RETIRE
+				}
+
+				supplyChanges = append(supplyChanges, &tokenSupplyChange{
+					token: tokenRef{contract: contract, symbol: quantity.Symbol.Symbol},
+					change: &pbtokenmeta.SupplyChange{
+						Type:          changeType,
+						Amount:        uint64(quantity.Amount),
+						BlockNum:      uint64(blk.Number),
+						BlockId:       blk.Id,
+						BlockTime:     blk.Header.Timestamp,
+						TransactionId: trx.Id,
+					},
+				})
+			}
+		}
+	}
+	return
+}
+
+func supplyChangeQuantity(actionName string, rawData []byte) (eos.Asset, error) {
+	if actionName == "issue" {
+		data := &issueActionData{}
+		err := eos.UnmarshalBinary(rawData, data)
+		return data.Quantity, err
+	}
+
+	data := &retireActionData{}
+	err := eos.UnmarshalBinary(rawData, data)
+	return data.Quantity, err
+}
+
+// savedTokenActivity is the gob encoded form of `TokenActivity`
+type savedTokenActivity struct {
+	SinceBlockID  string
+	SinceBlockNum uint64
+	SinceTime     time.Time
+	AtBlockID     string
+	AtBlockNum    uint64
+	AtTime        time.Time
+	Tokens        []*savedTokenActivityEntry
+}
+
+type savedTokenActivityEntry struct {
+	Contract        string
+	Symbol          string
+	HourlyTransfers []savedHourlyTransfers
+	Senders         map[string]time.Time
+	Receivers       map[string]time.Time
+	SupplyChanges   [][]byte // proto encoded `pbtokenmeta.SupplyChange`
+}
+
+type savedHourlyTransfers struct {
+	Hour   time.Time
+	Count  uint64
+	Volume uint64
+}
+
+func newSavedTokenActivity(a *TokenActivity) (*savedTokenActivity, error) {
+	out := &savedTokenActivity{SinceTime: a.sinceTime, AtTime: a.atTime}
+	if a.sinceBlock != nil {
+		out.SinceBlockID, out.SinceBlockNum = a.sinceBlock.ID(), a.sinceBlock.Num()
+		out.AtBlockID, out.AtBlockNum = a.atBlock.ID(), a.atBlock.Num()
+	}
+
+	for token, activity := range a.tokens {
+		entry := &savedTokenActivityEntry{
+			Contract:  string(token.contract),
+			Symbol:    token.symbol,
+			Senders:   make(map[string]time.Time, len(activity.senders)),
+			Receivers: make(map[string]time.Time, len(activity.receivers)),
+		}
+
+		for _, bucket := range activity.hourlyTransfers {
+			entry.HourlyTransfers = append(entry.HourlyTransfers, savedHourlyTransfers{Hour: bucket.hour, Count: bucket.count, Volume: bucket.volume})
+		}
+		for account, lastSeen := range activity.senders {
+			entry.Senders[string(account)] = lastSeen
+		}
+		for account, lastSeen := range activity.receivers {
+			entry.Receivers[string(account)] = lastSeen
+		}
+		for _, change := range activity.supplyChanges {
+			data, err := proto.Marshal(change)
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal supply change: %w", err)
+			}
+			entry.SupplyChanges = append(entry.SupplyChanges, data)
+		}
+
+		out.Tokens = append(out.Tokens, entry)
+	}
+
+	return out, nil
+}
+
+func (s *savedTokenActivity) toTokenActivity() (*TokenActivity, error) {
+	a := NewTokenActivity()
+	a.sinceTime, a.atTime = s.SinceTime, s.AtTime
+	if s.SinceBlockID != "" {
+		a.sinceBlock = bstream.NewBlockRef(s.SinceBlockID, s.SinceBlockNum)
+		a.atBlock = bstream.NewBlockRef(s.AtBlockID, s.AtBlockNum)
+	}
+
+	for _, entry := range s.Tokens {
+		activity := a.token(tokenRef{contract: eos.AccountName(entry.Contract), symbol: entry.Symbol})
+
+		for _, bucket := range entry.HourlyTransfers {
+			activity.hourlyTransfers = append(activity.hourlyTransfers, &hourlyTransfers{hour: bucket.Hour, count: bucket.Count, volume: bucket.Volume})
+		}
+		for account, lastSeen := range entry.Senders {
+			activity.senders[eos.AccountName(account)] = lastSeen
+		}
+		for account, lastSeen := range entry.Receivers {
+			activity.receivers[eos.AccountName(account)] = lastSeen
+		}
+		for _, data := range entry.SupplyChanges {
+			change := &pbtokenmeta.SupplyChange{}
+			if err := proto.Unmarshal(data, change); err != nil {
+				return nil, fmt.Errorf("unable to unmarshal supply change: %w", err)
+			}
+			activity.supplyChanges = append(activity.supplyChanges, change)
+		}
+	}
+
+	return a, nil
+}
@@ -0,0 +1,134 @@
+package tokenmeta
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbtokenmeta "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/tokenmeta/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenActivity(t *testing.T) {
+	start := time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC)
+	block := func(num uint64, at time.Duration, trxs ...*pbcodec.TransactionTrace) (bstream.BlockRef, *pbcodec.Block) {
+		blockTime, err := ptypes.TimestampProto(start.Add(at))
+		require.NoError(t, err)
+
+		id := fmt.Sprintf("%08xa", num)
+		return bstream.NewBlockRef(id, num), &pbcodec.Block{
+			Id:                          id,
+			Number:                      uint32(num),
+			Header:                      &pbcodec.BlockHeader{Timestamp: blockTime},
+			UnfilteredTransactionTraces: trxs,
+		}
+	}
+	trx := func(id string, status pbcodec.TransactionStatus, actions ...*pbcodec.ActionTrace) *pbcodec.TransactionTrace {
+		return &pbcodec.TransactionTrace{Id: id, Receipt: &pbcodec.TransactionReceiptHeader{Status: status}, ActionTraces: actions}
+	}
+	action := func(receiver, contract, name string, data interface{}) *pbcodec.ActionTrace {
+		rawData, err := eos.MarshalBinary(data)
+		require.NoError(t, err)
+		return &pbcodec.ActionTrace{Receiver: receiver, Action: &pbcodec.Action{Account: contract, Name: name, RawData: rawData}}
+	}
+	eosAsset := func(amount int64) eos.Asset {
+		return eos.Asset{Amount: eos.Int64(amount), Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}
+	}
+	transfer := func(contract, from, to string, amount int64) *pbcodec.ActionTrace {
+		return action(contract, contract, "transfer", &transferActionData{From: eos.AN(from), To: eos.AN(to), Quantity: eosAsset(amount)})
+	}
+	isTokenContract := func(contract eos.AccountName) bool {
+		return contract == "eosio.token"
+	}
+
+	activity := NewTokenActivity()
+	record := func(blockRef bstream.BlockRef, blk *pbcodec.Block) {
+		transfers, supplyChanges := tokenActivityFromBlock(blk, isTokenContract)
+		activity.Record(blockRef, blk.MustTime(), transfers, supplyChanges)
+	}
+
+	record(block(10, 0,
+		trx("a1", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+			action("eosio.token", "eosio.token", "issue", &issueActionData{To: "eosio", Quantity: eosAsset(1000)}),
+			transfer("eosio.token", "eosio", "alice", 3),
+			// notification, only the action on the contract itself counts
+			action("alice", "eosio.token", "transfer", &transferActionData{From: "eosio", To: "alice", Quantity: eosAsset(3)}),
+			transfer("other.token", "alice", "bob", 3),
+		),
+		trx("a2", pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL, transfer("eosio.token", "alice", "bob", 3)),
+	))
+	record(block(11, 2*24*time.Hour,
+		trx("b1", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+			transfer("eosio.token", "alice", "bob", 3),
+			transfer("eosio.token", "alice", "carol", 3),
+			action("eosio.token", "eosio.token", "retire", &retireActionData{Quantity: eosAsset(10)}),
+		),
+	))
+
+	stats, sinceBlock, atBlock := activity.Stats("eosio.token", "EOS")
+	assert.Equal(t, "0000000aa", sinceBlock.ID())
+	assert.Equal(t, "0000000ba", atBlock.ID())
+	assert.Equal(t, &pbtokenmeta.TransferStats{Count: 2, Volume: 6, UniqueSenders: 1, UniqueReceivers: 2, Complete: true}, stats.Transfers_24H)
+	// only 2 days were recorded so far
+	assert.Equal(t, &pbtokenmeta.TransferStats{Count: 3, Volume: 9, UniqueSenders: 2, UniqueReceivers: 3, Complete: false}, stats.Transfers_7D)
+
+	var supplyChanges []string
+	for _, change := range stats.SupplyChanges {
+		supplyChanges = append(supplyChanges, fmt.Sprintf("%s %d @ %d (%s)", change.Type, change.Amount, change.BlockNum, change.TransactionId))
+	}
+	assert.Equal(t, []string{"ISSUE 1000 @ 10 (a1)", "RETIRE 10 @ 11 (b1)"}, supplyChanges)
+
+	record(block(12, 8*24*time.Hour, trx("c1", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED, transfer("eosio.token", "bob", "dave", 3))))
+
+	stats, _, _ = activity.Stats("eosio.token", "EOS")
+	assert.Equal(t, &pbtokenmeta.TransferStats{Count: 1, Volume: 3, UniqueSenders: 1, UniqueReceivers: 1, Complete: true}, stats.Transfers_24H)
+	assert.Equal(t, &pbtokenmeta.TransferStats{Count: 3, Volume: 9, UniqueSenders: 2, UniqueReceivers: 3, Complete: true}, stats.Transfers_7D)
+
+	stats, _, _ = activity.Stats("other.token", "EOS")
+	assert.Equal(t, &pbtokenmeta.TransferStats{Complete: true}, stats.Transfers_7D)
+	assert.Len(t, stats.SupplyChanges, 0)
+}
+
+func TestTokenActivity_SaveToFile(t *testing.T) {
+	start := time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC)
+	blockTime, err := ptypes.TimestampProto(start)
+	require.NoError(t, err)
+
+	tmp, err := ioutil.TempDir("", "tokenmeta")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	token := tokenRef{contract: "eosio.token", symbol: "EOS"}
+	activity := NewTokenActivity()
+	activity.SetFilePath(filepath.Join(tmp, "cache.activity"))
+	activity.Record(bstream.NewBlockRef("0000000aa", 10), start, []*tokenTransfer{{token: token, from: "alice", to: "bob", amount: 3}}, nil)
+	activity.Record(bstream.NewBlockRef("0000000ba", 11), start.Add(8*24*time.Hour), []*tokenTransfer{{token: token, from: "bob", to: "carol", amount: 5}}, []*tokenSupplyChange{
+		{token: token, change: &pbtokenmeta.SupplyChange{Type: pbtokenmeta.SupplyChange_RETIRE, Amount: 10, BlockNum: 11, BlockId: "0000000ba", BlockTime: blockTime, TransactionId: "b1"}},
+	})
+	require.NoError(t, activity.SaveToFile())
+
+	loaded, err := LoadTokenActivityFromFile(filepath.Join(tmp, "cache.activity"))
+	require.NoError(t, err)
+	assert.Equal(t, "0000000ba", loaded.AtBlock().ID())
+
+	expected, expectedSince, expectedAt := activity.Stats("eosio.token", "EOS")
+	stats, sinceBlock, atBlock := loaded.Stats("eosio.token", "EOS")
+	assert.Equal(t, expectedSince, sinceBlock)
+	assert.Equal(t, expectedAt, atBlock)
+	assert.True(t, proto.Equal(expected, stats), "expected %s, got %s", expected, stats)
+	assert.True(t, stats.Transfers_7D.Complete)
+
+	// Blocks recorded after the load keep aggregating over the loaded ones
+	loaded.Record(bstream.NewBlockRef("0000000ca", 12), start.Add(8*24*time.Hour+time.Minute), []*tokenTransfer{{token: token, from: "bob", to: "dave", amount: 1}}, nil)
+	stats, _, _ = loaded.Stats("eosio.token", "EOS")
+	assert.Equal(t, &pbtokenmeta.TransferStats{Count: 2, Volume: 6, UniqueSenders: 1, UniqueReceivers: 2, Complete: true}, stats.Transfers_24H)
+}
@@ -19,6 +19,12 @@ import (
 const AccountsTable eos.TableName = eos.TableName("accounts")
 const StatTable eos.TableName = eos.TableName("stat")
 const EOSStakeTable eos.TableName = eos.TableName("delband")
+const REXBalanceTable eos.TableName = eos.TableName("rexbal")
+const REXPoolTable eos.TableName = eos.TableName("rexpool")
+const SimpleAssetsTable eos.TableName = eos.TableName("sassets")
+const AtomicAssetsTable eos.TableName = eos.TableName("assets")
+
+const RedeemAction eos.ActionName = eos.ActionName("redeem")
 
 var maxStateDBRetry = 5
 
@@ -31,9 +37,17 @@ type TokenMeta struct {
 	abisCache       map[string]*abiItem
 	saveEveryNBlock uint32
 	stateClient     pbstatedb.StateClient
+	changesHub      *BalanceChangesHub
+	activity        *TokenActivity
+
+	reversibleCache   *cache.ReversibleCache
+	reversibleChanges map[string][]*pbtokenmeta.BalanceChange
 }
 
-func NewTokenMeta(cache cache.Cache, abiCodecCli pbabicodec.DecoderClient, saveEveryNBlock uint32, stateClient pbstatedb.StateClient) *TokenMeta {
+// NewTokenMeta creates the block processor, `changesHub` and `activity` are optional and receive
+// respectively the balance changes and the token actions of every irreversible block. When `reversibleCache` is set (it must then also be `cache`),
+// tokenmeta runs in head mode, applying new blocks and undoing them on forks.
+func NewTokenMeta(cache cache.Cache, abiCodecCli pbabicodec.DecoderClient, saveEveryNBlock uint32, stateClient pbstatedb.StateClient, reversibleCache *cache.ReversibleCache, changesHub *BalanceChangesHub, activity *TokenActivity) *TokenMeta {
 	if blkTime := cache.GetHeadBlockTime(); !blkTime.IsZero() {
 		HeadTimeDrift.SetBlockTime(blkTime)
 	}
@@ -44,6 +58,22 @@ func NewTokenMeta(cache cache.Cache, abiCodecCli pbabicodec.DecoderClient, saveE
 		abiCodecCli:     abiCodecCli,
 		saveEveryNBlock: saveEveryNBlock,
 		stateClient:     stateClient,
+		changesHub:      changesHub,
+		activity:        activity,
+
+		reversibleCache:   reversibleCache,
+		reversibleChanges: map[string][]*pbtokenmeta.BalanceChange{},
+	}
+}
+
+// saveActivity saves the token statistics along the cache, both are then at the same block
+func (t *TokenMeta) saveActivity() {
+	if t.activity == nil {
+		return
+	}
+
+	if err := t.activity.SaveToFile(); err != nil {
+		zlog.Error("error saving token activity", zap.Error(err))
 	}
 }
 
@@ -68,6 +98,7 @@ func (i *TokenMeta) Launch() error {
 	if err != nil {
 		zlog.Error("error exporting cache on shutdown", zap.Error(err))
 	}
+	i.saveActivity()
 
 	if err := i.source.Err(); err != nil {
 		zlog.Error("source shutdown with error", zap.Error(err))
@@ -82,7 +113,7 @@ func shouldProcessDbop(dbop *pbcodec.DBOp, actionMatcher pbcodec.FilteringAction
 		return false
 	}
 
-	return dbop.TableName == string(AccountsTable) || dbop.TableName == string(StatTable)
+	return isStandardTable(eos.TableName(dbop.TableName))
 }
 
 func shouldProcessAction(actTrace *pbcodec.ActionTrace, actionMatcher pbcodec.FilteringActionMatcher) bool {
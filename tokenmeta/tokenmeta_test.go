@@ -27,6 +27,20 @@ func TestTokenMeta_shouldProcessDbop(t *testing.T) {
 			},
 			expectValue: true,
 		},
+		{
+			name: "eosio.system delband table",
+			dbop: &pbcodec.DBOp{
+				TableName: "delband",
+			},
+			expectValue: true,
+		},
+		{
+			name: "simpleassets sassets table",
+			dbop: &pbcodec.DBOp{
+				TableName: "sassets",
+			},
+			expectValue: true,
+		},
 		{
 			name: "invalid table",
 			dbop: &pbcodec.DBOp{
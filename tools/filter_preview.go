@@ -0,0 +1,298 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/filtering"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dstore"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var filterPreviewCmd = &cobra.Command{
+	Use:   "filter-preview {merged-blocks-store-url}",
+	Short: "Dry-runs new filter expressions against old ones over a range of merged blocks, printing per receiver/action kept, dropped and system-forced counts along with sample transactions that changed status",
+	Args:  cobra.ExactArgs(1),
+	RunE:  filterPreviewE,
+}
+
+func init() {
+	Cmd.AddCommand(filterPreviewCmd)
+
+	filterPreviewCmd.Flags().StringP("range", "r", "", "Block range to preview the filters on, format is of the form '<start>:<stop>' (i.e. '-r 1000:2000')")
+	filterPreviewCmd.Flags().String("include-expr", "", "New include filter expression(s)")
+	filterPreviewCmd.Flags().String("exclude-expr", "", "New exclude filter expression(s)")
+	filterPreviewCmd.Flags().String("system-actions-include-expr", "", "New system actions include filter expression(s)")
+	filterPreviewCmd.Flags().String("old-include-expr", "", "Old include filter expression(s) to compare against")
+	filterPreviewCmd.Flags().String("old-exclude-expr", "", "Old exclude filter expression(s) to compare against")
+	filterPreviewCmd.Flags().String("old-system-actions-include-expr", "", "Old system actions include filter expression(s) to compare against")
+	filterPreviewCmd.Flags().String("chain-id", "", "Chain ID in hex, required for 'trx_signing_keys' to resolve in the expressions")
+	filterPreviewCmd.Flags().Int("samples", 10, "Maximum number of transactions that changed status to print")
+	filterPreviewCmd.Flags().Int("top", 50, "Maximum number of receiver/action statistics to print, ordered by action count, 0 prints them all")
+}
+
+func filterPreviewE(cmd *cobra.Command, args []string) error {
+	storeURL := args[0]
+
+	blockRange, err := getBlockRangeFromFlag()
+	if err != nil {
+		return err
+	}
+
+	newFilter, err := newBlockFilterFromFlags("")
+	if err != nil {
+		return fmt.Errorf("new filter: %w", err)
+	}
+
+	oldFilter, err := newBlockFilterFromFlags("old-")
+	if err != nil {
+		return fmt.Errorf("old filter: %w", err)
+	}
+
+	blocksStore, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return err
+	}
+
+	preview := newFilterPreview(viper.GetInt("samples"))
+	err = walkMergedBlocks(context.Background(), blocksStore, blockRange, func(block *pbcodec.Block) error {
+		preview.process(block, oldFilter, newFilter)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	preview.print(blockRange, viper.GetInt("top"))
+	return nil
+}
+
+func newBlockFilterFromFlags(prefix string) (*filtering.BlockFilter, error) {
+	filter, err := filtering.NewBlockFilter(
+		strings.Split(viper.GetString(prefix+"include-expr"), ";;;"),
+		strings.Split(viper.GetString(prefix+"exclude-expr"), ";;;"),
+		strings.Split(viper.GetString(prefix+"system-actions-include-expr"), ";;;"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ChainID, err = hex.DecodeString(viper.GetString("chain-id"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain ID: %w", err)
+	}
+
+	return filter, nil
+}
+
+type filterPreview struct {
+	blockCount  int
+	actionCount int
+	statsByKey  map[string]*filterPreviewStats
+
+	maxSamples int
+	samples    []string
+	changed    map[bool]int
+}
+
+type filterPreviewStats struct {
+	key          string
+	kept         int
+	dropped      int
+	systemForced int
+	changed      int
+}
+
+func (s *filterPreviewStats) total() int {
+	return s.kept + s.dropped + s.systemForced
+}
+
+func newFilterPreview(maxSamples int) *filterPreview {
+	return &filterPreview{
+		statsByKey: map[string]*filterPreviewStats{},
+		maxSamples: maxSamples,
+		changed:    map[bool]int{},
+	}
+}
+
+func (p *filterPreview) process(block *pbcodec.Block, oldFilter, newFilter *filtering.BlockFilter) {
+	p.blockCount++
+
+	oldDecisions := map[*pbcodec.ActionTrace]filtering.ActionDecision{}
+	oldFilter.Evaluate(block, func(_ *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, decision filtering.ActionDecision) {
+		oldDecisions[actTrace] = decision
+	})
+
+	var trxTraces []*pbcodec.TransactionTrace
+	oldKeptByTrx := map[*pbcodec.TransactionTrace]bool{}
+	newKeptByTrx := map[*pbcodec.TransactionTrace]bool{}
+
+	newFilter.Evaluate(block, func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace, decision filtering.ActionDecision) {
+		p.actionCount++
+
+		key := actTrace.Receiver + " " + actTrace.SimpleName()
+		stats := p.statsByKey[key]
+		if stats == nil {
+			stats = &filterPreviewStats{key: key}
+			p.statsByKey[key] = stats
+		}
+
+		switch decision {
+		case filtering.ActionKept:
+			stats.kept++
+		case filtering.ActionDropped:
+			stats.dropped++
+		case filtering.ActionSystemForced:
+			stats.systemForced++
+		}
+
+		oldDecision := oldDecisions[actTrace]
+		if oldDecision != decision {
+			stats.changed++
+		}
+
+		if _, seen := newKeptByTrx[trxTrace]; !seen {
+			trxTraces = append(trxTraces, trxTrace)
+		}
+
+		oldKeptByTrx[trxTrace] = oldKeptByTrx[trxTrace] || oldDecision != filtering.ActionDropped
+		newKeptByTrx[trxTrace] = newKeptByTrx[trxTrace] || decision != filtering.ActionDropped
+	})
+
+	for _, trxTrace := range trxTraces {
+		wasKept, isKept := oldKeptByTrx[trxTrace], newKeptByTrx[trxTrace]
+		if wasKept == isKept {
+			continue
+		}
+
+		p.changed[isKept]++
+		if len(p.samples) < p.maxSamples {
+			p.samples = append(p.samples, formatChangedTrx(block, trxTrace, wasKept, isKept))
+		}
+	}
+}
+
+func formatChangedTrx(block *pbcodec.Block, trxTrace *pbcodec.TransactionTrace, wasKept, isKept bool) string {
+	status := func(kept bool) string {
+		if kept {
+			return "kept"
+		}
+		return "dropped"
+	}
+
+	actions := make([]string, len(trxTrace.ActionTraces))
+	for i, actTrace := range trxTrace.ActionTraces {
+		actions[i] = actTrace.Receiver + " " + actTrace.SimpleName()
+	}
+
+	return fmt.Sprintf("Block #%d Trx %s (%s -> %s): %s", block.Num(), trxTrace.Id, status(wasKept), status(isKept), strings.Join(actions, ", "))
+}
+
+func (p *filterPreview) print(blockRange BlockRange, top int) {
+	fmt.Printf("Previewed filters over %d blocks (%s), %d actions\n", p.blockCount, blockRange, p.actionCount)
+	fmt.Println()
+
+	stats := make([]*filterPreviewStats, 0, len(p.statsByKey))
+	for _, stat := range p.statsByKey {
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].total() == stats[j].total() {
+			return stats[i].key < stats[j].key
+		}
+		return stats[i].total() > stats[j].total()
+	})
+
+	if top > 0 && len(stats) > top {
+		stats = stats[0:top]
+	}
+
+	fmt.Printf("%-50s %12s %12s %14s %12s\n", "Receiver / Action", "Kept", "Dropped", "System-Forced", "Changed")
+	for _, stat := range stats {
+		fmt.Printf("%-50s %12d %12d %14d %12d\n", stat.key, stat.kept, stat.dropped, stat.systemForced, stat.changed)
+	}
+
+	fmt.Println()
+	fmt.Printf("Transactions now kept (were dropped): %d\n", p.changed[true])
+	fmt.Printf("Transactions now dropped (were kept): %d\n", p.changed[false])
+
+	if len(p.samples) > 0 {
+		fmt.Println()
+		fmt.Println("Sample transactions that changed status")
+		for _, sample := range p.samples {
+			fmt.Println("- " + sample)
+		}
+	}
+}
+
+// walkMergedBlocks decodes each block of the merged blocks files covering the range, calling
+// `onBlock` for each one within the range (stop block exclusive).
+func walkMergedBlocks(ctx context.Context, blocksStore dstore.Store, blockRange BlockRange, onBlock func(block *pbcodec.Block) error) error {
+	fileBlockSize := uint32(100)
+	number := regexp.MustCompile(`(\d{10})`)
+	walkPrefix := walkBlockPrefix(blockRange, fileBlockSize)
+
+	zlog.Debug("walking merged blocks", zap.Stringer("block_range", blockRange), zap.String("walk_prefix", walkPrefix))
+	err := blocksStore.Walk(ctx, walkPrefix, ".tmp", func(filename string) error {
+		match := number.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		baseNum, _ := strconv.ParseUint(match[1], 10, 32)
+		if baseNum+uint64(fileBlockSize) < blockRange.Start {
+			return nil
+		}
+
+		if !blockRange.Unbounded() && baseNum >= blockRange.Stop {
+			return errStopWalk
+		}
+
+		reader, err := blocksStore.OpenObject(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("open merged blocks file %s: %w", filename, err)
+		}
+		defer reader.Close()
+
+		readerFactory, err := bstream.GetBlockReaderFactory.New(reader)
+		if err != nil {
+			return fmt.Errorf("new block reader for %s: %w", filename, err)
+		}
+
+		for {
+			block, err := readerFactory.Read()
+			if block == nil && err == io.EOF {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("read block from %s: %w", filename, err)
+			}
+
+			if !blockRange.Unbounded() && (block.Number < blockRange.Start || block.Number >= blockRange.Stop) {
+				continue
+			}
+
+			if err := onBlock(block.ToNative().(*pbcodec.Block)); err != nil {
+				return err
+			}
+		}
+	})
+
+	if err != nil && err != errStopWalk {
+		return err
+	}
+
+	return nil
+}
@@ -35,6 +35,7 @@ type DBReader interface {
 	BlocksReader
 	TransactionsReader
 	AccountsReader
+	AccountTransactionsReader
 	TimelineExplorer
 }
 
@@ -50,6 +51,23 @@ type AccountsReader interface {
 	ListAccountNames(ctx context.Context, concurrentReadCount uint32) ([]string, error)
 }
 
+type AccountTransactionsReader interface {
+	// ListAccountTransactions retrieves references to the transactions involving
+	// `accountName`, either because it authorized one of the transaction's actions
+	// or because it is the receiver of the transaction's first action. Only
+	// transactions in blocks between `lowBlockNum` and `highBlockNum` (both
+	// inclusive) are considered and they are returned from the most recent to
+	// the oldest.
+	//
+	// Transactions of blocks that were forked out are never returned. Reversible
+	// transactions are returned too unless `opts.IrreversibleOnly` is set, the same
+	// transaction might then appear multiple times, once per competing fork.
+	//
+	// When more transactions are available, `nextCursor` is set and can be passed
+	// back in `opts.Cursor` to continue the listing, it is empty otherwise.
+	ListAccountTransactions(ctx context.Context, accountName string, lowBlockNum, highBlockNum uint32, opts ListAccountTransactionsOptions) (out []*AccountTransactionRef, nextCursor string, err error)
+}
+
 type TransactionsReader interface {
 	GetLastWrittenIrreversibleBlockRef(ctx context.Context) (ref bstream.BlockRef, err error)
 	// It's not the job of the Storage layer to discriminate events, just get the data
@@ -98,6 +116,15 @@ type BlocksReader interface {
 	// thing is you might not have the expected block range if there
 	// are forked blocks provided.
 	ListBlocks(ctx context.Context, highBlockNum uint32, limit int) ([]*pbcodec.BlockWithRefs, error)
+
+	// ListBlocksInRange retrieves blocks with a number between `lowBlockNum` and
+	// `highBlockNum` (both inclusive), ordered and filtered according to `opts`.
+	//
+	// Blocks sharing the same number (forks) are always returned in the same
+	// relative order, so a page ends on a well defined block. When more blocks
+	// are available, `nextCursor` is set and can be passed back in `opts.Cursor`
+	// to continue right after the last returned block, it is empty otherwise.
+	ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, opts ListBlocksOptions) (out []*pbcodec.BlockWithRefs, nextCursor string, err error)
 	ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) ([]*pbcodec.BlockWithRefs, error)
 }
 
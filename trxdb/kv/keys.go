@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/dfuse-io/kvdb"
+	"github.com/dfuse-io/kvdb/store"
 	"github.com/eoscanada/eos-go"
 )
 
@@ -18,6 +19,7 @@ const (
 	TblPrefixDtrxs     = 0x04
 	TblPrefixTrxTraces = 0x05
 	TblPrefixAccts     = 0x06
+	TblPrefixAcctTrxs  = 0x07
 	TblTTL             = 0x10
 
 	idxPrefixTimelineFwd = 0x80
@@ -84,6 +86,42 @@ func (Keyer) PackIrrBlockNumPrefix(blockNum uint32) []byte {
 func (Keyer) StartOfIrrBlockTable() []byte { return []byte{TblPrefixIrrBlks} }
 func (Keyer) EndOfIrrBlockTable() []byte   { return []byte{TblPrefixIrrBlks + 1} }
 
+// blockTable abstracts the blocks and irr blocks virtual tables which share the
+// same key layout, the reversed block ID.
+type blockTable struct {
+	prefix byte
+}
+
+var blocksTable = blockTable{prefix: TblPrefixBlocks}
+var irrBlocksTable = blockTable{prefix: TblPrefixIrrBlks}
+
+func (t blockTable) packKey(blockID string) []byte {
+	if t.prefix == TblPrefixIrrBlks {
+		return Keys.PackIrrBlocksKey(blockID)
+	}
+	return Keys.PackBlocksKey(blockID)
+}
+
+func (t blockTable) unpackKey(key []byte) (blockID string) {
+	return kvdb.ReversedBlockID(hex.EncodeToString(key[1:]))
+}
+
+func (t blockTable) packBlockNumPrefix(blockNum uint32) []byte {
+	if t.prefix == TblPrefixIrrBlks {
+		return Keys.PackIrrBlockNumPrefix(blockNum)
+	}
+	return Keys.PackBlockNumPrefix(blockNum)
+}
+
+// endOfBlockNum returns the exclusive end key of a scan that must include all
+// blocks with number `blockNum`, block nums being reversed in keys.
+func (t blockTable) endOfBlockNum(blockNum uint32) []byte {
+	if blockNum == 0 {
+		return []byte{t.prefix + 1}
+	}
+	return t.packBlockNumPrefix(blockNum - 1)
+}
+
 // Trx virt table
 
 func (k Keyer) PackTrxsKey(trxID string, blockID string) []byte {
@@ -173,14 +211,7 @@ func (Keyer) EndOfDtrxsTable() []byte   { return []byte{TblPrefixDtrxs + 1} }
 // Account virt table
 
 func (Keyer) PackAccountKey(accountName string) []byte {
-	name, err := eos.StringToName(accountName)
-	if err != nil {
-		panic(fmt.Errorf("invalid account name %q: %w", accountName, err))
-	}
-	b := make([]byte, 9)
-	b[0] = TblPrefixAccts
-	binary.LittleEndian.PutUint64(b[1:], name)
-	return b
+	return packAccountPrefix(TblPrefixAccts, accountName)
 }
 
 func (Keyer) UnpackAccountKey(key []byte) string {
@@ -191,6 +222,47 @@ func (Keyer) UnpackAccountKey(key []byte) string {
 func (Keyer) StartOfAccountTable() []byte { return []byte{TblPrefixAccts} }
 func (Keyer) EndOfAccountTable() []byte   { return []byte{TblPrefixAccts + 1} }
 
+// Account trxs virt table
+
+func (Keyer) PackAccountTrxsKey(accountName string, blockID string, trxID string) []byte {
+	id, err := hex.DecodeString(kvdb.ReversedBlockID(blockID) + trxID)
+	if err != nil {
+		panic(fmt.Errorf("invalid block ID %q or trx ID %q: %w", blockID, trxID, err))
+	}
+	return append(packAccountPrefix(TblPrefixAcctTrxs, accountName), id...)
+}
+
+func (Keyer) UnpackAccountTrxsKey(key []byte) (accountName, blockID, trxID string) {
+	if len(key) < 1+8+4+32 {
+		panic(fmt.Errorf("invalid key %q length, expected at least length 45 got %d", string(key), len(key)))
+	}
+
+	trxIDStart := len(key) - 32
+	accountName = eos.NameToString(binary.LittleEndian.Uint64(key[1:9]))
+	blockID = kvdb.ReversedBlockID(hex.EncodeToString(key[9:trxIDStart]))
+	trxID = hex.EncodeToString(key[trxIDStart:])
+	return
+}
+
+func (Keyer) PackAccountTrxsBlockNumPrefix(accountName string, blockNum uint32) []byte {
+	hexBlockNum, err := hex.DecodeString(kvdb.HexRevBlockNum(blockNum))
+	if err != nil {
+		panic(fmt.Errorf("invalid block num %d: %w", blockNum, err))
+	}
+	return append(packAccountPrefix(TblPrefixAcctTrxs, accountName), hexBlockNum...)
+}
+
+func (Keyer) StartOfAccountTrxsPrefix(accountName string) []byte {
+	return packAccountPrefix(TblPrefixAcctTrxs, accountName)
+}
+
+func (Keyer) EndOfAccountTrxsPrefix(accountName string) []byte {
+	return store.Key(packAccountPrefix(TblPrefixAcctTrxs, accountName)).PrefixNext()
+}
+
+func (Keyer) StartOfAccountTrxsTable() []byte { return []byte{TblPrefixAcctTrxs} }
+func (Keyer) EndOfAccountTrxsTable() []byte   { return []byte{TblPrefixAcctTrxs + 1} }
+
 // Timeline indexes
 
 func (Keyer) PackTimelineKey(fwd bool, blockTime time.Time, blockID string) []byte {
@@ -266,3 +338,14 @@ func (Keyer) packTrxPrefix(prefix byte, trxIDPrefix string) []byte {
 	}
 	return append([]byte{prefix}, id...)
 }
+
+func packAccountPrefix(prefix byte, accountName string) []byte {
+	name, err := eos.StringToName(accountName)
+	if err != nil {
+		panic(fmt.Errorf("invalid account name %q: %w", accountName, err))
+	}
+	b := make([]byte, 9)
+	b[0] = prefix
+	binary.LittleEndian.PutUint64(b[1:], name)
+	return b
+}
@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -21,6 +22,19 @@ func TestKeyer_PackAccountKey(t *testing.T) {
 	require.Equal(t, key, unpacked)
 }
 
+func TestKeyer_PackAccountTrxsKey(t *testing.T) {
+	expectedAccount := "eoscanadacom"
+	expectedBlockID := "0000001aafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615"
+	expectedTrxID := "f2c8602f6d2b8241894383b22614a82740338d3f5c34961c0c82b382ac9e11ae"
+
+	packed := Keys.PackAccountTrxsKey(expectedAccount, expectedBlockID, expectedTrxID)
+	account, blockID, trxID := Keys.UnpackAccountTrxsKey(packed)
+	require.Equal(t, expectedAccount, account)
+	require.Equal(t, expectedBlockID, blockID)
+	require.Equal(t, expectedTrxID, trxID)
+	require.True(t, bytes.HasPrefix(packed, Keys.PackAccountTrxsBlockNumPrefix(expectedAccount, 0x1a)))
+}
+
 func TestKeyer_PackTimelineKey(t *testing.T) {
 	expectedBlockID := "00000002aa"
 	expectedBlockTime := time.Unix(0, 0).UTC()
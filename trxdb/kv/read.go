@@ -16,6 +16,7 @@ package kv
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/dfuse-io/bstream"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbtrxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/trxdb/v1"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/kvdb"
 	"github.com/dfuse-io/kvdb/store"
 	"github.com/eoscanada/eos-go"
@@ -269,6 +271,179 @@ func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uin
 	return
 }
 
+// maxAscendingWindowSize caps the amount of block nums read at once when
+// listing blocks in ascending order, see `listBlockKeysAscending`.
+const maxAscendingWindowSize = 10000
+
+func (db *DB) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, opts trxdb.ListBlocksOptions) (out []*pbcodec.BlockWithRefs, nextCursor string, err error) {
+	db.logger.Debug("list blocks in range",
+		zap.Uint32("low_block_num", lowBlockNum),
+		zap.Uint32("high_block_num", highBlockNum),
+		zap.Bool("irreversible_only", opts.IrreversibleOnly),
+		zap.Bool("ascending", opts.Ascending),
+		zap.Int("limit", opts.Limit),
+		zap.String("cursor", opts.Cursor),
+	)
+
+	if lowBlockNum > highBlockNum {
+		return nil, "", fmt.Errorf("invalid range: low block num %d is higher than high block num %d", lowBlockNum, highBlockNum)
+	}
+
+	if opts.Limit < 0 {
+		return nil, "", fmt.Errorf("invalid limit %d: must be positive", opts.Limit)
+	}
+
+	table := blocksTable
+	if opts.IrreversibleOnly {
+		table = irrBlocksTable
+	}
+
+	var cursorKey []byte
+	if opts.Cursor != "" {
+		if _, err := hex.DecodeString(opts.Cursor); err != nil || len(opts.Cursor) < 8 {
+			return nil, "", fmt.Errorf("invalid cursor %q: not a valid block ID", opts.Cursor)
+		}
+
+		cursorBlockNum := kvdb.BlockNum(opts.Cursor)
+		if cursorBlockNum < lowBlockNum || cursorBlockNum > highBlockNum {
+			return nil, "", fmt.Errorf("invalid cursor %q: block num %d is not within range [%d, %d]", opts.Cursor, cursorBlockNum, lowBlockNum, highBlockNum)
+		}
+
+		cursorKey = table.packKey(opts.Cursor)
+		if opts.Ascending {
+			lowBlockNum = cursorBlockNum
+		} else {
+			highBlockNum = cursorBlockNum
+		}
+	}
+
+	// We fetch one more row than requested to know if there is a next page
+	fetchCount := 0
+	if opts.Limit > 0 {
+		fetchCount = opts.Limit + 1
+	}
+
+	var keyValues []store.KV
+	if opts.Ascending {
+		keyValues, err = db.listBlockKeysAscending(ctx, table, lowBlockNum, highBlockNum, cursorKey, fetchCount)
+	} else {
+		keyValues, err = db.listBlockKeysDescending(ctx, table, lowBlockNum, highBlockNum, cursorKey, fetchCount)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if fetchCount > 0 && len(keyValues) == fetchCount {
+		keyValues = keyValues[:opts.Limit]
+		nextCursor = table.unpackKey(keyValues[len(keyValues)-1].Key)
+	}
+
+	for _, kv := range keyValues {
+		blk, err := db.keyValueToBlockWithRef(ctx, table, kv)
+		if err == kvdb.ErrNotFound {
+			db.logger.Debug("irreversible block marker without block, skipping", zap.ByteString("packed_key", kv.Key))
+			continue
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("block with ref: %w", err)
+		}
+
+		out = append(out, blk)
+	}
+
+	return out, nextCursor, nil
+}
+
+// listBlockKeysDescending follows the natural ordering of the block tables, where
+// keys are prefixed by the reversed block num, so a single scan is enough.
+func (db *DB) listBlockKeysDescending(ctx context.Context, table blockTable, lowBlockNum, highBlockNum uint32, afterKey []byte, limit int) (out []store.KV, err error) {
+	start := table.packBlockNumPrefix(highBlockNum)
+	if afterKey != nil {
+		start = store.Key(afterKey).Next()
+	}
+
+	it := db.blkReadStore.Scan(ctx, start, table.endOfBlockNum(lowBlockNum), limit)
+	for it.Next() {
+		out = append(out, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// listBlockKeysAscending goes against the natural ordering of the block tables
+// and our stores do not all support reverse scans. Instead, the range is read
+// in windows of increasing block nums, each window being read in descending order
+// and then reversed. The window grows when empty, so sparse ranges do not cost
+// a scan per block num.
+func (db *DB) listBlockKeysAscending(ctx context.Context, table blockTable, lowBlockNum, highBlockNum uint32, beforeKey []byte, limit int) (out []store.KV, err error) {
+	// Avoid walking up to `highBlockNum` when it's way past what was written, like `math.MaxUint32`
+	it := db.blkReadStore.Scan(ctx, table.packBlockNumPrefix(highBlockNum), table.endOfBlockNum(lowBlockNum), 1)
+	found := it.Next()
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	highBlockNum = kvdb.BlockNum(table.unpackKey(it.Item().Key))
+
+	windowSize := uint32(limit)
+	if windowSize == 0 {
+		windowSize = maxAscendingWindowSize
+	}
+
+	for low := lowBlockNum; ; {
+		high := low + windowSize - 1
+		if high > highBlockNum || high < low {
+			high = highBlockNum
+		}
+
+		end := table.endOfBlockNum(low)
+		if beforeKey != nil && low == lowBlockNum {
+			end = beforeKey
+		}
+
+		var window []store.KV
+		it := db.blkReadStore.Scan(ctx, table.packBlockNumPrefix(high), end, 0)
+		for it.Next() {
+			window = append(window, it.Item())
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+
+		for i := len(window) - 1; i >= 0; i-- {
+			out = append(out, window[i])
+			if limit > 0 && len(out) >= limit {
+				return out, nil
+			}
+		}
+
+		if high >= highBlockNum {
+			return out, nil
+		}
+
+		if len(window) == 0 && windowSize < maxAscendingWindowSize {
+			windowSize *= 2
+		}
+		low = high + 1
+	}
+}
+
+func (db *DB) keyValueToBlockWithRef(ctx context.Context, table blockTable, kv store.KV) (*pbcodec.BlockWithRefs, error) {
+	if table.prefix == TblPrefixIrrBlks {
+		return db.GetBlock(ctx, table.unpackKey(kv.Key))
+	}
+
+	blockRow := &pbtrxdb.BlockRow{}
+	db.dec.MustInto(kv.Value, blockRow)
+	return db.blockRowToBlockWithRef(ctx, blockRow)
+}
+
 func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error) {
 	value, err := db.blkReadStore.Get(ctx, Keys.PackAccountKey(accountName))
 
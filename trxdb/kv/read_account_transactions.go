@@ -0,0 +1,138 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
+	"github.com/dfuse-io/kvdb"
+	"github.com/dfuse-io/kvdb/store"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (db *DB) ListAccountTransactions(ctx context.Context, accountName string, lowBlockNum, highBlockNum uint32, opts trxdb.ListAccountTransactionsOptions) (out []*trxdb.AccountTransactionRef, nextCursor string, err error) {
+	db.logger.Debug("list account transactions",
+		zap.String("account", accountName),
+		zap.Uint32("low_block_num", lowBlockNum),
+		zap.Uint32("high_block_num", highBlockNum),
+		zap.Bool("irreversible_only", opts.IrreversibleOnly),
+		zap.Int("limit", opts.Limit),
+		zap.String("cursor", opts.Cursor),
+	)
+
+	if _, err := eos.StringToName(accountName); err != nil {
+		return nil, "", fmt.Errorf("invalid account name %q: %w", accountName, err)
+	}
+
+	if lowBlockNum > highBlockNum {
+		return nil, "", fmt.Errorf("invalid range: low block num %d is higher than high block num %d", lowBlockNum, highBlockNum)
+	}
+
+	if opts.Limit < 0 {
+		return nil, "", fmt.Errorf("invalid limit %d: must be positive", opts.Limit)
+	}
+
+	start := Keys.PackAccountTrxsBlockNumPrefix(accountName, highBlockNum)
+	if opts.Cursor != "" {
+		blockID, trxID, err := unpackAccountTrxsCursor(opts.Cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q: %w", opts.Cursor, err)
+		}
+
+		cursorBlockNum := kvdb.BlockNum(blockID)
+		if cursorBlockNum < lowBlockNum || cursorBlockNum > highBlockNum {
+			return nil, "", fmt.Errorf("invalid cursor %q: block num %d is not within range [%d, %d]", opts.Cursor, cursorBlockNum, lowBlockNum, highBlockNum)
+		}
+
+		start = store.Key(Keys.PackAccountTrxsKey(accountName, blockID, trxID)).Next()
+	}
+
+	end := Keys.EndOfAccountTrxsPrefix(accountName)
+	if lowBlockNum > 0 {
+		end = Keys.PackAccountTrxsBlockNumPrefix(accountName, lowBlockNum-1)
+	}
+
+	// Rows of reversible blocks below the last irreversible block are the ones that
+	// were forked out, they were never rewritten as irreversible.
+	var libNum uint32
+	libRef, err := db.GetLastWrittenIrreversibleBlockRef(ctx)
+	if err != nil && err != kvdb.ErrNotFound {
+		return nil, "", fmt.Errorf("get last written irreversible block: %w", err)
+	}
+	if libRef != nil {
+		libNum = uint32(libRef.Num())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	it := db.trxReadStore.Scan(ctx, start, end, 0)
+	for it.Next() {
+		kv := it.Item()
+		_, blockID, trxID := Keys.UnpackAccountTrxsKey(kv.Key)
+
+		ref := &trxdb.AccountTransactionRef{
+			TransactionID: trxID,
+			BlockID:       blockID,
+			BlockNum:      kvdb.BlockNum(blockID),
+			Irreversible:  bytes.Equal(kv.Value, oneByte),
+		}
+
+		if !ref.Irreversible && (opts.IrreversibleOnly || ref.BlockNum <= libNum) {
+			continue
+		}
+
+		if opts.Limit > 0 && len(out) == opts.Limit {
+			last := out[len(out)-1]
+			nextCursor = packAccountTrxsCursor(last.BlockID, last.TransactionID)
+			break
+		}
+
+		out = append(out, ref)
+	}
+	if err := it.Err(); err != nil {
+		return nil, "", err
+	}
+
+	return out, nextCursor, nil
+}
+
+func packAccountTrxsCursor(blockID, trxID string) string {
+	return blockID + ":" + trxID
+}
+
+func unpackAccountTrxsCursor(cursor string) (blockID, trxID string, err error) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected 2 parts separated by ':', got %d", len(parts))
+	}
+
+	blockID, trxID = parts[0], parts[1]
+	if _, err := hex.DecodeString(blockID); err != nil || len(blockID) < 8 {
+		return "", "", fmt.Errorf("invalid block ID %q", blockID)
+	}
+
+	if _, err := hex.DecodeString(trxID); err != nil || len(trxID) != 64 {
+		return "", "", fmt.Errorf("invalid transaction ID %q", trxID)
+	}
+
+	return blockID, trxID, nil
+}
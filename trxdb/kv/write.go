@@ -72,6 +72,10 @@ func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
 		if err := db.putImplicitTransactions(ctx, blk); err != nil {
 			return fmt.Errorf("put block: unable to putTransactions: %w", err)
 		}
+
+		if err := db.putAccountTransactions(ctx, blk, reversibleMarker); err != nil {
+			return fmt.Errorf("put block: unable to putAccountTransactions: %w", err)
+		}
 	} else {
 		db.logger.Debug("skipping transaction write")
 	}
@@ -205,6 +209,58 @@ func (db *DB) putNewAccount(ctx context.Context, blk *pbcodec.Block, trace *pbco
 	return nil
 }
 
+// putAccountTransactions indexes each transaction of the block under the accounts
+// it involves. Rows are first written with the `reversibleMarker` value and then
+// rewritten with `oneByte` once the block becomes irreversible. This is what
+// enables readers to discard rows of blocks that were forked out.
+func (db *DB) putAccountTransactions(ctx context.Context, blk *pbcodec.Block, marker []byte) error {
+	for _, trxTrace := range blk.TransactionTraces() {
+		for _, account := range transactionAccounts(trxTrace) {
+			if traceEnabled {
+				db.logger.Debug("put account transaction row", zap.String("account", account), zap.String("trx_id", trxTrace.Id), zap.String("block_id", blk.Id))
+			}
+
+			key := Keys.PackAccountTrxsKey(account, blk.Id, trxTrace.Id)
+			// NOTE: This function is guarded by the parent with db.enableTrxWrite
+			if err := db.writeStore.Put(ctx, key, marker); err != nil {
+				return fmt.Errorf("put account trx: write to db: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// transactionAccounts returns the authorizers of the transaction's input actions
+// as well as the receiver of its first action, deduplicated.
+func transactionAccounts(trxTrace *pbcodec.TransactionTrace) (out []string) {
+	seen := map[string]bool{}
+	add := func(account string) {
+		if account != "" && !seen[account] {
+			seen[account] = true
+			out = append(out, account)
+		}
+	}
+
+	firstAction := true
+	for _, actTrace := range trxTrace.ActionTraces {
+		if !actTrace.IsInput() || actTrace.Action == nil {
+			continue
+		}
+
+		if firstAction {
+			add(actTrace.Receiver)
+			firstAction = false
+		}
+
+		for _, authorization := range actTrace.Action.Authorization {
+			add(authorization.Actor)
+		}
+	}
+
+	return
+}
+
 func (db *DB) putImplicitTransactions(ctx context.Context, blk *pbcodec.Block) error {
 	for _, trxOp := range blk.ImplicitTransactionOps() {
 		implTrxRow := &pbtrxdb.ImplicitTrxRow{
@@ -286,6 +342,7 @@ func (db *DB) putBlock(ctx context.Context, blk *pbcodec.Block) error {
 }
 
 var oneByte = []byte{0x01}
+var reversibleMarker = []byte{0x00}
 
 func (db *DB) UpdateNowIrreversibleBlock(ctx context.Context, blk *pbcodec.Block) error {
 	if db.enableBlkWrite {
@@ -316,6 +373,14 @@ func (db *DB) UpdateNowIrreversibleBlock(ctx context.Context, blk *pbcodec.Block
 		db.logger.Debug("account is not written, skipping")
 	}
 
+	if db.enableTrxWrite {
+		if err := db.putAccountTransactions(ctx, blk, oneByte); err != nil {
+			return fmt.Errorf("failed to put irreversible account transactions: %w", err)
+		}
+	} else {
+		db.logger.Debug("account transactions are not written, skipping")
+	}
+
 	if db.writeStore != nil {
 		// We must do this operation regardless of the write only categories set since this is used
 		// as our last block marker. If this would not be writing, it would never be possible to start
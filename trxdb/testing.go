@@ -75,6 +75,10 @@ func (db *testDriver) GetAccount(ctx context.Context, accountName string) (*pbco
 	panic("test driver, not callable")
 }
 
+func (db *testDriver) ListAccountTransactions(ctx context.Context, accountName string, lowBlockNum, highBlockNum uint32, opts ListAccountTransactionsOptions) ([]*AccountTransactionRef, string, error) {
+	panic("test driver, not callable")
+}
+
 func (db *testDriver) ListAccountNames(ctx context.Context, concurrentReadCount uint32) ([]string, error) {
 	panic("test driver, not callable")
 }
@@ -131,6 +135,10 @@ func (db *testDriver) ListBlocks(ctx context.Context, highBlockNum uint32, limit
 	panic("test driver, not callable")
 }
 
+func (db *testDriver) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, opts ListBlocksOptions) ([]*pbcodec.BlockWithRefs, string, error) {
+	panic("test driver, not callable")
+}
+
 func (db *testDriver) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) ([]*pbcodec.BlockWithRefs, error) {
 	panic("test driver, not callable")
 }
@@ -0,0 +1,184 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trxdbtest
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var accountTransactionsReaderTests = []DriverTestFunc{
+	TestListAccountTransactions,
+}
+
+func TestListAccountTransactions(t *testing.T, driverFactory DriverFactory) {
+	trxA := strings.Repeat("a", 64)
+	trxB := strings.Repeat("b", 64)
+	trxC := strings.Repeat("c", 64)
+	trxD := strings.Repeat("d", 64)
+
+	type page struct {
+		trxIDs     []string
+		nextCursor bool
+	}
+
+	tests := []struct {
+		name         string
+		account      string
+		lowBlockNum  uint32
+		highBlockNum uint32
+		options      trxdb.ListAccountTransactionsOptions
+		expectPages  []page
+		expectErr    bool
+	}{
+		{
+			name:         "authorizer, skips forked out transactions",
+			account:      "alice",
+			highBlockNum: math.MaxUint32,
+			expectPages:  []page{{trxIDs: []string{trxD, trxB, trxA}}},
+		},
+		{
+			name:         "first action receiver",
+			account:      "eosio.token",
+			highBlockNum: math.MaxUint32,
+			expectPages:  []page{{trxIDs: []string{trxA}}},
+		},
+		{
+			name:         "notified account is not indexed",
+			account:      "carol",
+			highBlockNum: math.MaxUint32,
+			expectPages:  []page{{trxIDs: []string{trxB}}},
+		},
+		{
+			name:         "receiver and authorizer",
+			account:      "bob",
+			highBlockNum: math.MaxUint32,
+			expectPages:  []page{{trxIDs: []string{trxD, trxB}}},
+		},
+		{
+			name:         "irreversible only",
+			account:      "alice",
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListAccountTransactionsOptions{IrreversibleOnly: true},
+			expectPages:  []page{{trxIDs: []string{trxB, trxA}}},
+		},
+		{
+			name:         "bounded range",
+			account:      "alice",
+			lowBlockNum:  4,
+			highBlockNum: 4,
+			expectPages:  []page{{trxIDs: []string{trxB}}},
+		},
+		{
+			name:         "paginated",
+			account:      "alice",
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListAccountTransactionsOptions{Limit: 2},
+			expectPages: []page{
+				{trxIDs: []string{trxD, trxB}, nextCursor: true},
+				{trxIDs: []string{trxA}},
+			},
+		},
+		{
+			name:         "unknown account",
+			account:      "unknown",
+			highBlockNum: math.MaxUint32,
+			expectPages:  []page{{trxIDs: nil}},
+		},
+		{
+			name:         "invalid range",
+			account:      "alice",
+			lowBlockNum:  5,
+			highBlockNum: 4,
+			expectErr:    true,
+		},
+		{
+			name:         "invalid cursor",
+			account:      "alice",
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListAccountTransactionsOptions{Cursor: "invalid"},
+			expectErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, clean := driverFactory()
+			defer clean()
+
+			blocks := []struct {
+				block        *pbcodec.Block
+				irreversible bool
+			}{
+				{ct.Block(t, "00000003aa", ct.TrxTrace(t, ct.TrxID(trxA),
+					ct.ActionTrace(t, "eosio.token:transfer", ct.Authorization("alice")),
+					ct.ActionTrace(t, "carol:eosio.token:transfer"),
+				)), true},
+				{ct.Block(t, "00000004aa", ct.TrxTrace(t, ct.TrxID(trxB), ct.ActionTrace(t, "bob:hi", ct.Authorizations("alice@owner", "carol")))), true},
+				{ct.Block(t, "00000004bb", ct.TrxTrace(t, ct.TrxID(trxC), ct.ActionTrace(t, "bob:hi", ct.Authorization("alice")))), false},
+				{ct.Block(t, "00000005aa", ct.TrxTrace(t, ct.TrxID(trxD), ct.ActionTrace(t, "eosio:vote", ct.Authorizations("alice", "bob")))), false},
+			}
+
+			for _, blk := range blocks {
+				block := blk.block
+				// Notifications are created by the input action that sent them
+				for i, actTrace := range block.UnfilteredTransactionTraces[0].ActionTraces {
+					actTrace.ActionOrdinal = uint32(i + 1)
+					if actTrace.Receiver != actTrace.Action.Account {
+						actTrace.CreatorActionOrdinal = 1
+					}
+				}
+
+				require.NoError(t, db.PutBlock(ctx, block))
+				if blk.irreversible {
+					require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, block))
+				}
+			}
+			require.NoError(t, db.Flush(ctx))
+
+			options := test.options
+			if test.expectErr {
+				_, _, err := db.ListAccountTransactions(ctx, test.account, test.lowBlockNum, test.highBlockNum, options)
+				assert.Error(t, err)
+				return
+			}
+
+			for _, expectPage := range test.expectPages {
+				refs, nextCursor, err := db.ListAccountTransactions(ctx, test.account, test.lowBlockNum, test.highBlockNum, options)
+				require.NoError(t, err)
+
+				var trxIDs []string
+				for _, ref := range refs {
+					trxIDs = append(trxIDs, ref.TransactionID)
+					assert.Equal(t, ref.BlockNum > 4, !ref.Irreversible, "transaction %s irreversibility", ref.TransactionID)
+				}
+
+				assert.Equal(t, expectPage.trxIDs, trxIDs)
+				assert.Equal(t, expectPage.nextCursor, nextCursor != "")
+
+				options.Cursor = nextCursor
+			}
+		})
+	}
+}
@@ -16,10 +16,12 @@ package trxdbtest
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	ct "github.com/dfuse-io/dfuse-eosio/codec/testing"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/kvdb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +31,7 @@ var dbReaderTests = []DriverTestFunc{
 	TestGetBlock,
 	TestGetBlockByNum,
 	TestListBlocks,
+	TestListBlocksInRange,
 	TestListSiblingBlocks,
 	TestGetClosestIrreversibleIDAtBlockNum,
 	TestGetIrreversibleIDAtBlockID,
@@ -392,6 +395,167 @@ func TestListBlocks(t *testing.T, driverFactory DriverFactory) {
 	require.Equal(t, 0, len(resps))
 }
 
+func TestListBlocksInRange(t *testing.T, driverFactory DriverFactory) {
+	type page struct {
+		blockIDs   []string
+		nextCursor string
+	}
+
+	tests := []struct {
+		name         string
+		lowBlockNum  uint32
+		highBlockNum uint32
+		options      trxdb.ListBlocksOptions
+		expectPages  []page
+		expectErr    bool
+	}{
+		{
+			name:         "all blocks, descending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			expectPages: []page{
+				{blockIDs: []string{"00000006aa", "00000005aa", "00000004aa", "00000004bb", "00000003aa"}},
+			},
+		},
+		{
+			name:         "all blocks, ascending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{Ascending: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000003aa", "00000004bb", "00000004aa", "00000005aa", "00000006aa"}},
+			},
+		},
+		{
+			name:         "irreversible only, descending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{IrreversibleOnly: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000005aa", "00000004aa", "00000003aa"}},
+			},
+		},
+		{
+			name:         "irreversible only, ascending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{IrreversibleOnly: true, Ascending: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000003aa", "00000004aa", "00000005aa"}},
+			},
+		},
+		{
+			name:         "bounded range",
+			lowBlockNum:  4,
+			highBlockNum: 5,
+			expectPages: []page{
+				{blockIDs: []string{"00000005aa", "00000004aa", "00000004bb"}},
+			},
+		},
+		{
+			name:         "bounded range, ascending",
+			lowBlockNum:  4,
+			highBlockNum: 5,
+			options:      trxdb.ListBlocksOptions{Ascending: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000004bb", "00000004aa", "00000005aa"}},
+			},
+		},
+		{
+			name:         "paginated, descending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{Limit: 2},
+			expectPages: []page{
+				{blockIDs: []string{"00000006aa", "00000005aa"}, nextCursor: "00000005aa"},
+				{blockIDs: []string{"00000004aa", "00000004bb"}, nextCursor: "00000004bb"},
+				{blockIDs: []string{"00000003aa"}},
+			},
+		},
+		{
+			name:         "paginated, ascending",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{Limit: 2, Ascending: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000003aa", "00000004bb"}, nextCursor: "00000004bb"},
+				{blockIDs: []string{"00000004aa", "00000005aa"}, nextCursor: "00000005aa"},
+				{blockIDs: []string{"00000006aa"}},
+			},
+		},
+		{
+			name:         "paginated, irreversible only, exact page size",
+			lowBlockNum:  0,
+			highBlockNum: math.MaxUint32,
+			options:      trxdb.ListBlocksOptions{Limit: 3, IrreversibleOnly: true},
+			expectPages: []page{
+				{blockIDs: []string{"00000005aa", "00000004aa", "00000003aa"}},
+			},
+		},
+		{
+			name:         "empty range",
+			lowBlockNum:  7,
+			highBlockNum: 10,
+			options:      trxdb.ListBlocksOptions{Ascending: true},
+			expectPages: []page{
+				{blockIDs: nil},
+			},
+		},
+		{
+			name:         "invalid range",
+			lowBlockNum:  5,
+			highBlockNum: 4,
+			expectErr:    true,
+		},
+		{
+			name:         "cursor out of range",
+			lowBlockNum:  5,
+			highBlockNum: 6,
+			options:      trxdb.ListBlocksOptions{Cursor: "00000004aa"},
+			expectErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, clean := driverFactory()
+			defer clean()
+
+			for _, id := range []string{"00000003aa", "00000004aa", "00000004bb", "00000005aa", "00000006aa"} {
+				require.NoError(t, db.PutBlock(ctx, ct.Block(t, id)))
+			}
+
+			for _, id := range []string{"00000003aa", "00000004aa", "00000005aa"} {
+				require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, ct.Block(t, id)))
+			}
+			require.NoError(t, db.Flush(ctx))
+
+			options := test.options
+			if test.expectErr {
+				_, _, err := db.ListBlocksInRange(ctx, test.lowBlockNum, test.highBlockNum, options)
+				assert.Error(t, err)
+				return
+			}
+
+			for _, expectPage := range test.expectPages {
+				blocks, nextCursor, err := db.ListBlocksInRange(ctx, test.lowBlockNum, test.highBlockNum, options)
+				require.NoError(t, err)
+
+				var ids []string
+				for _, blk := range blocks {
+					ids = append(ids, blk.Id)
+				}
+
+				assert.Equal(t, expectPage.blockIDs, ids)
+				assert.Equal(t, expectPage.nextCursor, nextCursor)
+
+				options.Cursor = nextCursor
+			}
+		})
+	}
+}
+
 func TestListSiblingBlocks(t *testing.T, driverFactory DriverFactory) {
 
 	ctx := context.Background()
@@ -88,3 +88,48 @@ func toIndexableCategory(in string) (pbtrxdb.IndexableCategory, error) {
 
 	return pbtrxdb.IndexableCategory(value), nil
 }
+
+// ListBlocksOptions controls how `BlocksReader.ListBlocksInRange` walks its range.
+type ListBlocksOptions struct {
+	// IrreversibleOnly restricts the listing to blocks of the canonical chain,
+	// i.e. blocks flagged as irreversible, forked and reversible blocks are skipped.
+	IrreversibleOnly bool
+
+	// Ascending lists blocks from the low block num up to the high one, the
+	// default being from the high block num down to the low one.
+	Ascending bool
+
+	// Limit is the maximum number of blocks returned, 0 means no limit.
+	Limit int
+
+	// Cursor is the `nextCursor` value returned by a previous call, the listing
+	// then resumes right after the block it identifies. It must be used with the
+	// same `IrreversibleOnly` and `Ascending` values as the call that returned it.
+	Cursor string
+}
+
+// ListAccountTransactionsOptions controls how `AccountTransactionsReader.ListAccountTransactions`
+// walks its range.
+type ListAccountTransactionsOptions struct {
+	// IrreversibleOnly restricts the listing to transactions of irreversible blocks.
+	IrreversibleOnly bool
+
+	// Limit is the maximum number of transactions returned, 0 means no limit.
+	Limit int
+
+	// Cursor is the `nextCursor` value returned by a previous call, the listing
+	// then resumes right after the transaction it identifies.
+	Cursor string
+}
+
+// AccountTransactionRef points to a transaction, and the block it was executed
+// in, involving a given account.
+type AccountTransactionRef struct {
+	TransactionID string
+	BlockID       string
+	BlockNum      uint32
+
+	// Irreversible is `false` when the block is still reversible, in which case
+	// the transaction might be forked out later on.
+	Irreversible bool
+}